@@ -29,6 +29,12 @@ const (
 	SupportedRedisVersion = "3.2"
 )
 
+// AnnotationKeyRegenerateKey triggers the regeneration of one of a Redis
+// cache's access keys whenever its value changes. The value names the key to
+// regenerate, Primary or Secondary, optionally followed by a colon and any
+// string that makes it unique, for example "Secondary:2021-02-06T10:00:00Z".
+const AnnotationKeyRegenerateKey = "cache.azure.crossplane.io/regenerate-key"
+
 // An SKU represents the performance and cost oriented properties of a
 // Redis.
 type SKU struct {
@@ -86,7 +92,16 @@ type RedisParameters struct {
 	// +optional
 	SubnetID *string `json:"subnetId,omitempty"`
 
-	// TODO(hasheddan): support SubnetIDRef
+	// SubnetIDRef - A reference to a Subnet to retrieve its SubnetID.
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector - Select a reference to a Subnet to retrieve its
+	// SubnetID.
+	// +immutable
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
 
 	// StaticIP address. Required when deploying a Redis cache inside an
 	// existing Azure Virtual Network.
@@ -100,6 +115,9 @@ type RedisParameters struct {
 	// slowlog-log-slower-than,slowlog-max-len,list-max-ziplist-entries,
 	// list-max-ziplist-value,hash-max-ziplist-entries,hash-max-ziplist-value,
 	// set-max-intset-entries,zset-max-ziplist-entries,zset-max-ziplist-value etc.
+	// Keys that are removed are reset in Azure. Keys that Azure populates
+	// itself, e.g. maxclients, are not reported as drift unless they are
+	// specified here.
 	// +optional
 	RedisConfiguration map[string]string `json:"redisConfiguration,omitempty"`
 
@@ -108,7 +126,8 @@ type RedisParameters struct {
 	// +optional
 	EnableNonSSLPort *bool `json:"enableNonSslPort,omitempty"`
 
-	// TenantSettings - A dictionary of tenant settings
+	// TenantSettings - A dictionary of tenant settings. Keys that are removed
+	// are reset in Azure.
 	// +optional
 	TenantSettings map[string]string `json:"tenantSettings,omitempty"`
 
@@ -131,6 +150,53 @@ type RedisParameters struct {
 	// Tags - Resource tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// Persistence configures RDB and AOF persistence of a Premium cache to
+	// storage Accounts. It takes precedence over the corresponding
+	// RedisConfiguration keys. RDB or AOF persistence is disabled when it is
+	// removed from here.
+	// +optional
+	Persistence *RedisPersistence `json:"persistence,omitempty"`
+}
+
+// RedisPersistence configures the persistence of a Premium Redis cache.
+type RedisPersistence struct {
+	// RDB configures periodic snapshots of the cache.
+	// +optional
+	RDB *RDBPersistence `json:"rdb,omitempty"`
+
+	// AOF configures logging of every write operation to an append only
+	// file.
+	// +optional
+	AOF *AOFPersistence `json:"aof,omitempty"`
+}
+
+// RDBPersistence configures periodic snapshots of a Redis cache.
+type RDBPersistence struct {
+	// BackupFrequency is the number of minutes between snapshots.
+	// +kubebuilder:validation:Enum=15;30;60;360;720;1440
+	BackupFrequency int `json:"backupFrequency"`
+
+	// MaxSnapshotCount is the number of snapshots to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSnapshotCount *int `json:"maxSnapshotCount,omitempty"`
+
+	// StorageAccountRef references the storage Account snapshots are written
+	// to. The Account must publish a connection secret.
+	StorageAccountRef xpv1.Reference `json:"storageAccountRef"`
+}
+
+// AOFPersistence configures append only file persistence of a Redis cache.
+type AOFPersistence struct {
+	// StorageAccountRef references the storage Account the append only file
+	// is written to. The Account must publish a connection secret.
+	StorageAccountRef xpv1.Reference `json:"storageAccountRef"`
+
+	// SecondaryStorageAccountRef references a second storage Account the
+	// append only file is written to.
+	// +optional
+	SecondaryStorageAccountRef *xpv1.Reference `json:"secondaryStorageAccountRef,omitempty"`
 }
 
 // A RedisSpec defines the desired state of a Redis.
@@ -173,6 +239,29 @@ type RedisObservation struct {
 type RedisStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          RedisObservation `json:"atProvider,omitempty"`
+
+	// LastKeyRegenerationTrigger is the value of the regenerate-key
+	// annotation that was last acted upon.
+	LastKeyRegenerationTrigger string `json:"lastKeyRegenerationTrigger,omitempty"`
+
+	// LastApplied records the settings that were last applied to Azure.
+	LastApplied RedisLastApplied `json:"lastApplied,omitempty"`
+
+	// PersistenceStorageHash is a digest of the storage connection strings
+	// that were last sent to Azure. Azure does not report them, so the digest
+	// is used to tell when they changed and must be sent again.
+	PersistenceStorageHash string `json:"persistenceStorageHash,omitempty"`
+}
+
+// RedisLastApplied records the keys of the RedisConfiguration and
+// TenantSettings maps that were last applied to Azure, so that keys removed
+// from the spec can be told apart from keys Azure populates itself.
+type RedisLastApplied struct {
+	// RedisConfiguration keys that were last applied.
+	RedisConfiguration []string `json:"redisConfiguration,omitempty"`
+
+	// TenantSettings keys that were last applied.
+	TenantSettings []string `json:"tenantSettings,omitempty"`
 }
 
 // +kubebuilder:object:root=true
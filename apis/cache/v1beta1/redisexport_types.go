@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+)
+
+// RedisExportParameters define the desired state of an export of an Azure
+// Redis cache.
+type RedisExportParameters struct {
+	// RedisName - Name of the Redis cache to export.
+	// +immutable
+	RedisName string `json:"redisName,omitempty"`
+
+	// RedisNameRef - A reference to the Redis to export.
+	// +immutable
+	RedisNameRef *xpv1.Reference `json:"redisNameRef,omitempty"`
+
+	// RedisNameSelector - Selects a Redis to reference.
+	// +immutable
+	RedisNameSelector *xpv1.Selector `json:"redisNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Redis cache's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// ContainerRef references the storage Container the cache is exported
+	// to. The Container's Account must publish a connection secret.
+	// +immutable
+	ContainerRef xpv1.Reference `json:"containerRef"`
+
+	// Prefix of the exported files.
+	// +immutable
+	Prefix string `json:"prefix"`
+
+	// Format of the exported files. Defaults to RDB.
+	// +immutable
+	// +optional
+	Format *string `json:"format,omitempty"`
+}
+
+// A RedisExportObservation represents the observed state of an export of an
+// Azure Redis cache.
+type RedisExportObservation struct {
+	// LastOperation is the export operation.
+	LastOperation v1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// A RedisExportSpec defines the desired state of an export of an Azure Redis
+// cache.
+type RedisExportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisExportParameters `json:"forProvider"`
+}
+
+// A RedisExportStatus represents the status of an export of an Azure Redis
+// cache.
+type RedisExportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisExportObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedisExport is a managed resource that exports the data of an Azure
+// Premium Redis cache to a storage Container once. It becomes ready when the
+// export completes; deleting it does not delete the exported files.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.lastOperation.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type RedisExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisExportSpec   `json:"spec"`
+	Status RedisExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisExportList contains a list of RedisExport.
+type RedisExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisExport `json:"items"`
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RedisFirewallRuleProperties defines the properties of an Azure Redis
+// firewall rule.
+type RedisFirewallRuleProperties struct {
+	// StartIP is the lowest IP address included in the range.
+	StartIP string `json:"startIp"`
+
+	// EndIP is the highest IP address included in the range.
+	EndIP string `json:"endIp"`
+}
+
+// RedisFirewallRuleParameters define the desired state of an Azure Redis
+// firewall rule.
+type RedisFirewallRuleParameters struct {
+	// RedisName - Name of the Redis cache this firewall rule belongs to.
+	RedisName string `json:"redisName,omitempty"`
+
+	// RedisNameRef - A reference to the Redis this firewall rule belongs to.
+	RedisNameRef *xpv1.Reference `json:"redisNameRef,omitempty"`
+
+	// RedisNameSelector - Selects a Redis to reference.
+	RedisNameSelector *xpv1.Selector `json:"redisNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Firewall Rule's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// RedisFirewallRuleProperties - Resource properties.
+	RedisFirewallRuleProperties `json:"properties"`
+}
+
+// A RedisFirewallRuleObservation represents the observed state of an Azure
+// Redis firewall rule.
+type RedisFirewallRuleObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+}
+
+// A RedisFirewallRuleSpec defines the desired state of an Azure Redis
+// firewall rule.
+type RedisFirewallRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisFirewallRuleParameters `json:"forProvider"`
+}
+
+// A RedisFirewallRuleStatus represents the status of an Azure Redis firewall
+// rule.
+type RedisFirewallRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisFirewallRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedisFirewallRule is a managed resource that represents an Azure Redis
+// firewall rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type RedisFirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisFirewallRuleSpec   `json:"spec"`
+	Status RedisFirewallRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisFirewallRuleList contains a list of RedisFirewallRule.
+type RedisFirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisFirewallRule `json:"items"`
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Replication roles of a linked Redis server.
+const (
+	ReplicationRolePrimary   = "Primary"
+	ReplicationRoleSecondary = "Secondary"
+)
+
+// RedisLinkedServerParameters define the desired state of a geo-replication
+// link between two Azure Premium Redis caches.
+type RedisLinkedServerParameters struct {
+	// ResourceGroupName - Name of the primary Redis cache's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// RedisName - Name of the primary Redis cache.
+	// +immutable
+	RedisName string `json:"redisName,omitempty"`
+
+	// RedisNameRef - A reference to the primary Redis.
+	// +immutable
+	RedisNameRef *xpv1.Reference `json:"redisNameRef,omitempty"`
+
+	// RedisNameSelector - Selects the primary Redis to reference.
+	// +immutable
+	RedisNameSelector *xpv1.Selector `json:"redisNameSelector,omitempty"`
+
+	// LinkedRedisCacheID - Fully qualified resource ID of the secondary Redis
+	// cache.
+	// +immutable
+	LinkedRedisCacheID string `json:"linkedRedisCacheId,omitempty"`
+
+	// LinkedRedisCacheIDRef - A reference to the secondary Redis.
+	// +immutable
+	LinkedRedisCacheIDRef *xpv1.Reference `json:"linkedRedisCacheIdRef,omitempty"`
+
+	// LinkedRedisCacheIDSelector - Selects the secondary Redis to reference.
+	// +immutable
+	LinkedRedisCacheIDSelector *xpv1.Selector `json:"linkedRedisCacheIdSelector,omitempty"`
+
+	// LinkedRedisCacheLocation - Location of the secondary Redis cache.
+	// Defaults to the location of the secondary Redis cache.
+	// +immutable
+	// +optional
+	LinkedRedisCacheLocation *string `json:"linkedRedisCacheLocation,omitempty"`
+
+	// ServerRole - Role of the linked server. Possible values include:
+	// 'Primary', 'Secondary'
+	// +immutable
+	// +kubebuilder:validation:Enum=Primary;Secondary
+	ServerRole string `json:"serverRole"`
+}
+
+// A RedisLinkedServerObservation represents the observed state of a
+// geo-replication link between two Azure Redis caches.
+type RedisLinkedServerObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Name - Resource name.
+	Name string `json:"name,omitempty"`
+
+	// ProvisioningState - Terminal state of the link between the primary and
+	// the secondary Redis cache.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// LinkedRedisCacheID - Fully qualified resource ID of the linked Redis
+	// cache.
+	LinkedRedisCacheID string `json:"linkedRedisCacheId,omitempty"`
+
+	// LinkedRedisCacheLocation - Location of the linked Redis cache.
+	LinkedRedisCacheLocation string `json:"linkedRedisCacheLocation,omitempty"`
+
+	// ServerRole - Role of the linked server.
+	ServerRole string `json:"serverRole,omitempty"`
+}
+
+// A RedisLinkedServerSpec defines the desired state of a RedisLinkedServer.
+type RedisLinkedServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisLinkedServerParameters `json:"forProvider"`
+}
+
+// A RedisLinkedServerStatus represents the observed state of a
+// RedisLinkedServer.
+type RedisLinkedServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisLinkedServerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedisLinkedServer is a managed resource that represents a geo-replication
+// link between a primary and a secondary Azure Premium Redis cache.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.serverRole"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type RedisLinkedServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisLinkedServerSpec   `json:"spec"`
+	Status RedisLinkedServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisLinkedServerList contains a list of RedisLinkedServer.
+type RedisLinkedServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisLinkedServer `json:"items"`
+}
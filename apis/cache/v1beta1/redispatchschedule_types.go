@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ScheduleEntry defines a window in which Azure may patch a Premium Redis
+// cache.
+type ScheduleEntry struct {
+	// DayOfWeek on which the cache can be patched.
+	// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday;Everyday;Weekend
+	DayOfWeek string `json:"dayOfWeek"`
+
+	// StartHourUTC is the hour of the day, in UTC, after which patching can
+	// start.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHourUTC *int `json:"startHourUtc,omitempty"`
+
+	// MaintenanceWindow is an ISO8601 timespan specifying how long patching
+	// can take, e.g. PT5H.
+	// +optional
+	MaintenanceWindow *string `json:"maintenanceWindow,omitempty"`
+}
+
+// RedisPatchScheduleParameters define the desired state of an Azure Redis
+// patch schedule.
+type RedisPatchScheduleParameters struct {
+	// RedisName - Name of the Redis cache this patch schedule belongs to.
+	RedisName string `json:"redisName,omitempty"`
+
+	// RedisNameRef - A reference to the Redis this patch schedule belongs to.
+	RedisNameRef *xpv1.Reference `json:"redisNameRef,omitempty"`
+
+	// RedisNameSelector - Selects a Redis to reference.
+	RedisNameSelector *xpv1.Selector `json:"redisNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Patch Schedule's resource group.
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// ScheduleEntries during which the Redis cache may be patched.
+	// +optional
+	ScheduleEntries []ScheduleEntry `json:"scheduleEntries,omitempty"`
+}
+
+// A RedisPatchScheduleObservation represents the observed state of an Azure
+// Redis patch schedule.
+type RedisPatchScheduleObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+}
+
+// A RedisPatchScheduleSpec defines the desired state of an Azure Redis patch
+// schedule.
+type RedisPatchScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisPatchScheduleParameters `json:"forProvider"`
+}
+
+// A RedisPatchScheduleStatus represents the status of an Azure Redis patch
+// schedule.
+type RedisPatchScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisPatchScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedisPatchSchedule is a managed resource that represents the maintenance
+// window of an Azure Premium Redis cache. A cache has at most one patch
+// schedule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type RedisPatchSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisPatchScheduleSpec   `json:"spec"`
+	Status RedisPatchScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisPatchScheduleList contains a list of RedisPatchSchedule.
+type RedisPatchScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisPatchSchedule `json:"items"`
+}
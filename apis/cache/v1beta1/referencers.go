@@ -23,7 +23,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	networkv1alpha3 "github.com/crossplane/provider-azure/apis/network/v1alpha3"
 	"github.com/crossplane/provider-azure/apis/v1alpha3"
 )
 
@@ -45,5 +47,185 @@ func (mg *Redis) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.subnetId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SubnetID),
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &networkv1alpha3.Subnet{}, List: &networkv1alpha3.SubnetList{}},
+		Extract:      networkv1alpha3.SubnetID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetId")
+	}
+	mg.Spec.ForProvider.SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RedisFirewallRule.
+func (mg *RedisFirewallRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.redisName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RedisName,
+		Reference:    mg.Spec.ForProvider.RedisNameRef,
+		Selector:     mg.Spec.ForProvider.RedisNameSelector,
+		To:           reference.To{Managed: &Redis{}, List: &RedisList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.redisName")
+	}
+	mg.Spec.ForProvider.RedisName = rsp.ResolvedValue
+	mg.Spec.ForProvider.RedisNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RedisPatchSchedule.
+func (mg *RedisPatchSchedule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.redisName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RedisName,
+		Reference:    mg.Spec.ForProvider.RedisNameRef,
+		Selector:     mg.Spec.ForProvider.RedisNameSelector,
+		To:           reference.To{Managed: &Redis{}, List: &RedisList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.redisName")
+	}
+	mg.Spec.ForProvider.RedisName = rsp.ResolvedValue
+	mg.Spec.ForProvider.RedisNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// RedisID extracts status.atProvider.id from the supplied managed resource,
+// which must be a Redis.
+func RedisID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Redis)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ID
+	}
+}
+
+// ResolveReferences of this RedisLinkedServer.
+func (mg *RedisLinkedServer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.redisName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RedisName,
+		Reference:    mg.Spec.ForProvider.RedisNameRef,
+		Selector:     mg.Spec.ForProvider.RedisNameSelector,
+		To:           reference.To{Managed: &Redis{}, List: &RedisList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.redisName")
+	}
+	mg.Spec.ForProvider.RedisName = rsp.ResolvedValue
+	mg.Spec.ForProvider.RedisNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.linkedRedisCacheId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.LinkedRedisCacheID,
+		Reference:    mg.Spec.ForProvider.LinkedRedisCacheIDRef,
+		Selector:     mg.Spec.ForProvider.LinkedRedisCacheIDSelector,
+		To:           reference.To{Managed: &Redis{}, List: &RedisList{}},
+		Extract:      RedisID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.linkedRedisCacheId")
+	}
+	mg.Spec.ForProvider.LinkedRedisCacheID = rsp.ResolvedValue
+	mg.Spec.ForProvider.LinkedRedisCacheIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RedisExport.
+func (mg *RedisExport) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.redisName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RedisName,
+		Reference:    mg.Spec.ForProvider.RedisNameRef,
+		Selector:     mg.Spec.ForProvider.RedisNameSelector,
+		To:           reference.To{Managed: &Redis{}, List: &RedisList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.redisName")
+	}
+	mg.Spec.ForProvider.RedisName = rsp.ResolvedValue
+	mg.Spec.ForProvider.RedisNameRef = rsp.ResolvedReference
+
 	return nil
 }
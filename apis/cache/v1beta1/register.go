@@ -45,6 +45,42 @@ var (
 	RedisGroupVersionKind = SchemeGroupVersion.WithKind(RedisKind)
 )
 
+// RedisFirewallRule type metadata.
+var (
+	RedisFirewallRuleKind             = reflect.TypeOf(RedisFirewallRule{}).Name()
+	RedisFirewallRuleGroupKind        = schema.GroupKind{Group: Group, Kind: RedisFirewallRuleKind}.String()
+	RedisFirewallRuleKindAPIVersion   = RedisFirewallRuleKind + "." + SchemeGroupVersion.String()
+	RedisFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(RedisFirewallRuleKind)
+)
+
+// RedisExport type metadata.
+var (
+	RedisExportKind             = reflect.TypeOf(RedisExport{}).Name()
+	RedisExportGroupKind        = schema.GroupKind{Group: Group, Kind: RedisExportKind}.String()
+	RedisExportKindAPIVersion   = RedisExportKind + "." + SchemeGroupVersion.String()
+	RedisExportGroupVersionKind = SchemeGroupVersion.WithKind(RedisExportKind)
+)
+
+// RedisLinkedServer type metadata.
+var (
+	RedisLinkedServerKind             = reflect.TypeOf(RedisLinkedServer{}).Name()
+	RedisLinkedServerGroupKind        = schema.GroupKind{Group: Group, Kind: RedisLinkedServerKind}.String()
+	RedisLinkedServerKindAPIVersion   = RedisLinkedServerKind + "." + SchemeGroupVersion.String()
+	RedisLinkedServerGroupVersionKind = SchemeGroupVersion.WithKind(RedisLinkedServerKind)
+)
+
+// RedisPatchSchedule type metadata.
+var (
+	RedisPatchScheduleKind             = reflect.TypeOf(RedisPatchSchedule{}).Name()
+	RedisPatchScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: RedisPatchScheduleKind}.String()
+	RedisPatchScheduleKindAPIVersion   = RedisPatchScheduleKind + "." + SchemeGroupVersion.String()
+	RedisPatchScheduleGroupVersionKind = SchemeGroupVersion.WithKind(RedisPatchScheduleKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Redis{}, &RedisList{})
+	SchemeBuilder.Register(&RedisFirewallRule{}, &RedisFirewallRuleList{})
+	SchemeBuilder.Register(&RedisPatchSchedule{}, &RedisPatchScheduleList{})
+	SchemeBuilder.Register(&RedisLinkedServer{}, &RedisLinkedServerList{})
+	SchemeBuilder.Register(&RedisExport{}, &RedisExportList{})
 }
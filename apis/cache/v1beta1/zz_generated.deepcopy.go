@@ -25,33 +25,567 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AOFPersistence) DeepCopyInto(out *AOFPersistence) {
+	*out = *in
+	out.StorageAccountRef = in.StorageAccountRef
+	if in.SecondaryStorageAccountRef != nil {
+		in, out := &in.SecondaryStorageAccountRef, &out.SecondaryStorageAccountRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AOFPersistence.
+func (in *AOFPersistence) DeepCopy() *AOFPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(AOFPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDBPersistence) DeepCopyInto(out *RDBPersistence) {
+	*out = *in
+	if in.MaxSnapshotCount != nil {
+		in, out := &in.MaxSnapshotCount, &out.MaxSnapshotCount
+		*out = new(int)
+		**out = **in
+	}
+	out.StorageAccountRef = in.StorageAccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDBPersistence.
+func (in *RDBPersistence) DeepCopy() *RDBPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(RDBPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Redis) DeepCopyInto(out *Redis) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
+func (in *Redis) DeepCopy() *Redis {
+	if in == nil {
+		return nil
+	}
+	out := new(Redis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Redis) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisExport) DeepCopyInto(out *RedisExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisExport.
+func (in *RedisExport) DeepCopy() *RedisExport {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisExportList) DeepCopyInto(out *RedisExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisExportList.
+func (in *RedisExportList) DeepCopy() *RedisExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisExportObservation) DeepCopyInto(out *RedisExportObservation) {
+	*out = *in
+	out.LastOperation = in.LastOperation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisExportObservation.
+func (in *RedisExportObservation) DeepCopy() *RedisExportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisExportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisExportParameters) DeepCopyInto(out *RedisExportParameters) {
+	*out = *in
+	if in.RedisNameRef != nil {
+		in, out := &in.RedisNameRef, &out.RedisNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RedisNameSelector != nil {
+		in, out := &in.RedisNameSelector, &out.RedisNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.ContainerRef = in.ContainerRef
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisExportParameters.
+func (in *RedisExportParameters) DeepCopy() *RedisExportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisExportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisExportSpec) DeepCopyInto(out *RedisExportSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisExportSpec.
+func (in *RedisExportSpec) DeepCopy() *RedisExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisExportStatus) DeepCopyInto(out *RedisExportStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisExportStatus.
+func (in *RedisExportStatus) DeepCopy() *RedisExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRule) DeepCopyInto(out *RedisFirewallRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRule.
+func (in *RedisFirewallRule) DeepCopy() *RedisFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisFirewallRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRuleList) DeepCopyInto(out *RedisFirewallRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisFirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRuleList.
+func (in *RedisFirewallRuleList) DeepCopy() *RedisFirewallRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisFirewallRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRuleObservation) DeepCopyInto(out *RedisFirewallRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRuleObservation.
+func (in *RedisFirewallRuleObservation) DeepCopy() *RedisFirewallRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRuleParameters) DeepCopyInto(out *RedisFirewallRuleParameters) {
+	*out = *in
+	if in.RedisNameRef != nil {
+		in, out := &in.RedisNameRef, &out.RedisNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RedisNameSelector != nil {
+		in, out := &in.RedisNameSelector, &out.RedisNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.RedisFirewallRuleProperties = in.RedisFirewallRuleProperties
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRuleParameters.
+func (in *RedisFirewallRuleParameters) DeepCopy() *RedisFirewallRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRuleProperties) DeepCopyInto(out *RedisFirewallRuleProperties) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRuleProperties.
+func (in *RedisFirewallRuleProperties) DeepCopy() *RedisFirewallRuleProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRuleProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRuleSpec) DeepCopyInto(out *RedisFirewallRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRuleSpec.
+func (in *RedisFirewallRuleSpec) DeepCopy() *RedisFirewallRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisFirewallRuleStatus) DeepCopyInto(out *RedisFirewallRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisFirewallRuleStatus.
+func (in *RedisFirewallRuleStatus) DeepCopy() *RedisFirewallRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisFirewallRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLastApplied) DeepCopyInto(out *RedisLastApplied) {
+	*out = *in
+	if in.RedisConfiguration != nil {
+		in, out := &in.RedisConfiguration, &out.RedisConfiguration
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TenantSettings != nil {
+		in, out := &in.TenantSettings, &out.TenantSettings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLastApplied.
+func (in *RedisLastApplied) DeepCopy() *RedisLastApplied {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisLastApplied)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLinkedServer) DeepCopyInto(out *RedisLinkedServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLinkedServer.
+func (in *RedisLinkedServer) DeepCopy() *RedisLinkedServer {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisLinkedServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisLinkedServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLinkedServerList) DeepCopyInto(out *RedisLinkedServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisLinkedServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
-func (in *Redis) DeepCopy() *Redis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLinkedServerList.
+func (in *RedisLinkedServerList) DeepCopy() *RedisLinkedServerList {
 	if in == nil {
 		return nil
 	}
-	out := new(Redis)
+	out := new(RedisLinkedServerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Redis) DeepCopyObject() runtime.Object {
+func (in *RedisLinkedServerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLinkedServerObservation) DeepCopyInto(out *RedisLinkedServerObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLinkedServerObservation.
+func (in *RedisLinkedServerObservation) DeepCopy() *RedisLinkedServerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisLinkedServerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLinkedServerParameters) DeepCopyInto(out *RedisLinkedServerParameters) {
+	*out = *in
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisNameRef != nil {
+		in, out := &in.RedisNameRef, &out.RedisNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RedisNameSelector != nil {
+		in, out := &in.RedisNameSelector, &out.RedisNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkedRedisCacheIDRef != nil {
+		in, out := &in.LinkedRedisCacheIDRef, &out.LinkedRedisCacheIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LinkedRedisCacheIDSelector != nil {
+		in, out := &in.LinkedRedisCacheIDSelector, &out.LinkedRedisCacheIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkedRedisCacheLocation != nil {
+		in, out := &in.LinkedRedisCacheLocation, &out.LinkedRedisCacheLocation
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLinkedServerParameters.
+func (in *RedisLinkedServerParameters) DeepCopy() *RedisLinkedServerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisLinkedServerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLinkedServerSpec) DeepCopyInto(out *RedisLinkedServerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLinkedServerSpec.
+func (in *RedisLinkedServerSpec) DeepCopy() *RedisLinkedServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisLinkedServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisLinkedServerStatus) DeepCopyInto(out *RedisLinkedServerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisLinkedServerStatus.
+func (in *RedisLinkedServerStatus) DeepCopy() *RedisLinkedServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisLinkedServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisList) DeepCopyInto(out *RedisList) {
 	*out = *in
@@ -123,6 +657,16 @@ func (in *RedisParameters) DeepCopyInto(out *RedisParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StaticIP != nil {
 		in, out := &in.StaticIP, &out.StaticIP
 		*out = new(string)
@@ -169,6 +713,11 @@ func (in *RedisParameters) DeepCopyInto(out *RedisParameters) {
 			(*out)[key] = val
 		}
 	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(RedisPersistence)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisParameters.
@@ -181,6 +730,181 @@ func (in *RedisParameters) DeepCopy() *RedisParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPatchSchedule) DeepCopyInto(out *RedisPatchSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPatchSchedule.
+func (in *RedisPatchSchedule) DeepCopy() *RedisPatchSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPatchSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisPatchSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPatchScheduleList) DeepCopyInto(out *RedisPatchScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisPatchSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPatchScheduleList.
+func (in *RedisPatchScheduleList) DeepCopy() *RedisPatchScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPatchScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisPatchScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPatchScheduleObservation) DeepCopyInto(out *RedisPatchScheduleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPatchScheduleObservation.
+func (in *RedisPatchScheduleObservation) DeepCopy() *RedisPatchScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPatchScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPatchScheduleParameters) DeepCopyInto(out *RedisPatchScheduleParameters) {
+	*out = *in
+	if in.RedisNameRef != nil {
+		in, out := &in.RedisNameRef, &out.RedisNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RedisNameSelector != nil {
+		in, out := &in.RedisNameSelector, &out.RedisNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduleEntries != nil {
+		in, out := &in.ScheduleEntries, &out.ScheduleEntries
+		*out = make([]ScheduleEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPatchScheduleParameters.
+func (in *RedisPatchScheduleParameters) DeepCopy() *RedisPatchScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPatchScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPatchScheduleSpec) DeepCopyInto(out *RedisPatchScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPatchScheduleSpec.
+func (in *RedisPatchScheduleSpec) DeepCopy() *RedisPatchScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPatchScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPatchScheduleStatus) DeepCopyInto(out *RedisPatchScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPatchScheduleStatus.
+func (in *RedisPatchScheduleStatus) DeepCopy() *RedisPatchScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPatchScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPersistence) DeepCopyInto(out *RedisPersistence) {
+	*out = *in
+	if in.RDB != nil {
+		in, out := &in.RDB, &out.RDB
+		*out = new(RDBPersistence)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AOF != nil {
+		in, out := &in.AOF, &out.AOF
+		*out = new(AOFPersistence)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPersistence.
+func (in *RedisPersistence) DeepCopy() *RedisPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
 	*out = *in
@@ -203,6 +927,7 @@ func (in *RedisStatus) DeepCopyInto(out *RedisStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	in.LastApplied.DeepCopyInto(&out.LastApplied)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStatus.
@@ -229,3 +954,28 @@ func (in *SKU) DeepCopy() *SKU {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleEntry) DeepCopyInto(out *ScheduleEntry) {
+	*out = *in
+	if in.StartHourUTC != nil {
+		in, out := &in.StartHourUTC, &out.StartHourUTC
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleEntry.
+func (in *ScheduleEntry) DeepCopy() *ScheduleEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleEntry)
+	in.DeepCopyInto(out)
+	return out
+}
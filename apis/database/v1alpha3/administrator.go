@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AdministratorTypeActiveDirectory is the only type of external administrator
+// Azure SQL servers support.
+const AdministratorTypeActiveDirectory = "ActiveDirectory"
+
+// An ActiveDirectoryAdministratorObservation represents the observed state of
+// the Azure AD administrator of an Azure SQL server.
+type ActiveDirectoryAdministratorObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+}
+
+// An ActiveDirectoryAdministratorStatus represents the status of the Azure AD
+// administrator of an Azure SQL server.
+type ActiveDirectoryAdministratorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ActiveDirectoryAdministratorObservation `json:"atProvider,omitempty"`
+}
+
+// ActiveDirectoryAdministratorParameters define the desired state of the
+// Azure AD administrator of an Azure SQL server.
+type ActiveDirectoryAdministratorParameters struct {
+	// ServerName - Name of the administrator's server.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the administrator's server.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the administrator's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Login - The login name of the Azure AD user or group.
+	Login string `json:"login"`
+
+	// ObjectID - The object ID of the Azure AD user or group, i.e. the SID of
+	// the administrator.
+	ObjectID string `json:"objectId"`
+
+	// TenantID - The ID of the Azure AD tenant of the user or group.
+	TenantID string `json:"tenantId"`
+}
+
+// An ActiveDirectoryAdministratorSpec defines the desired state of the Azure
+// AD administrator of an Azure SQL server.
+type ActiveDirectoryAdministratorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ActiveDirectoryAdministratorParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MySQLServerActiveDirectoryAdministrator is a managed resource that
+// represents the Azure AD administrator of an Azure MySQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOGIN",type="string",JSONPath=".spec.forProvider.login"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MySQLServerActiveDirectoryAdministrator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActiveDirectoryAdministratorSpec   `json:"spec"`
+	Status ActiveDirectoryAdministratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLServerActiveDirectoryAdministratorList contains a list of
+// MySQLServerActiveDirectoryAdministrator.
+type MySQLServerActiveDirectoryAdministratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLServerActiveDirectoryAdministrator `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLServerActiveDirectoryAdministrator is a managed resource that
+// represents the Azure AD administrator of an Azure PostgreSQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOGIN",type="string",JSONPath=".spec.forProvider.login"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLServerActiveDirectoryAdministrator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActiveDirectoryAdministratorSpec   `json:"spec"`
+	Status ActiveDirectoryAdministratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLServerActiveDirectoryAdministratorList contains a list of
+// PostgreSQLServerActiveDirectoryAdministrator.
+type PostgreSQLServerActiveDirectoryAdministratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLServerActiveDirectoryAdministrator `json:"items"`
+}
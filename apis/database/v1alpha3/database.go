@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DatabaseObservation represents the observed state of a database in an
+// Azure SQL server.
+type DatabaseObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+}
+
+// A DatabaseStatus represents the status of a database in an Azure SQL
+// server.
+type DatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// DatabaseParameters define the desired state of a database in an Azure SQL
+// server.
+type DatabaseParameters struct {
+	// ServerName - Name of the Database's server.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the Database's server. The endpoint,
+	// user and password the server publishes to its connection secret are
+	// published to the Database's connection secret when it is set.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the Database's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Charset - The charset of the database.
+	// +immutable
+	// +optional
+	Charset *string `json:"charset,omitempty"`
+
+	// Collation - The collation of the database.
+	// +immutable
+	// +optional
+	Collation *string `json:"collation,omitempty"`
+}
+
+// A DatabaseSpec defines the desired state of a database in an Azure SQL
+// server.
+type DatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatabaseParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MySQLServerDatabase is a managed resource that represents a database in
+// an Azure MySQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MySQLServerDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseSpec   `json:"spec"`
+	Status DatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLServerDatabaseList contains a list of MySQLServerDatabase.
+type MySQLServerDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLServerDatabase `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLServerDatabase is a managed resource that represents a database
+// in an Azure PostgreSQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLServerDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseSpec   `json:"spec"`
+	Status DatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLServerDatabaseList contains a list of PostgreSQLServerDatabase.
+type PostgreSQLServerDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLServerDatabase `json:"items"`
+}
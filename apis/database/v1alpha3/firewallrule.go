@@ -133,3 +133,57 @@ type PostgreSQLServerFirewallRuleList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []PostgreSQLServerFirewallRule `json:"items"`
 }
+
+// +kubebuilder:object:root=true
+
+// A MariaDBServerFirewallRule is a managed resource that represents an Azure
+// MariaDB firewall rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MariaDBServerFirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallRuleSpec   `json:"spec"`
+	Status FirewallRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MariaDBServerFirewallRuleList contains a list of MariaDBServerFirewallRule.
+type MariaDBServerFirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MariaDBServerFirewallRule `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLFirewallRule is a managed resource that represents an Azure SQL
+// server firewall rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLFirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallRuleSpec   `json:"spec"`
+	Status FirewallRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLFirewallRuleList contains a list of MSSQLFirewallRule.
+type MSSQLFirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLFirewallRule `json:"items"`
+}
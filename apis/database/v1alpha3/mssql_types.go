@@ -0,0 +1,459 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+)
+
+// States of Azure SQL resources in which they are ready to use.
+const (
+	MSSQLServerStateReady      = "Ready"
+	MSSQLDatabaseStatusOnline  = "Online"
+	MSSQLElasticPoolStateReady = "Ready"
+)
+
+// An MSSQLSKU is the SKU of an Azure SQL database or elastic pool. DTU
+// based SKUs are selected by name alone, e.g. S0 or StandardPool, while
+// vCore based SKUs combine a name such as GP_Gen5 with a capacity.
+type MSSQLSKU struct {
+	// Name - The name of the SKU, e.g. Basic, S0, P1, GP_Gen5, BC_Gen5 or
+	// StandardPool.
+	Name string `json:"name"`
+
+	// Tier - The tier or edition of the SKU, e.g. Basic, Standard, Premium,
+	// GeneralPurpose or BusinessCritical.
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// Family - The hardware generation of vCore based SKUs, e.g. Gen5.
+	// +optional
+	Family *string `json:"family,omitempty"`
+
+	// Capacity - The number of DTUs or vCores of the SKU.
+	// +optional
+	Capacity *int `json:"capacity,omitempty"`
+}
+
+// MSSQLServerParameters define the desired state of an Azure SQL server.
+type MSSQLServerParameters struct {
+	// ResourceGroupName - Name of the server's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location of the server.
+	// +immutable
+	Location string `json:"location"`
+
+	// AdministratorLogin - The administrator login name of the server.
+	// +immutable
+	AdministratorLogin string `json:"administratorLogin"`
+
+	// AdministratorLoginPasswordSecretRef references the key of a secret
+	// that holds the administrator login password. The password is
+	// generated if no secret is referenced. Changes to the referenced
+	// password are pushed to the server.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
+
+	// Version - The version of the server, e.g. 12.0.
+	// +immutable
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// MinimalTLSVersion - The minimal TLS version clients must use.
+	// Possible values include: '1.0', '1.1', '1.2'
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2"
+	// +optional
+	MinimalTLSVersion *string `json:"minimalTlsVersion,omitempty"`
+
+	// PublicNetworkAccess - Whether the server can be accessed over its
+	// public endpoint. Possible values include: 'Enabled', 'Disabled'
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An MSSQLServerSpec defines the desired state of an MSSQLServer.
+type MSSQLServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MSSQLServerParameters `json:"forProvider"`
+}
+
+// An MSSQLServerObservation represents the observed state of an Azure SQL
+// server.
+type MSSQLServerObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Name - Resource name.
+	Name string `json:"name,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// State - The state of the server.
+	State string `json:"state,omitempty"`
+
+	// FullyQualifiedDomainName - The fully qualified domain name of the
+	// server.
+	FullyQualifiedDomainName string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// An MSSQLServerStatus represents the observed state of an MSSQLServer.
+type MSSQLServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MSSQLServerObservation `json:"atProvider,omitempty"`
+
+	// AdministratorLoginPasswordHash is the hash of the administrator login
+	// password that was last pushed to the server, keyed with
+	// AdministratorLoginPasswordSalt.
+	AdministratorLoginPasswordHash string `json:"administratorLoginPasswordHash,omitempty"`
+
+	// AdministratorLoginPasswordSalt is the random salt with which the
+	// administrator login password of the server is hashed.
+	AdministratorLoginPasswordSalt string `json:"administratorLoginPasswordSalt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLServer is a managed resource that represents an Azure SQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLServerSpec   `json:"spec"`
+	Status MSSQLServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLServerList contains a list of MSSQLServer.
+type MSSQLServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLServer `json:"items"`
+}
+
+// MSSQLDatabaseParameters define the desired state of a database in an Azure
+// SQL server.
+type MSSQLDatabaseParameters struct {
+	// ServerName - Name of the database's server.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the database's MSSQLServer. The
+	// endpoint, user and password the server publishes to its connection
+	// secret are published to the database's connection secret when it is
+	// set.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects an MSSQLServer to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the database's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location of the database. It must be the location of
+	// its server.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU - The SKU of the database. It is ignored while the database is a
+	// member of an elastic pool.
+	// +optional
+	SKU *MSSQLSKU `json:"sku,omitempty"`
+
+	// ElasticPoolID - The ID of the elastic pool the database is a member of.
+	// +optional
+	ElasticPoolID *string `json:"elasticPoolId,omitempty"`
+
+	// ElasticPoolIDRef - A reference to an MSSQLElasticPool to retrieve its
+	// ID.
+	// +optional
+	ElasticPoolIDRef *xpv1.Reference `json:"elasticPoolIdRef,omitempty"`
+
+	// ElasticPoolIDSelector - Selects an MSSQLElasticPool to reference.
+	// +optional
+	ElasticPoolIDSelector *xpv1.Selector `json:"elasticPoolIdSelector,omitempty"`
+
+	// Collation - The collation of the database.
+	// +immutable
+	// +optional
+	Collation *string `json:"collation,omitempty"`
+
+	// MaxSizeBytes - The maximum size of the database in bytes.
+	// +optional
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+
+	// ZoneRedundant - Whether the replicas of the database are spread across
+	// availability zones.
+	// +optional
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+
+	// LicenseType - The license type of vCore based databases. Possible
+	// values include: 'LicenseIncluded', 'BasePrice'
+	// +kubebuilder:validation:Enum=LicenseIncluded;BasePrice
+	// +optional
+	LicenseType *string `json:"licenseType,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An MSSQLDatabaseSpec defines the desired state of an MSSQLDatabase.
+type MSSQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MSSQLDatabaseParameters `json:"forProvider"`
+}
+
+// An MSSQLDatabaseObservation represents the observed state of a database in
+// an Azure SQL server.
+type MSSQLDatabaseObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Name - Resource name.
+	Name string `json:"name,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// Status - The status of the database.
+	Status string `json:"status,omitempty"`
+
+	// DatabaseID - The ID of the database within its server.
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// CurrentServiceObjectiveName - The current service level objective of
+	// the database.
+	CurrentServiceObjectiveName string `json:"currentServiceObjectiveName,omitempty"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// An MSSQLDatabaseStatus represents the observed state of an MSSQLDatabase.
+type MSSQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MSSQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLDatabase is a managed resource that represents a database in an
+// Azure SQL server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLDatabaseSpec   `json:"spec"`
+	Status MSSQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLDatabaseList contains a list of MSSQLDatabase.
+type MSSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLDatabase `json:"items"`
+}
+
+// MSSQLElasticPoolPerDatabaseSettings bound the capacity each database of an
+// elastic pool may use. Capacities are DTUs or vCores, depending on the SKU
+// of the pool, and may be fractional for vCore based pools.
+type MSSQLElasticPoolPerDatabaseSettings struct {
+	// MinCapacity - The minimum capacity all databases are guaranteed.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MinCapacity *string `json:"minCapacity,omitempty"`
+
+	// MaxCapacity - The maximum capacity any one database can consume.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MaxCapacity *string `json:"maxCapacity,omitempty"`
+}
+
+// MSSQLElasticPoolParameters define the desired state of an Azure SQL elastic
+// pool.
+type MSSQLElasticPoolParameters struct {
+	// ServerName - Name of the elastic pool's server.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the elastic pool's MSSQLServer.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects an MSSQLServer to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the elastic pool's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The location of the elastic pool. It must be the location
+	// of its server.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU - The SKU of the elastic pool, e.g. StandardPool with a capacity
+	// of 100 eDTUs or GP_Gen5 with a capacity of 2 vCores.
+	SKU MSSQLSKU `json:"sku"`
+
+	// MaxSizeBytes - The storage limit of the elastic pool in bytes.
+	// +optional
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+
+	// PerDatabaseSettings - The capacity bounds of the databases in the
+	// elastic pool.
+	// +optional
+	PerDatabaseSettings *MSSQLElasticPoolPerDatabaseSettings `json:"perDatabaseSettings,omitempty"`
+
+	// ZoneRedundant - Whether the replicas of the elastic pool are spread
+	// across availability zones.
+	// +optional
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+
+	// LicenseType - The license type of vCore based elastic pools. Possible
+	// values include: 'LicenseIncluded', 'BasePrice'
+	// +kubebuilder:validation:Enum=LicenseIncluded;BasePrice
+	// +optional
+	LicenseType *string `json:"licenseType,omitempty"`
+
+	// Tags - Application-specific metadata in the form of key-value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An MSSQLElasticPoolSpec defines the desired state of an MSSQLElasticPool.
+type MSSQLElasticPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MSSQLElasticPoolParameters `json:"forProvider"`
+}
+
+// An MSSQLElasticPoolObservation represents the observed state of an Azure
+// SQL elastic pool.
+type MSSQLElasticPoolObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Name - Resource name.
+	Name string `json:"name,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// State - The state of the elastic pool.
+	State string `json:"state,omitempty"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// An MSSQLElasticPoolStatus represents the observed state of an
+// MSSQLElasticPool.
+type MSSQLElasticPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MSSQLElasticPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An MSSQLElasticPool is a managed resource that represents an Azure SQL
+// elastic pool.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MSSQLElasticPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MSSQLElasticPoolSpec   `json:"spec"`
+	Status MSSQLElasticPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MSSQLElasticPoolList contains a list of MSSQLElasticPool.
+type MSSQLElasticPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MSSQLElasticPool `json:"items"`
+}
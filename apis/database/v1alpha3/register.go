@@ -53,6 +53,14 @@ var (
 	PostgreSQLServerVirtualNetworkRuleGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerVirtualNetworkRuleKind)
 )
 
+// MariaDBServerVirtualNetworkRule type metadata.
+var (
+	MariaDBServerVirtualNetworkRuleKind             = reflect.TypeOf(MariaDBServerVirtualNetworkRule{}).Name()
+	MariaDBServerVirtualNetworkRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MariaDBServerVirtualNetworkRuleKind}.String()
+	MariaDBServerVirtualNetworkRuleKindAPIVersion   = MariaDBServerVirtualNetworkRuleKind + "." + SchemeGroupVersion.String()
+	MariaDBServerVirtualNetworkRuleGroupVersionKind = SchemeGroupVersion.WithKind(MariaDBServerVirtualNetworkRuleKind)
+)
+
 // MySQLServerFirewallRule type metadata.
 var (
 	MySQLServerFirewallRuleKind             = reflect.TypeOf(MySQLServerFirewallRule{}).Name()
@@ -69,6 +77,110 @@ var (
 	PostgreSQLServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerFirewallRuleKind)
 )
 
+// MariaDBServerFirewallRule type metadata.
+var (
+	MariaDBServerFirewallRuleKind             = reflect.TypeOf(MariaDBServerFirewallRule{}).Name()
+	MariaDBServerFirewallRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MariaDBServerFirewallRuleKind}.String()
+	MariaDBServerFirewallRuleKindAPIVersion   = MariaDBServerFirewallRuleKind + "." + SchemeGroupVersion.String()
+	MariaDBServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(MariaDBServerFirewallRuleKind)
+)
+
+// MySQLServerConfiguration type metadata.
+var (
+	MySQLServerConfigurationKind             = reflect.TypeOf(MySQLServerConfiguration{}).Name()
+	MySQLServerConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLServerConfigurationKind}.String()
+	MySQLServerConfigurationKindAPIVersion   = MySQLServerConfigurationKind + "." + SchemeGroupVersion.String()
+	MySQLServerConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(MySQLServerConfigurationKind)
+)
+
+// PostgreSQLServerConfiguration type metadata.
+var (
+	PostgreSQLServerConfigurationKind             = reflect.TypeOf(PostgreSQLServerConfiguration{}).Name()
+	PostgreSQLServerConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLServerConfigurationKind}.String()
+	PostgreSQLServerConfigurationKindAPIVersion   = PostgreSQLServerConfigurationKind + "." + SchemeGroupVersion.String()
+	PostgreSQLServerConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerConfigurationKind)
+)
+
+// MySQLServerDatabase type metadata.
+var (
+	MySQLServerDatabaseKind             = reflect.TypeOf(MySQLServerDatabase{}).Name()
+	MySQLServerDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLServerDatabaseKind}.String()
+	MySQLServerDatabaseKindAPIVersion   = MySQLServerDatabaseKind + "." + SchemeGroupVersion.String()
+	MySQLServerDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(MySQLServerDatabaseKind)
+)
+
+// PostgreSQLServerDatabase type metadata.
+var (
+	PostgreSQLServerDatabaseKind             = reflect.TypeOf(PostgreSQLServerDatabase{}).Name()
+	PostgreSQLServerDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLServerDatabaseKind}.String()
+	PostgreSQLServerDatabaseKindAPIVersion   = PostgreSQLServerDatabaseKind + "." + SchemeGroupVersion.String()
+	PostgreSQLServerDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerDatabaseKind)
+)
+
+// MySQLServerActiveDirectoryAdministrator type metadata.
+var (
+	MySQLServerActiveDirectoryAdministratorKind             = reflect.TypeOf(MySQLServerActiveDirectoryAdministrator{}).Name()
+	MySQLServerActiveDirectoryAdministratorGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLServerActiveDirectoryAdministratorKind}.String()
+	MySQLServerActiveDirectoryAdministratorKindAPIVersion   = MySQLServerActiveDirectoryAdministratorKind + "." + SchemeGroupVersion.String()
+	MySQLServerActiveDirectoryAdministratorGroupVersionKind = SchemeGroupVersion.WithKind(MySQLServerActiveDirectoryAdministratorKind)
+)
+
+// PostgreSQLServerActiveDirectoryAdministrator type metadata.
+var (
+	PostgreSQLServerActiveDirectoryAdministratorKind             = reflect.TypeOf(PostgreSQLServerActiveDirectoryAdministrator{}).Name()
+	PostgreSQLServerActiveDirectoryAdministratorGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLServerActiveDirectoryAdministratorKind}.String()
+	PostgreSQLServerActiveDirectoryAdministratorKindAPIVersion   = PostgreSQLServerActiveDirectoryAdministratorKind + "." + SchemeGroupVersion.String()
+	PostgreSQLServerActiveDirectoryAdministratorGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerActiveDirectoryAdministratorKind)
+)
+
+// MySQLServerKey type metadata.
+var (
+	MySQLServerKeyKind             = reflect.TypeOf(MySQLServerKey{}).Name()
+	MySQLServerKeyGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLServerKeyKind}.String()
+	MySQLServerKeyKindAPIVersion   = MySQLServerKeyKind + "." + SchemeGroupVersion.String()
+	MySQLServerKeyGroupVersionKind = SchemeGroupVersion.WithKind(MySQLServerKeyKind)
+)
+
+// PostgreSQLServerKey type metadata.
+var (
+	PostgreSQLServerKeyKind             = reflect.TypeOf(PostgreSQLServerKey{}).Name()
+	PostgreSQLServerKeyGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLServerKeyKind}.String()
+	PostgreSQLServerKeyKindAPIVersion   = PostgreSQLServerKeyKind + "." + SchemeGroupVersion.String()
+	PostgreSQLServerKeyGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerKeyKind)
+)
+
+// MSSQLServer type metadata.
+var (
+	MSSQLServerKind             = reflect.TypeOf(MSSQLServer{}).Name()
+	MSSQLServerGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLServerKind}.String()
+	MSSQLServerKindAPIVersion   = MSSQLServerKind + "." + SchemeGroupVersion.String()
+	MSSQLServerGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLServerKind)
+)
+
+// MSSQLDatabase type metadata.
+var (
+	MSSQLDatabaseKind             = reflect.TypeOf(MSSQLDatabase{}).Name()
+	MSSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLDatabaseKind}.String()
+	MSSQLDatabaseKindAPIVersion   = MSSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	MSSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLDatabaseKind)
+)
+
+// MSSQLElasticPool type metadata.
+var (
+	MSSQLElasticPoolKind             = reflect.TypeOf(MSSQLElasticPool{}).Name()
+	MSSQLElasticPoolGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLElasticPoolKind}.String()
+	MSSQLElasticPoolKindAPIVersion   = MSSQLElasticPoolKind + "." + SchemeGroupVersion.String()
+	MSSQLElasticPoolGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLElasticPoolKind)
+)
+
+// MSSQLFirewallRule type metadata.
+var (
+	MSSQLFirewallRuleKind             = reflect.TypeOf(MSSQLFirewallRule{}).Name()
+	MSSQLFirewallRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MSSQLFirewallRuleKind}.String()
+	MSSQLFirewallRuleKindAPIVersion   = MSSQLFirewallRuleKind + "." + SchemeGroupVersion.String()
+	MSSQLFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(MSSQLFirewallRuleKind)
+)
+
 // CosmosDBAccount type metadata.
 var (
 	CosmosDBAccountKind             = reflect.TypeOf(CosmosDBAccount{}).Name()
@@ -80,7 +192,21 @@ var (
 func init() {
 	SchemeBuilder.Register(&MySQLServerVirtualNetworkRule{}, &MySQLServerVirtualNetworkRuleList{})
 	SchemeBuilder.Register(&PostgreSQLServerVirtualNetworkRule{}, &PostgreSQLServerVirtualNetworkRuleList{})
+	SchemeBuilder.Register(&MariaDBServerVirtualNetworkRule{}, &MariaDBServerVirtualNetworkRuleList{})
 	SchemeBuilder.Register(&MySQLServerFirewallRule{}, &MySQLServerFirewallRuleList{})
 	SchemeBuilder.Register(&PostgreSQLServerFirewallRule{}, &PostgreSQLServerFirewallRuleList{})
+	SchemeBuilder.Register(&MariaDBServerFirewallRule{}, &MariaDBServerFirewallRuleList{})
+	SchemeBuilder.Register(&MySQLServerConfiguration{}, &MySQLServerConfigurationList{})
+	SchemeBuilder.Register(&PostgreSQLServerConfiguration{}, &PostgreSQLServerConfigurationList{})
+	SchemeBuilder.Register(&MySQLServerDatabase{}, &MySQLServerDatabaseList{})
+	SchemeBuilder.Register(&PostgreSQLServerDatabase{}, &PostgreSQLServerDatabaseList{})
+	SchemeBuilder.Register(&MySQLServerActiveDirectoryAdministrator{}, &MySQLServerActiveDirectoryAdministratorList{})
+	SchemeBuilder.Register(&PostgreSQLServerActiveDirectoryAdministrator{}, &PostgreSQLServerActiveDirectoryAdministratorList{})
+	SchemeBuilder.Register(&MySQLServerKey{}, &MySQLServerKeyList{})
+	SchemeBuilder.Register(&PostgreSQLServerKey{}, &PostgreSQLServerKeyList{})
+	SchemeBuilder.Register(&MSSQLServer{}, &MSSQLServerList{})
+	SchemeBuilder.Register(&MSSQLDatabase{}, &MSSQLDatabaseList{})
+	SchemeBuilder.Register(&MSSQLElasticPool{}, &MSSQLElasticPoolList{})
+	SchemeBuilder.Register(&MSSQLFirewallRule{}, &MSSQLFirewallRuleList{})
 	SchemeBuilder.Register(&CosmosDBAccount{}, &CosmosDBAccountList{})
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServerKeyTypeAzureKeyVault is the only type of key Azure SQL servers
+// support for data encryption.
+const ServerKeyTypeAzureKeyVault = "AzureKeyVault"
+
+// A ServerKeyObservation represents the observed state of an Azure SQL server
+// key.
+type ServerKeyObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Name - The name of the key, derived from its URI.
+	Name string `json:"name,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+
+	// CreationDate - The key creation date.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// A ServerKeyStatus represents the status of an Azure SQL server key.
+type ServerKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServerKeyObservation `json:"atProvider,omitempty"`
+}
+
+// ServerKeyParameters define the desired state of an Azure SQL server key.
+type ServerKeyParameters struct {
+	// ServerName - Name of the key's server.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the key's server.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// ResourceGroupName - Name of the key's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// KeyURI - The versioned URI of the Azure Key Vault key used to encrypt
+	// the data of the server, e.g.
+	// https://example.vault.azure.net/keys/example/0123456789abcdef0123456789abcdef.
+	// The server must have a system assigned identity that is allowed to
+	// get, wrap and unwrap the key. Setting the URI of another key version
+	// rotates the key.
+	// +kubebuilder:validation:Pattern=`^https://[^/.]+\.[^/]+/keys/[^/]+/[^/]+$`
+	KeyURI string `json:"keyUri"`
+}
+
+// A ServerKeySpec defines the desired state of an Azure SQL server key.
+type ServerKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerKeyParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MySQLServerKey is a managed resource that represents the customer managed
+// key an Azure MySQL server encrypts its data with.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MySQLServerKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerKeySpec   `json:"spec"`
+	Status ServerKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLServerKeyList contains a list of MySQLServerKey.
+type MySQLServerKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLServerKey `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLServerKey is a managed resource that represents the customer
+// managed key an Azure PostgreSQL server encrypts its data with.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLServerKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerKeySpec   `json:"spec"`
+	Status ServerKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLServerKeyList contains a list of PostgreSQLServerKey.
+type PostgreSQLServerKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLServerKey `json:"items"`
+}
@@ -25,6 +25,174 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveDirectoryAdministratorObservation) DeepCopyInto(out *ActiveDirectoryAdministratorObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDirectoryAdministratorObservation.
+func (in *ActiveDirectoryAdministratorObservation) DeepCopy() *ActiveDirectoryAdministratorObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDirectoryAdministratorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveDirectoryAdministratorParameters) DeepCopyInto(out *ActiveDirectoryAdministratorParameters) {
+	*out = *in
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDirectoryAdministratorParameters.
+func (in *ActiveDirectoryAdministratorParameters) DeepCopy() *ActiveDirectoryAdministratorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDirectoryAdministratorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveDirectoryAdministratorSpec) DeepCopyInto(out *ActiveDirectoryAdministratorSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDirectoryAdministratorSpec.
+func (in *ActiveDirectoryAdministratorSpec) DeepCopy() *ActiveDirectoryAdministratorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDirectoryAdministratorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveDirectoryAdministratorStatus) DeepCopyInto(out *ActiveDirectoryAdministratorStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDirectoryAdministratorStatus.
+func (in *ActiveDirectoryAdministratorStatus) DeepCopy() *ActiveDirectoryAdministratorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDirectoryAdministratorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationObservation) DeepCopyInto(out *ConfigurationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationObservation.
+func (in *ConfigurationObservation) DeepCopy() *ConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationParameters) DeepCopyInto(out *ConfigurationParameters) {
+	*out = *in
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationParameters.
+func (in *ConfigurationParameters) DeepCopy() *ConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
+func (in *ConfigurationSpec) DeepCopy() *ConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationStatus) DeepCopyInto(out *ConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationStatus.
+func (in *ConfigurationStatus) DeepCopy() *ConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CosmosDBAccount) DeepCopyInto(out *CosmosDBAccount) {
 	*out = *in
@@ -255,6 +423,100 @@ func (in *CosmosDBAccountStatus) DeepCopy() *CosmosDBAccountStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseObservation) DeepCopyInto(out *DatabaseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseObservation.
+func (in *DatabaseObservation) DeepCopy() *DatabaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseParameters) DeepCopyInto(out *DatabaseParameters) {
+	*out = *in
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Charset != nil {
+		in, out := &in.Charset, &out.Charset
+		*out = new(string)
+		**out = **in
+	}
+	if in.Collation != nil {
+		in, out := &in.Collation, &out.Collation
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseParameters.
+func (in *DatabaseParameters) DeepCopy() *DatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
+func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallRuleObservation) DeepCopyInto(out *FirewallRuleObservation) {
 	*out = *in
@@ -356,7 +618,7 @@ func (in *FirewallRuleStatus) DeepCopy() *FirewallRuleStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLServerFirewallRule) DeepCopyInto(out *MySQLServerFirewallRule) {
+func (in *MSSQLDatabase) DeepCopyInto(out *MSSQLDatabase) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -364,18 +626,18 @@ func (in *MySQLServerFirewallRule) DeepCopyInto(out *MySQLServerFirewallRule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerFirewallRule.
-func (in *MySQLServerFirewallRule) DeepCopy() *MySQLServerFirewallRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLDatabase.
+func (in *MSSQLDatabase) DeepCopy() *MSSQLDatabase {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLServerFirewallRule)
+	out := new(MSSQLDatabase)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLServerFirewallRule) DeepCopyObject() runtime.Object {
+func (in *MSSQLDatabase) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -383,31 +645,31 @@ func (in *MySQLServerFirewallRule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLServerFirewallRuleList) DeepCopyInto(out *MySQLServerFirewallRuleList) {
+func (in *MSSQLDatabaseList) DeepCopyInto(out *MSSQLDatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MySQLServerFirewallRule, len(*in))
+		*out = make([]MSSQLDatabase, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerFirewallRuleList.
-func (in *MySQLServerFirewallRuleList) DeepCopy() *MySQLServerFirewallRuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLDatabaseList.
+func (in *MSSQLDatabaseList) DeepCopy() *MSSQLDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLServerFirewallRuleList)
+	out := new(MSSQLDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLServerFirewallRuleList) DeepCopyObject() runtime.Object {
+func (in *MSSQLDatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -415,58 +677,1151 @@ func (in *MySQLServerFirewallRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLServerVirtualNetworkRule) DeepCopyInto(out *MySQLServerVirtualNetworkRule) {
+func (in *MSSQLDatabaseObservation) DeepCopyInto(out *MSSQLDatabaseObservation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.LastOperation = in.LastOperation
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerVirtualNetworkRule.
-func (in *MySQLServerVirtualNetworkRule) DeepCopy() *MySQLServerVirtualNetworkRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLDatabaseObservation.
+func (in *MSSQLDatabaseObservation) DeepCopy() *MSSQLDatabaseObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLServerVirtualNetworkRule)
+	out := new(MSSQLDatabaseObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLServerVirtualNetworkRule) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLServerVirtualNetworkRuleList) DeepCopyInto(out *MySQLServerVirtualNetworkRuleList) {
+func (in *MSSQLDatabaseParameters) DeepCopyInto(out *MSSQLDatabaseParameters) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MySQLServerVirtualNetworkRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerVirtualNetworkRuleList.
-func (in *MySQLServerVirtualNetworkRuleList) DeepCopy() *MySQLServerVirtualNetworkRuleList {
-	if in == nil {
-		return nil
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SKU != nil {
+		in, out := &in.SKU, &out.SKU
+		*out = new(MSSQLSKU)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticPoolID != nil {
+		in, out := &in.ElasticPoolID, &out.ElasticPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticPoolIDRef != nil {
+		in, out := &in.ElasticPoolIDRef, &out.ElasticPoolIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ElasticPoolIDSelector != nil {
+		in, out := &in.ElasticPoolIDSelector, &out.ElasticPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Collation != nil {
+		in, out := &in.Collation, &out.Collation
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSizeBytes != nil {
+		in, out := &in.MaxSizeBytes, &out.MaxSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ZoneRedundant != nil {
+		in, out := &in.ZoneRedundant, &out.ZoneRedundant
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LicenseType != nil {
+		in, out := &in.LicenseType, &out.LicenseType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLDatabaseParameters.
+func (in *MSSQLDatabaseParameters) DeepCopy() *MSSQLDatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLDatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLDatabaseSpec) DeepCopyInto(out *MSSQLDatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLDatabaseSpec.
+func (in *MSSQLDatabaseSpec) DeepCopy() *MSSQLDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLDatabaseStatus) DeepCopyInto(out *MSSQLDatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLDatabaseStatus.
+func (in *MSSQLDatabaseStatus) DeepCopy() *MSSQLDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPool) DeepCopyInto(out *MSSQLElasticPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPool.
+func (in *MSSQLElasticPool) DeepCopy() *MSSQLElasticPool {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MSSQLElasticPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPoolList) DeepCopyInto(out *MSSQLElasticPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MSSQLElasticPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPoolList.
+func (in *MSSQLElasticPoolList) DeepCopy() *MSSQLElasticPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MSSQLElasticPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPoolObservation) DeepCopyInto(out *MSSQLElasticPoolObservation) {
+	*out = *in
+	out.LastOperation = in.LastOperation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPoolObservation.
+func (in *MSSQLElasticPoolObservation) DeepCopy() *MSSQLElasticPoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPoolParameters) DeepCopyInto(out *MSSQLElasticPoolParameters) {
+	*out = *in
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SKU.DeepCopyInto(&out.SKU)
+	if in.MaxSizeBytes != nil {
+		in, out := &in.MaxSizeBytes, &out.MaxSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PerDatabaseSettings != nil {
+		in, out := &in.PerDatabaseSettings, &out.PerDatabaseSettings
+		*out = new(MSSQLElasticPoolPerDatabaseSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneRedundant != nil {
+		in, out := &in.ZoneRedundant, &out.ZoneRedundant
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LicenseType != nil {
+		in, out := &in.LicenseType, &out.LicenseType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPoolParameters.
+func (in *MSSQLElasticPoolParameters) DeepCopy() *MSSQLElasticPoolParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPoolPerDatabaseSettings) DeepCopyInto(out *MSSQLElasticPoolPerDatabaseSettings) {
+	*out = *in
+	if in.MinCapacity != nil {
+		in, out := &in.MinCapacity, &out.MinCapacity
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPoolPerDatabaseSettings.
+func (in *MSSQLElasticPoolPerDatabaseSettings) DeepCopy() *MSSQLElasticPoolPerDatabaseSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPoolPerDatabaseSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPoolSpec) DeepCopyInto(out *MSSQLElasticPoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPoolSpec.
+func (in *MSSQLElasticPoolSpec) DeepCopy() *MSSQLElasticPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLElasticPoolStatus) DeepCopyInto(out *MSSQLElasticPoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLElasticPoolStatus.
+func (in *MSSQLElasticPoolStatus) DeepCopy() *MSSQLElasticPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLElasticPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLFirewallRule) DeepCopyInto(out *MSSQLFirewallRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLFirewallRule.
+func (in *MSSQLFirewallRule) DeepCopy() *MSSQLFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MSSQLFirewallRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLFirewallRuleList) DeepCopyInto(out *MSSQLFirewallRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MSSQLFirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLFirewallRuleList.
+func (in *MSSQLFirewallRuleList) DeepCopy() *MSSQLFirewallRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLFirewallRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MSSQLFirewallRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLSKU) DeepCopyInto(out *MSSQLSKU) {
+	*out = *in
+	if in.Tier != nil {
+		in, out := &in.Tier, &out.Tier
+		*out = new(string)
+		**out = **in
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(string)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLSKU.
+func (in *MSSQLSKU) DeepCopy() *MSSQLSKU {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLSKU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServer) DeepCopyInto(out *MSSQLServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServer.
+func (in *MSSQLServer) DeepCopy() *MSSQLServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MSSQLServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServerList) DeepCopyInto(out *MSSQLServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MSSQLServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerList.
+func (in *MSSQLServerList) DeepCopy() *MSSQLServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MSSQLServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServerObservation) DeepCopyInto(out *MSSQLServerObservation) {
+	*out = *in
+	out.LastOperation = in.LastOperation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerObservation.
+func (in *MSSQLServerObservation) DeepCopy() *MSSQLServerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServerParameters) DeepCopyInto(out *MSSQLServerParameters) {
+	*out = *in
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdministratorLoginPasswordSecretRef != nil {
+		in, out := &in.AdministratorLoginPasswordSecretRef, &out.AdministratorLoginPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimalTLSVersion != nil {
+		in, out := &in.MinimalTLSVersion, &out.MinimalTLSVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicNetworkAccess != nil {
+		in, out := &in.PublicNetworkAccess, &out.PublicNetworkAccess
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerParameters.
+func (in *MSSQLServerParameters) DeepCopy() *MSSQLServerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServerSpec) DeepCopyInto(out *MSSQLServerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSpec.
+func (in *MSSQLServerSpec) DeepCopy() *MSSQLServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServerStatus) DeepCopyInto(out *MSSQLServerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerStatus.
+func (in *MSSQLServerStatus) DeepCopy() *MSSQLServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServerFirewallRule) DeepCopyInto(out *MariaDBServerFirewallRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServerFirewallRule.
+func (in *MariaDBServerFirewallRule) DeepCopy() *MariaDBServerFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServerFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServerFirewallRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServerFirewallRuleList) DeepCopyInto(out *MariaDBServerFirewallRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MariaDBServerFirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServerFirewallRuleList.
+func (in *MariaDBServerFirewallRuleList) DeepCopy() *MariaDBServerFirewallRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServerFirewallRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServerFirewallRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServerVirtualNetworkRule) DeepCopyInto(out *MariaDBServerVirtualNetworkRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServerVirtualNetworkRule.
+func (in *MariaDBServerVirtualNetworkRule) DeepCopy() *MariaDBServerVirtualNetworkRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServerVirtualNetworkRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServerVirtualNetworkRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServerVirtualNetworkRuleList) DeepCopyInto(out *MariaDBServerVirtualNetworkRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MariaDBServerVirtualNetworkRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServerVirtualNetworkRuleList.
+func (in *MariaDBServerVirtualNetworkRuleList) DeepCopy() *MariaDBServerVirtualNetworkRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServerVirtualNetworkRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServerVirtualNetworkRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBVirtualNetworkRuleSpec) DeepCopyInto(out *MariaDBVirtualNetworkRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.VirtualNetworkRuleProperties.DeepCopyInto(&out.VirtualNetworkRuleProperties)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBVirtualNetworkRuleSpec.
+func (in *MariaDBVirtualNetworkRuleSpec) DeepCopy() *MariaDBVirtualNetworkRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBVirtualNetworkRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerActiveDirectoryAdministrator) DeepCopyInto(out *MySQLServerActiveDirectoryAdministrator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerActiveDirectoryAdministrator.
+func (in *MySQLServerActiveDirectoryAdministrator) DeepCopy() *MySQLServerActiveDirectoryAdministrator {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerActiveDirectoryAdministrator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerActiveDirectoryAdministrator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerActiveDirectoryAdministratorList) DeepCopyInto(out *MySQLServerActiveDirectoryAdministratorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLServerActiveDirectoryAdministrator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerActiveDirectoryAdministratorList.
+func (in *MySQLServerActiveDirectoryAdministratorList) DeepCopy() *MySQLServerActiveDirectoryAdministratorList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerActiveDirectoryAdministratorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerActiveDirectoryAdministratorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerConfiguration) DeepCopyInto(out *MySQLServerConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerConfiguration.
+func (in *MySQLServerConfiguration) DeepCopy() *MySQLServerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerConfigurationList) DeepCopyInto(out *MySQLServerConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLServerConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerConfigurationList.
+func (in *MySQLServerConfigurationList) DeepCopy() *MySQLServerConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerDatabase) DeepCopyInto(out *MySQLServerDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerDatabase.
+func (in *MySQLServerDatabase) DeepCopy() *MySQLServerDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerDatabaseList) DeepCopyInto(out *MySQLServerDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLServerDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerDatabaseList.
+func (in *MySQLServerDatabaseList) DeepCopy() *MySQLServerDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerFirewallRule) DeepCopyInto(out *MySQLServerFirewallRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerFirewallRule.
+func (in *MySQLServerFirewallRule) DeepCopy() *MySQLServerFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerFirewallRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerFirewallRuleList) DeepCopyInto(out *MySQLServerFirewallRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLServerFirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerFirewallRuleList.
+func (in *MySQLServerFirewallRuleList) DeepCopy() *MySQLServerFirewallRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerFirewallRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerFirewallRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerKey) DeepCopyInto(out *MySQLServerKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerKey.
+func (in *MySQLServerKey) DeepCopy() *MySQLServerKey {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerKeyList) DeepCopyInto(out *MySQLServerKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLServerKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerKeyList.
+func (in *MySQLServerKeyList) DeepCopy() *MySQLServerKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerVirtualNetworkRule) DeepCopyInto(out *MySQLServerVirtualNetworkRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerVirtualNetworkRule.
+func (in *MySQLServerVirtualNetworkRule) DeepCopy() *MySQLServerVirtualNetworkRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerVirtualNetworkRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerVirtualNetworkRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLServerVirtualNetworkRuleList) DeepCopyInto(out *MySQLServerVirtualNetworkRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLServerVirtualNetworkRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLServerVirtualNetworkRuleList.
+func (in *MySQLServerVirtualNetworkRuleList) DeepCopy() *MySQLServerVirtualNetworkRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLServerVirtualNetworkRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLServerVirtualNetworkRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLVirtualNetworkRuleSpec) DeepCopyInto(out *MySQLVirtualNetworkRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.VirtualNetworkRuleProperties.DeepCopyInto(&out.VirtualNetworkRuleProperties)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLVirtualNetworkRuleSpec.
+func (in *MySQLVirtualNetworkRuleSpec) DeepCopy() *MySQLVirtualNetworkRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLVirtualNetworkRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerActiveDirectoryAdministrator) DeepCopyInto(out *PostgreSQLServerActiveDirectoryAdministrator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerActiveDirectoryAdministrator.
+func (in *PostgreSQLServerActiveDirectoryAdministrator) DeepCopy() *PostgreSQLServerActiveDirectoryAdministrator {
+	if in == nil {
+		return nil
 	}
-	out := new(MySQLServerVirtualNetworkRuleList)
+	out := new(PostgreSQLServerActiveDirectoryAdministrator)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLServerVirtualNetworkRuleList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLServerActiveDirectoryAdministrator) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -474,42 +1829,155 @@ func (in *MySQLServerVirtualNetworkRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLVirtualNetworkRuleSpec) DeepCopyInto(out *MySQLVirtualNetworkRuleSpec) {
+func (in *PostgreSQLServerActiveDirectoryAdministratorList) DeepCopyInto(out *PostgreSQLServerActiveDirectoryAdministratorList) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	if in.ServerNameRef != nil {
-		in, out := &in.ServerNameRef, &out.ServerNameRef
-		*out = new(v1.Reference)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQLServerActiveDirectoryAdministrator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ServerNameSelector != nil {
-		in, out := &in.ServerNameSelector, &out.ServerNameSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerActiveDirectoryAdministratorList.
+func (in *PostgreSQLServerActiveDirectoryAdministratorList) DeepCopy() *PostgreSQLServerActiveDirectoryAdministratorList {
+	if in == nil {
+		return nil
 	}
-	if in.ResourceGroupNameRef != nil {
-		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
-		*out = new(v1.Reference)
-		**out = **in
+	out := new(PostgreSQLServerActiveDirectoryAdministratorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerActiveDirectoryAdministratorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ResourceGroupNameSelector != nil {
-		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerConfiguration) DeepCopyInto(out *PostgreSQLServerConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerConfiguration.
+func (in *PostgreSQLServerConfiguration) DeepCopy() *PostgreSQLServerConfiguration {
+	if in == nil {
+		return nil
 	}
-	in.VirtualNetworkRuleProperties.DeepCopyInto(&out.VirtualNetworkRuleProperties)
+	out := new(PostgreSQLServerConfiguration)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLVirtualNetworkRuleSpec.
-func (in *MySQLVirtualNetworkRuleSpec) DeepCopy() *MySQLVirtualNetworkRuleSpec {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerConfigurationList) DeepCopyInto(out *PostgreSQLServerConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQLServerConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerConfigurationList.
+func (in *PostgreSQLServerConfigurationList) DeepCopy() *PostgreSQLServerConfigurationList {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLVirtualNetworkRuleSpec)
+	out := new(PostgreSQLServerConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerDatabase) DeepCopyInto(out *PostgreSQLServerDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerDatabase.
+func (in *PostgreSQLServerDatabase) DeepCopy() *PostgreSQLServerDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLServerDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerDatabaseList) DeepCopyInto(out *PostgreSQLServerDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQLServerDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerDatabaseList.
+func (in *PostgreSQLServerDatabaseList) DeepCopy() *PostgreSQLServerDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLServerDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostgreSQLServerFirewallRule) DeepCopyInto(out *PostgreSQLServerFirewallRule) {
 	*out = *in
@@ -569,6 +2037,65 @@ func (in *PostgreSQLServerFirewallRuleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerKey) DeepCopyInto(out *PostgreSQLServerKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerKey.
+func (in *PostgreSQLServerKey) DeepCopy() *PostgreSQLServerKey {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLServerKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLServerKeyList) DeepCopyInto(out *PostgreSQLServerKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQLServerKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLServerKeyList.
+func (in *PostgreSQLServerKeyList) DeepCopy() *PostgreSQLServerKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLServerKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLServerKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostgreSQLServerVirtualNetworkRule) DeepCopyInto(out *PostgreSQLServerVirtualNetworkRule) {
 	*out = *in
@@ -665,6 +2192,94 @@ func (in *PostgreSQLVirtualNetworkRuleSpec) DeepCopy() *PostgreSQLVirtualNetwork
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerKeyObservation) DeepCopyInto(out *ServerKeyObservation) {
+	*out = *in
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerKeyObservation.
+func (in *ServerKeyObservation) DeepCopy() *ServerKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerKeyParameters) DeepCopyInto(out *ServerKeyParameters) {
+	*out = *in
+	if in.ServerNameRef != nil {
+		in, out := &in.ServerNameRef, &out.ServerNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerNameSelector != nil {
+		in, out := &in.ServerNameSelector, &out.ServerNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerKeyParameters.
+func (in *ServerKeyParameters) DeepCopy() *ServerKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerKeySpec) DeepCopyInto(out *ServerKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerKeySpec.
+func (in *ServerKeySpec) DeepCopy() *ServerKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerKeyStatus) DeepCopyInto(out *ServerKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerKeyStatus.
+func (in *ServerKeyStatus) DeepCopy() *ServerKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualNetworkRuleProperties) DeepCopyInto(out *VirtualNetworkRuleProperties) {
 	*out = *in
@@ -299,3 +299,787 @@ func (mg *PostgreSQLServerVirtualNetworkRule) SetProviderReference(r *xpv1.Refer
 func (mg *PostgreSQLServerVirtualNetworkRule) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this MySQLServerConfiguration.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MySQLServerConfiguration) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this MySQLServerConfiguration.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MySQLServerConfiguration) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MySQLServerConfiguration.
+func (mg *MySQLServerConfiguration) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this PostgreSQLServerConfiguration.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerConfiguration) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this PostgreSQLServerConfiguration.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerConfiguration) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this PostgreSQLServerConfiguration.
+func (mg *PostgreSQLServerConfiguration) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this MySQLServerDatabase.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MySQLServerDatabase) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this MySQLServerDatabase.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MySQLServerDatabase) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MySQLServerDatabase.
+func (mg *MySQLServerDatabase) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this PostgreSQLServerDatabase.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerDatabase) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this PostgreSQLServerDatabase.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerDatabase) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this PostgreSQLServerDatabase.
+func (mg *PostgreSQLServerDatabase) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this MySQLServerActiveDirectoryAdministrator.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MySQLServerActiveDirectoryAdministrator) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this MySQLServerActiveDirectoryAdministrator.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MySQLServerActiveDirectoryAdministrator) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MySQLServerActiveDirectoryAdministrator.
+func (mg *MySQLServerActiveDirectoryAdministrator) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this PostgreSQLServerActiveDirectoryAdministrator.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this PostgreSQLServerActiveDirectoryAdministrator.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this PostgreSQLServerActiveDirectoryAdministrator.
+func (mg *PostgreSQLServerActiveDirectoryAdministrator) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this MySQLServerKey.
+func (mg *MySQLServerKey) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MySQLServerKey.
+func (mg *MySQLServerKey) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MySQLServerKey.
+func (mg *MySQLServerKey) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this MySQLServerKey.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MySQLServerKey) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this MySQLServerKey.
+func (mg *MySQLServerKey) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MySQLServerKey.
+func (mg *MySQLServerKey) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MySQLServerKey.
+func (mg *MySQLServerKey) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MySQLServerKey.
+func (mg *MySQLServerKey) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this MySQLServerKey.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MySQLServerKey) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MySQLServerKey.
+func (mg *MySQLServerKey) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this PostgreSQLServerKey.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerKey) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this PostgreSQLServerKey.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *PostgreSQLServerKey) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this PostgreSQLServerKey.
+func (mg *PostgreSQLServerKey) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MariaDBServerFirewallRule.
+func (mg *MariaDBServerFirewallRule) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MariaDBServerVirtualNetworkRule.
+func (mg *MariaDBServerVirtualNetworkRule) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+/*
+GetProviderReference of this MariaDBServerFirewallRule.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MariaDBServerFirewallRule) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MariaDBServerFirewallRule.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MariaDBServerFirewallRule) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+/*
+GetProviderReference of this MariaDBServerVirtualNetworkRule.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MariaDBServerVirtualNetworkRule) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MariaDBServerVirtualNetworkRule.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MariaDBServerVirtualNetworkRule) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// GetCondition of this MSSQLServer.
+func (mg *MSSQLServer) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MSSQLServer.
+func (mg *MSSQLServer) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MSSQLServer.
+func (mg *MSSQLServer) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MSSQLServer.
+func (mg *MSSQLServer) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MSSQLServer.
+func (mg *MSSQLServer) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MSSQLServer.
+func (mg *MSSQLServer) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MSSQLServer.
+func (mg *MSSQLServer) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MSSQLServer.
+func (mg *MSSQLServer) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+/*
+GetProviderReference of this MSSQLServer.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MSSQLServer) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MSSQLServer.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MSSQLServer) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// GetCondition of this MSSQLDatabase.
+func (mg *MSSQLDatabase) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MSSQLDatabase.
+func (mg *MSSQLDatabase) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MSSQLDatabase.
+func (mg *MSSQLDatabase) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MSSQLDatabase.
+func (mg *MSSQLDatabase) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MSSQLDatabase.
+func (mg *MSSQLDatabase) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MSSQLDatabase.
+func (mg *MSSQLDatabase) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MSSQLDatabase.
+func (mg *MSSQLDatabase) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MSSQLDatabase.
+func (mg *MSSQLDatabase) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+/*
+GetProviderReference of this MSSQLDatabase.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MSSQLDatabase) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MSSQLDatabase.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MSSQLDatabase) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// GetCondition of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MSSQLElasticPool.
+func (mg *MSSQLElasticPool) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+/*
+GetProviderReference of this MSSQLElasticPool.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MSSQLElasticPool) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MSSQLElasticPool.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MSSQLElasticPool) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// GetCondition of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MSSQLFirewallRule.
+func (mg *MSSQLFirewallRule) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+/*
+GetProviderReference of this MSSQLFirewallRule.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MSSQLFirewallRule) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MSSQLFirewallRule.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MSSQLFirewallRule) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
@@ -23,10 +23,28 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-azure/apis/v1alpha3"
 )
 
+// SQLServerID extracts the resolved ID of a MySQLServer, a PostgreSQLServer
+// or a MariaDBServer.
+func SQLServerID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		switch s := mg.(type) {
+		case *MySQLServer:
+			return s.Status.AtProvider.ID
+		case *PostgreSQLServer:
+			return s.Status.AtProvider.ID
+		case *MariaDBServer:
+			return s.Status.AtProvider.ID
+		default:
+			return ""
+		}
+	}
+}
+
 // ResolveReferences of this MySQLServer.
 func (mg *MySQLServer) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,6 +63,20 @@ func (mg *MySQLServer) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.sourceServerId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceServerID),
+		Reference:    mg.Spec.ForProvider.SourceServerIDRef,
+		Selector:     mg.Spec.ForProvider.SourceServerIDSelector,
+		To:           reference.To{Managed: &MySQLServer{}, List: &MySQLServerList{}},
+		Extract:      SQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceServerId")
+	}
+	mg.Spec.ForProvider.SourceServerID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceServerIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -66,5 +98,54 @@ func (mg *PostgreSQLServer) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.sourceServerId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceServerID),
+		Reference:    mg.Spec.ForProvider.SourceServerIDRef,
+		Selector:     mg.Spec.ForProvider.SourceServerIDSelector,
+		To:           reference.To{Managed: &PostgreSQLServer{}, List: &PostgreSQLServerList{}},
+		Extract:      SQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceServerId")
+	}
+	mg.Spec.ForProvider.SourceServerID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceServerIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this MariaDBServer.
+func (mg *MariaDBServer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceServerId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceServerID),
+		Reference:    mg.Spec.ForProvider.SourceServerIDRef,
+		Selector:     mg.Spec.ForProvider.SourceServerIDSelector,
+		To:           reference.To{Managed: &MariaDBServer{}, List: &MariaDBServerList{}},
+		Extract:      SQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceServerId")
+	}
+	mg.Spec.ForProvider.SourceServerID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceServerIDRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -31,6 +31,21 @@ const (
 	StateReady    = "Ready"
 )
 
+// Possible modes a SQL server can be created with.
+const (
+	CreateModeDefault            = "Default"
+	CreateModePointInTimeRestore = "PointInTimeRestore"
+	CreateModeGeoRestore         = "GeoRestore"
+	CreateModeReplica            = "Replica"
+)
+
+// Possible replication roles of SQL servers.
+const (
+	ReplicationRoleNone    = "None"
+	ReplicationRoleMaster  = "Master"
+	ReplicationRoleReplica = "Replica"
+)
+
 // +kubebuilder:object:root=true
 
 // A MySQLServer is a managed resource that represents an Azure MySQL Database
@@ -85,6 +100,33 @@ type PostgreSQLServerList struct {
 	Items           []PostgreSQLServer `json:"items"`
 }
 
+// +kubebuilder:object:root=true
+
+// A MariaDBServer is a managed resource that represents an Azure MariaDB
+// Database Server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MariaDBServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SQLServerSpec   `json:"spec"`
+	Status SQLServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MariaDBServerList contains a list of MariaDBServer.
+type MariaDBServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MariaDBServer `json:"items"`
+}
+
 // SKU billing information related properties of a server.
 type SKU struct {
 	// Tier - The tier of the particular SKU.
@@ -154,16 +196,79 @@ type SQLServerParameters struct {
 	// +immutable
 	AdministratorLogin string `json:"administratorLogin"`
 
-	// TODO(hasheddan): support AdministratorLoginPassword
+	// AdministratorLoginPasswordSecretRef references the key of a secret
+	// that holds the administrator's login password. The password is
+	// generated at creation time if this is not set. Changes to the password
+	// stored in the secret are pushed to the server.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
 
-	// MinimalTLSVersion - control TLS connection policy
+	// MinimalTLSVersion - control TLS connection policy. Not supported by
+	// MariaDBServer.
 	MinimalTLSVersion MinimalTLSVersionEnum `json:"minimalTlsVersion,omitempty"`
 
-	// TODO(hasheddan): support InfrastructureEncryption
+	// InfrastructureEncryption - Whether data at rest is encrypted a second
+	// time using a different key and algorithm. Not supported by
+	// MariaDBServer. Possible values include: 'Enabled', 'Disabled'
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +immutable
+	// +optional
+	InfrastructureEncryption *string `json:"infrastructureEncryption,omitempty"`
+
+	// PublicNetworkAccess - Whether or not public network access is allowed
+	// for this server. Possible values include: 'Enabled', 'Disabled'
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+
+	// Identity - The Azure Active Directory identity of the server. A
+	// system assigned identity is required to encrypt data with a customer
+	// managed key. Not supported by MariaDBServer.
+	// +optional
+	Identity *Identity `json:"identity,omitempty"`
+
+	// CreateMode - The mode to create the server in. PointInTimeRestore and
+	// GeoRestore restore the server from the backups of the source server
+	// while Replica creates a read replica of the source server. The
+	// administrator login and password of the source server are retained
+	// in all modes but Default.
+	// Possible values include: 'Default', 'PointInTimeRestore', 'GeoRestore', 'Replica'
+	// +kubebuilder:validation:Enum=Default;PointInTimeRestore;GeoRestore;Replica
+	// +immutable
+	// +optional
+	CreateMode *string `json:"createMode,omitempty"`
+
+	// SourceServerID - The ID of the server to restore from or to replicate.
+	// Required unless CreateMode is Default.
+	// +immutable
+	// +optional
+	SourceServerID *string `json:"sourceServerId,omitempty"`
+
+	// SourceServerIDRef - A reference to a server of the same kind to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	SourceServerIDRef *xpv1.Reference `json:"sourceServerIdRef,omitempty"`
+
+	// SourceServerIDSelector - A selector for a server of the same kind to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	SourceServerIDSelector *xpv1.Selector `json:"sourceServerIdSelector,omitempty"`
 
-	// TODO(hasheddan): support PublicNetworkAccess
+	// RestorePointInTime - The point in time to restore the source server
+	// from. Required if CreateMode is PointInTimeRestore.
+	// +immutable
+	// +optional
+	RestorePointInTime *metav1.Time `json:"restorePointInTime,omitempty"`
 
-	// TODO(hasheddan): support CreateMode
+	// ReplicationRole - The desired replication role of a server created in
+	// Replica mode. Setting it to None promotes the replica to a standalone
+	// server. Promotion stops replication and cannot be undone.
+	// Possible values include: 'Replica', 'None'
+	// +kubebuilder:validation:Enum=Replica;None
+	// +optional
+	ReplicationRole *string `json:"replicationRole,omitempty"`
 
 	// Tags - Application-specific metadata in the form of key-value pairs.
 	// +optional
@@ -181,7 +286,7 @@ type SQLServerParameters struct {
 }
 
 // MinimalTLSVersionEnum describes the TLS connection policy.
-// Keep synced with "github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql".MinimalTLSVersionEnum
+// Keep synced with "github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql".MinimalTLSVersionEnum
 // +kubebuilder:validation:Enum=TLS1_0;TLS1_1;TLS1_2;TLSEnforcementDisabled
 type MinimalTLSVersionEnum string
 
@@ -193,6 +298,17 @@ const (
 	TLSEnforcementDisabled MinimalTLSVersionEnum = "TLSEnforcementDisabled"
 )
 
+// IdentityTypeSystemAssigned is the only supported type of SQL server
+// identity.
+const IdentityTypeSystemAssigned = "SystemAssigned"
+
+// An Identity is the Azure Active Directory identity of a SQL server.
+type Identity struct {
+	// Type - The identity type. Possible values include: 'SystemAssigned'
+	// +kubebuilder:validation:Enum=SystemAssigned
+	Type string `json:"type"`
+}
+
 // A SQLServerSpec defines the desired state of a SQLServer.
 type SQLServerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -219,6 +335,25 @@ type SQLServerObservation struct {
 	// MasterServerID - The master server id of a replica server.
 	MasterServerID string `json:"masterServerId,omitempty"`
 
+	// ReplicationRole - The replication role of the server.
+	ReplicationRole string `json:"replicationRole,omitempty"`
+
+	// ReplicaCapacity - The maximum number of replicas that a master server
+	// can have.
+	ReplicaCapacity int `json:"replicaCapacity,omitempty"`
+
+	// ByokEnforcement - Whether data of the server is encrypted with a
+	// customer managed key.
+	ByokEnforcement string `json:"byokEnforcement,omitempty"`
+
+	// IdentityPrincipalID - The Azure Active Directory principal ID of the
+	// system assigned identity of the server.
+	IdentityPrincipalID string `json:"identityPrincipalId,omitempty"`
+
+	// IdentityTenantID - The Azure Active Directory tenant ID of the system
+	// assigned identity of the server.
+	IdentityTenantID string `json:"identityTenantId,omitempty"`
+
 	// LastOperation represents the state of the last operation started by the
 	// controller.
 	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
@@ -228,4 +363,13 @@ type SQLServerObservation struct {
 type SQLServerStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          SQLServerObservation `json:"atProvider,omitempty"`
+
+	// AdministratorLoginPasswordHash is the hash of the administrator login
+	// password that was last pushed to the server, keyed with
+	// AdministratorLoginPasswordSalt.
+	AdministratorLoginPasswordHash string `json:"administratorLoginPasswordHash,omitempty"`
+
+	// AdministratorLoginPasswordSalt is the random salt with which the
+	// administrator login password of the server is hashed.
+	AdministratorLoginPasswordSalt string `json:"administratorLoginPasswordSalt,omitempty"`
 }
@@ -25,6 +25,80 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Identity) DeepCopyInto(out *Identity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Identity.
+func (in *Identity) DeepCopy() *Identity {
+	if in == nil {
+		return nil
+	}
+	out := new(Identity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServer) DeepCopyInto(out *MariaDBServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServer.
+func (in *MariaDBServer) DeepCopy() *MariaDBServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBServerList) DeepCopyInto(out *MariaDBServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MariaDBServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBServerList.
+func (in *MariaDBServerList) DeepCopy() *MariaDBServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MariaDBServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MariaDBServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLServer) DeepCopyInto(out *MySQLServer) {
 	*out = *in
@@ -193,6 +267,55 @@ func (in *SQLServerParameters) DeepCopyInto(out *SQLServerParameters) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.SKU.DeepCopyInto(&out.SKU)
+	if in.AdministratorLoginPasswordSecretRef != nil {
+		in, out := &in.AdministratorLoginPasswordSecretRef, &out.AdministratorLoginPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.InfrastructureEncryption != nil {
+		in, out := &in.InfrastructureEncryption, &out.InfrastructureEncryption
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicNetworkAccess != nil {
+		in, out := &in.PublicNetworkAccess, &out.PublicNetworkAccess
+		*out = new(string)
+		**out = **in
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(Identity)
+		**out = **in
+	}
+	if in.CreateMode != nil {
+		in, out := &in.CreateMode, &out.CreateMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceServerID != nil {
+		in, out := &in.SourceServerID, &out.SourceServerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceServerIDRef != nil {
+		in, out := &in.SourceServerIDRef, &out.SourceServerIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceServerIDSelector != nil {
+		in, out := &in.SourceServerIDSelector, &out.SourceServerIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestorePointInTime != nil {
+		in, out := &in.RestorePointInTime, &out.RestorePointInTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReplicationRole != nil {
+		in, out := &in.ReplicationRole, &out.ReplicationRole
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -131,3 +131,59 @@ func (mg *PostgreSQLServer) SetProviderReference(r *xpv1.Reference) {
 func (mg *PostgreSQLServer) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this MariaDBServer.
+func (mg *MariaDBServer) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this MariaDBServer.
+func (mg *MariaDBServer) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this MariaDBServer.
+func (mg *MariaDBServer) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+// GetWriteConnectionSecretToReference of this MariaDBServer.
+func (mg *MariaDBServer) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this MariaDBServer.
+func (mg *MariaDBServer) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this MariaDBServer.
+func (mg *MariaDBServer) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this MariaDBServer.
+func (mg *MariaDBServer) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+// SetWriteConnectionSecretToReference of this MariaDBServer.
+func (mg *MariaDBServer) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+/*
+GetProviderReference of this MariaDBServer.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *MariaDBServer) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+/*
+SetProviderReference of this MariaDBServer.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *MariaDBServer) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
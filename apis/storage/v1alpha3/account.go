@@ -19,7 +19,7 @@ package v1alpha3
 import (
 	"encoding/json"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -116,6 +116,12 @@ type Encryption struct {
 
 	// KeyVaultProperties - Properties provided by key vault.
 	KeyVaultProperties *KeyVaultProperties `json:"keyvaultproperties,omitempty"`
+
+	// RequireInfrastructureEncryption - A boolean indicating whether or not
+	// the service applies a secondary layer of encryption with platform
+	// managed keys for data at rest. Can only be set at account creation.
+	// +optional
+	RequireInfrastructureEncryption *bool `json:"requireInfrastructureEncryption,omitempty"`
 }
 
 // newEncryption from the storage equivalent
@@ -124,9 +130,10 @@ func newEncryption(s *storage.Encryption) *Encryption {
 		return nil
 	}
 	return &Encryption{
-		Services:           newEnabledEncryptionServices(s.Services),
-		KeySource:          s.KeySource,
-		KeyVaultProperties: newKeyVaultProperties(s.KeyVaultProperties),
+		Services:                        newEnabledEncryptionServices(s.Services),
+		KeySource:                       s.KeySource,
+		KeyVaultProperties:              newKeyVaultProperties(s.KeyVaultProperties),
+		RequireInfrastructureEncryption: s.RequireInfrastructureEncryption,
 	}
 }
 
@@ -136,9 +143,10 @@ func toStorageEncryption(e *Encryption) *storage.Encryption {
 		return nil
 	}
 	return &storage.Encryption{
-		Services:           toStorageEncryptedServices(e.Services),
-		KeySource:          e.KeySource,
-		KeyVaultProperties: toStorageKeyVaultProperties(e.KeyVaultProperties),
+		Services:                        toStorageEncryptedServices(e.Services),
+		KeySource:                       e.KeySource,
+		KeyVaultProperties:              toStorageKeyVaultProperties(e.KeyVaultProperties),
+		RequireInfrastructureEncryption: e.RequireInfrastructureEncryption,
 	}
 }
 
@@ -152,6 +160,8 @@ type Endpoints struct {
 	Table string `json:"table,omitempty"`
 	// File - the file endpoint.
 	File string `json:"file,omitempty"`
+	// Web - the static website endpoint.
+	Web string `json:"web,omitempty"`
 }
 
 // newEndpoint from the storage equivalent
@@ -164,6 +174,7 @@ func newEndpoints(ep *storage.Endpoints) *Endpoints {
 		Queue: to.String(ep.Queue),
 		Table: to.String(ep.Table),
 		File:  to.String(ep.File),
+		Web:   to.String(ep.Web),
 	}
 }
 
@@ -362,46 +373,36 @@ type skuCapability struct {
 	Value string `json:"value,omitempty"`
 }
 
-// newSkuCapability from the storage equivalent
-func newSkuCapability(s storage.SKUCapability) skuCapability {
-	return skuCapability{
-		Name:  to.String(s.Name),
-		Value: to.String(s.Value),
-	}
-}
-
-// toStorageSkuCapability format
-func toStorageSkuCapability(s skuCapability) storage.SKUCapability {
-	return storage.SKUCapability{
-		Name:  toStringPtr(s.Name),
-		Value: toStringPtr(s.Value),
-	}
-}
-
 // Sku of an Azure Blob Storage Account.
 type Sku struct {
 	// Capabilities - The capability information in the specified sku, including
 	// file encryption, network acls, change notification, etc.
+	// Deprecated: No longer reported by Azure; this field is ignored.
 	Capabilities []skuCapability `json:"capabilities,omitempty"`
 
 	// Kind - Indicates the type of storage account.
+	// Deprecated: No longer reported by Azure; this field is ignored. Use
+	// the Kind of the StorageAccountSpec instead.
 	//
-	// Possible values include: 'Storage', 'BlobStorage'
-	// +kubebuilder:validation:Enum=Storage;BlobStorage
+	// Possible values include: 'Storage', 'StorageV2', 'BlobStorage', 'FileStorage', 'BlockBlobStorage'
+	// +kubebuilder:validation:Enum=Storage;StorageV2;BlobStorage;FileStorage;BlockBlobStorage
 	Kind storage.Kind `json:"kind,omitempty"`
 
 	// Locations - The set of locations that the Sku is available.
 	// This will be supported and registered Azure Geo Regions (e.g. West US, East US, Southeast Asia, etc.).
+	// Deprecated: No longer reported by Azure; this field is ignored.
 	Locations []string `json:"locations,omitempty"`
 
 	// Name - Gets or sets the sku name. Required for account creation; optional for update.
 	// Note that in older versions, sku name was called accountType.
 	//
-	// Possible values include: 'Standard_LRS', 'Standard_GRS', 'Standard_RAGRS', 'Standard_ZRS', 'Premium_LRS'
-	// +kubebuilder:validation:Enum=Standard_LRS;Standard_GRS;Standard_RAGRS;Standard_ZRS;Premium_LRS
+	// Possible values include: 'Standard_LRS', 'Standard_GRS', 'Standard_RAGRS', 'Standard_ZRS',
+	// 'Premium_LRS', 'Premium_ZRS', 'Standard_GZRS', 'Standard_RAGZRS'
+	// +kubebuilder:validation:Enum=Standard_LRS;Standard_GRS;Standard_RAGRS;Standard_ZRS;Premium_LRS;Premium_ZRS;Standard_GZRS;Standard_RAGZRS
 	Name storage.SkuName `json:"name"`
 
 	// ResourceType - The type of the resource, usually it is 'storageAccounts'.
+	// Deprecated: No longer reported by Azure; this field is ignored.
 	ResourceType string `json:"resourceType,omitempty"`
 
 	// Tier - Gets the sku tier. This is based on the Sku name.
@@ -416,22 +417,9 @@ func newSku(s *storage.Sku) *Sku {
 	if s == nil {
 		return nil
 	}
-
-	var capabilities []skuCapability
-	if s.Capabilities != nil {
-		capabilities = make([]skuCapability, len(*s.Capabilities))
-		for i, v := range *s.Capabilities {
-			capabilities[i] = newSkuCapability(v)
-		}
-	}
-
 	return &Sku{
-		Capabilities: capabilities,
-		Kind:         s.Kind,
-		Locations:    to.StringSlice(s.Locations),
-		Name:         s.Name,
-		ResourceType: to.String(s.ResourceType),
-		Tier:         s.Tier,
+		Name: s.Name,
+		Tier: s.Tier,
 	}
 }
 
@@ -440,28 +428,9 @@ func toStorageSku(s *Sku) *storage.Sku {
 	if s == nil {
 		return nil
 	}
-
-	var capabilities *[]storage.SKUCapability
-	if len(s.Capabilities) > 0 {
-		cbp := make([]storage.SKUCapability, len(s.Capabilities))
-		for i, v := range s.Capabilities {
-			cbp[i] = toStorageSkuCapability(v)
-		}
-		capabilities = &cbp
-	}
-
-	var locations *[]string
-	if len(s.Locations) > 0 {
-		locations = to.StringSlicePtr(s.Locations)
-	}
-
 	return &storage.Sku{
-		Capabilities: capabilities,
-		Kind:         s.Kind,
-		Locations:    locations,
-		Name:         s.Name,
-		ResourceType: toStringPtr(s.ResourceType),
-		Tier:         s.Tier,
+		Name: s.Name,
+		Tier: s.Tier,
 	}
 }
 
@@ -495,6 +464,7 @@ func toStorageVirtualNetworkRule(v VirtualNetworkRule) storage.VirtualNetworkRul
 // StorageAccountSpecProperties the parameters used to create the storage account.
 type StorageAccountSpecProperties struct {
 	// AccessTier - Required for storage accounts where kind = BlobStorage.
+	// Also applies to StorageV2 accounts.
 	// The access tier used for billing.
 	// Possible values include: 'Hot', 'Cool'
 	// +kubebuilder:validation:Enum=Hot;Cool
@@ -515,6 +485,31 @@ type StorageAccountSpecProperties struct {
 
 	// NetworkRuleSet - Network rule set
 	NetworkRuleSet *NetworkRuleSet `json:"networkAcls,omitempty"`
+
+	// MinimumTLSVersion - Set the minimum TLS version to be permitted on
+	// requests to storage. Azure defaults to TLS1_0.
+	// Possible values include: 'TLS1_0', 'TLS1_1', 'TLS1_2'
+	// +kubebuilder:validation:Enum=TLS1_0;TLS1_1;TLS1_2
+	// +optional
+	MinimumTLSVersion storage.MinimumTLSVersion `json:"minimumTlsVersion,omitempty"`
+
+	// AllowBlobPublicAccess - Allow or disallow public access to all blobs
+	// or containers in the storage account. Azure defaults to true.
+	// +optional
+	AllowBlobPublicAccess *bool `json:"allowBlobPublicAccess,omitempty"`
+
+	// IsHNSEnabled - Enables the hierarchical namespace, which makes the
+	// account a Data Lake Storage Gen2 account. Can only be set at account
+	// creation.
+	// +optional
+	IsHNSEnabled *bool `json:"isHnsEnabled,omitempty"`
+
+	// LargeFileSharesState - Allow large file shares if set to Enabled. It
+	// cannot be disabled once it is enabled.
+	// Possible values include: 'Disabled', 'Enabled'
+	// +kubebuilder:validation:Enum=Disabled;Enabled
+	// +optional
+	LargeFileSharesState storage.LargeFileSharesState `json:"largeFileSharesState,omitempty"`
 }
 
 // newStorageAccountSpecProperties from the storage equivalent
@@ -528,6 +523,10 @@ func newStorageAccountSpecProperties(p *storage.AccountProperties) *StorageAccou
 		EnableHTTPSTrafficOnly: to.Bool(p.EnableHTTPSTrafficOnly),
 		Encryption:             newEncryption(p.Encryption),
 		NetworkRuleSet:         newNetworkRuleSet(p.NetworkRuleSet),
+		MinimumTLSVersion:      p.MinimumTLSVersion,
+		AllowBlobPublicAccess:  p.AllowBlobPublicAccess,
+		IsHNSEnabled:           p.IsHnsEnabled,
+		LargeFileSharesState:   p.LargeFileSharesState,
 	}
 }
 
@@ -542,6 +541,10 @@ func toStorageAccountCreateProperties(s *StorageAccountSpecProperties) *storage.
 		EnableHTTPSTrafficOnly: to.BoolPtr(s.EnableHTTPSTrafficOnly),
 		Encryption:             toStorageEncryption(s.Encryption),
 		NetworkRuleSet:         toStorageNetworkRuleSet(s.NetworkRuleSet),
+		MinimumTLSVersion:      s.MinimumTLSVersion,
+		AllowBlobPublicAccess:  s.AllowBlobPublicAccess,
+		IsHnsEnabled:           s.IsHNSEnabled,
+		LargeFileSharesState:   s.LargeFileSharesState,
 	}
 }
 
@@ -556,6 +559,9 @@ func toStorageAccountUpdateProperties(s *StorageAccountSpecProperties) *storage.
 		EnableHTTPSTrafficOnly: to.BoolPtr(s.EnableHTTPSTrafficOnly),
 		Encryption:             toStorageEncryption(s.Encryption),
 		NetworkRuleSet:         toStorageNetworkRuleSet(s.NetworkRuleSet),
+		MinimumTLSVersion:      s.MinimumTLSVersion,
+		AllowBlobPublicAccess:  s.AllowBlobPublicAccess,
+		LargeFileSharesState:   s.LargeFileSharesState,
 	}
 }
 
@@ -638,8 +644,9 @@ type StorageAccountSpec struct {
 	Identity *Identity `json:"identity,omitempty"`
 
 	// Kind - Indicates the type of storage account.
-	// Possible values include: 'Storage', 'BlobStorage'
-	// +kubebuilder:validation:Enum=Storage;BlobStorage
+	// Possible values include: 'Storage', 'StorageV2', 'BlobStorage',
+	// 'FileStorage', 'BlockBlobStorage'
+	// +kubebuilder:validation:Enum=Storage;StorageV2;BlobStorage;FileStorage;BlockBlobStorage
 	Kind storage.Kind `json:"kind"`
 
 	// Location - The location of the resource. This will be one of the
@@ -719,12 +726,19 @@ func ToStorageAccountUpdate(s *StorageAccountSpec) storage.AccountUpdateParamete
 		return storage.AccountUpdateParameters{}
 	}
 
-	return storage.AccountUpdateParameters{
+	aup := storage.AccountUpdateParameters{
 		AccountPropertiesUpdateParameters: toStorageAccountUpdateProperties(s.StorageAccountSpecProperties),
 		Identity:                          toStorageIdentity(s.Identity),
 		Sku:                               toStorageSku(s.Sku),
 		Tags:                              *to.StringMapPtr(s.Tags),
 	}
+
+	// Azure only supports upgrading existing accounts to StorageV2.
+	if s.Kind == storage.StorageV2 {
+		aup.Kind = s.Kind
+	}
+
+	return aup
 }
 
 // A StorageAccountStatus represents the observed status of an Account.
@@ -20,7 +20,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
@@ -183,7 +183,7 @@ func Test_toStorageEncryption(t *testing.T) {
 			name: "test",
 			args: &Encryption{
 				Services:  &EnabledEncryptionServices{},
-				KeySource: storage.MicrosoftKeyvault,
+				KeySource: storage.KeySourceMicrosoftKeyvault,
 				KeyVaultProperties: &KeyVaultProperties{
 					KeyName:     "bar",
 					KeyVersion:  "1.0.0",
@@ -197,7 +197,7 @@ func Test_toStorageEncryption(t *testing.T) {
 					Table: &storage.EncryptionService{Enabled: to.BoolPtr(false)},
 					Queue: &storage.EncryptionService{Enabled: to.BoolPtr(false)},
 				},
-				KeySource: storage.MicrosoftKeyvault,
+				KeySource: storage.KeySourceMicrosoftKeyvault,
 				KeyVaultProperties: &storage.KeyVaultProperties{
 					KeyName:     to.StringPtr("bar"),
 					KeyVersion:  to.StringPtr("1.0.0"),
@@ -527,46 +527,6 @@ func Test_toStorageNetworkRuleSet(t *testing.T) {
 	}
 }
 
-func Test_newSkuCapability(t *testing.T) {
-	tests := []struct {
-		name string
-		args storage.SKUCapability
-		want skuCapability
-	}{
-		{name: "empty", args: storage.SKUCapability{}, want: skuCapability{}},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := newSkuCapability(tt.args)
-			if diff := cmp.Diff(got, tt.want); diff != "" {
-				t.Errorf("newSkuCapability() = %v, want %v\n%s", got, tt.want, diff)
-			}
-		})
-	}
-}
-
-func Test_toStorageSkuCapability(t *testing.T) {
-	tests := []struct {
-		name string
-		args skuCapability
-		want storage.SKUCapability
-	}{
-		{
-			name: "empty",
-			args: skuCapability{},
-			want: storage.SKUCapability{},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := toStorageSkuCapability(tt.args)
-			if diff := cmp.Diff(got, tt.want); diff != "" {
-				t.Errorf("skuCapability.ToStorageSkuCapability() = %v, want %v\n%s", got, tt.want, diff)
-			}
-		})
-	}
-}
-
 func Test_newSku(t *testing.T) {
 	tests := []struct {
 		name string
@@ -577,20 +537,12 @@ func Test_newSku(t *testing.T) {
 		{
 			name: "values",
 			args: &storage.Sku{
-				Capabilities: &[]storage.SKUCapability{
-					{
-						Name:  to.StringPtr("test-capability-name"),
-						Value: to.StringPtr("true"),
-					},
-				},
+				Name: storage.StandardLRS,
+				Tier: storage.Standard,
 			},
 			want: &Sku{
-				Capabilities: []skuCapability{
-					{
-						Name:  "test-capability-name",
-						Value: "true",
-					},
-				},
+				Name: storage.StandardLRS,
+				Tier: storage.Standard,
 			},
 		},
 	}
@@ -627,16 +579,8 @@ func Test_toStorageSku(t *testing.T) {
 				Tier:         storage.Premium,
 			},
 			want: &storage.Sku{
-				Capabilities: &[]storage.SKUCapability{
-					{
-						Name:  to.StringPtr("test-capability"),
-						Value: to.StringPtr("true"),
-					},
-				},
-				Kind:         storage.Storage,
-				Name:         storage.PremiumLRS,
-				ResourceType: to.StringPtr("test-type"),
-				Tier:         storage.Premium,
+				Name: storage.PremiumLRS,
+				Tier: storage.Premium,
 			},
 		},
 	}
@@ -708,6 +652,21 @@ func Test_newStorageAccountSpecProperties(t *testing.T) {
 			args: &storage.AccountProperties{},
 			want: &StorageAccountSpecProperties{},
 		},
+		{
+			name: "security",
+			args: &storage.AccountProperties{
+				MinimumTLSVersion:     storage.TLS12,
+				AllowBlobPublicAccess: to.BoolPtr(false),
+				IsHnsEnabled:          to.BoolPtr(true),
+				LargeFileSharesState:  storage.LargeFileSharesStateEnabled,
+			},
+			want: &StorageAccountSpecProperties{
+				MinimumTLSVersion:     storage.TLS12,
+				AllowBlobPublicAccess: to.BoolPtr(false),
+				IsHNSEnabled:          to.BoolPtr(true),
+				LargeFileSharesState:  storage.LargeFileSharesStateEnabled,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -749,6 +708,37 @@ func Test_toStorageAccountCreateProperties(t *testing.T) {
 				NetworkRuleSet:         nil,
 			},
 		},
+		{
+			name: "security",
+			args: &StorageAccountSpecProperties{
+				Encryption: &Encryption{
+					Services:                        &EnabledEncryptionServices{Blob: true},
+					KeySource:                       storage.KeySourceMicrosoftStorage,
+					RequireInfrastructureEncryption: to.BoolPtr(true),
+				},
+				MinimumTLSVersion:     storage.TLS12,
+				AllowBlobPublicAccess: to.BoolPtr(false),
+				IsHNSEnabled:          to.BoolPtr(true),
+				LargeFileSharesState:  storage.LargeFileSharesStateEnabled,
+			},
+			want: &storage.AccountPropertiesCreateParameters{
+				EnableHTTPSTrafficOnly: to.BoolPtr(false),
+				Encryption: &storage.Encryption{
+					Services: &storage.EncryptionServices{
+						Blob:  &storage.EncryptionService{Enabled: to.BoolPtr(true)},
+						File:  &storage.EncryptionService{Enabled: to.BoolPtr(false)},
+						Table: &storage.EncryptionService{Enabled: to.BoolPtr(false)},
+						Queue: &storage.EncryptionService{Enabled: to.BoolPtr(false)},
+					},
+					KeySource:                       storage.KeySourceMicrosoftStorage,
+					RequireInfrastructureEncryption: to.BoolPtr(true),
+				},
+				MinimumTLSVersion:     storage.TLS12,
+				AllowBlobPublicAccess: to.BoolPtr(false),
+				IsHnsEnabled:          to.BoolPtr(true),
+				LargeFileSharesState:  storage.LargeFileSharesStateEnabled,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -778,6 +768,19 @@ func Test_toStorageAccountUpdateProperties(t *testing.T) {
 				EnableHTTPSTrafficOnly: to.BoolPtr(true),
 			},
 		},
+		{
+			name: "security",
+			args: &StorageAccountSpecProperties{
+				MinimumTLSVersion:     storage.TLS12,
+				AllowBlobPublicAccess: to.BoolPtr(false),
+				IsHNSEnabled:          to.BoolPtr(true),
+			},
+			want: &storage.AccountPropertiesUpdateParameters{
+				EnableHTTPSTrafficOnly: to.BoolPtr(false),
+				MinimumTLSVersion:      storage.TLS12,
+				AllowBlobPublicAccess:  to.BoolPtr(false),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -996,7 +999,7 @@ var storageAccountSpec = &StorageAccountSpec{
 			Services: &EnabledEncryptionServices{
 				Blob: true,
 			},
-			KeySource:          storage.MicrosoftKeyvault,
+			KeySource:          storage.KeySourceMicrosoftKeyvault,
 			KeyVaultProperties: nil,
 		},
 		NetworkRuleSet: nil,
@@ -111,6 +111,48 @@ func (tc *MockContainer) WithSpecMetadata(meta map[string]string) *MockContainer
 	return tc
 }
 
+// WithSpecImmutabilityPolicy sets spec immutability policy value
+func (tc *MockContainer) WithSpecImmutabilityPolicy(p *storagev1alpha3.ImmutabilityPolicy) *MockContainer {
+	tc.Container.Spec.ImmutabilityPolicy = p
+	return tc
+}
+
+// WithSpecLegalHoldTags sets spec legal hold tags value
+func (tc *MockContainer) WithSpecLegalHoldTags(tags []string) *MockContainer {
+	tc.Container.Spec.LegalHoldTags = tags
+	return tc
+}
+
+// WithStatusImmutabilityPolicy sets status immutability policy value
+func (tc *MockContainer) WithStatusImmutabilityPolicy(o *storagev1alpha3.ImmutabilityPolicyObservation) *MockContainer {
+	tc.Container.Status.ImmutabilityPolicy = o
+	return tc
+}
+
+// WithStatusLegalHoldTags sets status legal hold tags value
+func (tc *MockContainer) WithStatusLegalHoldTags(tags []string) *MockContainer {
+	tc.Container.Status.LegalHoldTags = tags
+	return tc
+}
+
+// WithSpecAuthenticationMethod sets spec authentication method value
+func (tc *MockContainer) WithSpecAuthenticationMethod(m storagev1alpha3.AuthenticationMethod) *MockContainer {
+	tc.Container.Spec.AuthenticationMethod = m
+	return tc
+}
+
+// WithStatusAuthenticationMethod sets status authentication method value
+func (tc *MockContainer) WithStatusAuthenticationMethod(m storagev1alpha3.AuthenticationMethod) *MockContainer {
+	tc.Container.Status.AuthenticationMethod = m
+	return tc
+}
+
+// WithStatusAtProvider sets the observed state of the container
+func (tc *MockContainer) WithStatusAtProvider(o storagev1alpha3.ContainerObservation) *MockContainer {
+	tc.Container.Status.AtProvider = o
+	return tc
+}
+
 // WithStatusConditions sets the conditioned status.
 func (tc *MockContainer) WithStatusConditions(c ...xpv1.Condition) *MockContainer {
 	tc.Status.SetConditions(c...)
@@ -23,6 +23,81 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// A SharedAccessSignature configures a shared access signature (SAS) that is
+// published to a connection secret and regenerated before it expires.
+type SharedAccessSignature struct {
+	// Permissions granted by the signature. Valid permissions for an Account
+	// are any of "rwdlacup" and for a Container any of "racwdl", for example
+	// "rl" for read and list.
+	Permissions string `json:"permissions"`
+
+	// Expiry is how long each generated signature is valid for, for example
+	// "720h". Must be at least five minutes.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	Expiry metav1.Duration `json:"expiry"`
+
+	// RenewBefore is how long before expiry the signature is regenerated.
+	// Must be less than Expiry. Defaults to a third of Expiry.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// Services the signature grants access to; any of "bfqt" for blob, file,
+	// queue and table. Only used by Accounts. Defaults to "b".
+	// +optional
+	Services string `json:"services,omitempty"`
+
+	// ResourceTypes the signature grants access to; any of "sco" for service,
+	// container and object. Only used by Accounts. Defaults to "sco".
+	// +optional
+	ResourceTypes string `json:"resourceTypes,omitempty"`
+}
+
+// ConnectionDetails configures the optional connection details published by
+// storage resources.
+type ConnectionDetails struct {
+	// SharedAccessSignature configures a scoped, time-limited signature that
+	// is published to the connection secret alongside a connection string
+	// that uses it.
+	// +optional
+	SharedAccessSignature *SharedAccessSignature `json:"sharedAccessSignature,omitempty"`
+}
+
+// AnnotationKeyRotateKeys triggers a rotation of an Account's access keys
+// whenever its value changes, for example to the current time.
+const AnnotationKeyRotateKeys = "storage.azure.crossplane.io/rotate-keys"
+
+// A KeyRotationPolicy configures the rotation of an Account's access keys.
+// Each rotation regenerates the inactive key, publishes it to the connection
+// secret, then regenerates the previously active key once the grace period
+// has elapsed.
+type KeyRotationPolicy struct {
+	// Interval between rotations, for example "2160h". Keys are only rotated
+	// when the rotate-keys annotation changes if no interval is specified.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// GracePeriod for which the previously active key remains valid after
+	// the new key has been published, giving consumers time to pick it up.
+	// Defaults to one hour.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// A StaticWebsite configures an Account to serve static content from its
+// $web container.
+type StaticWebsite struct {
+	// IndexDocument is the default page served for requests to a directory,
+	// for example "index.html".
+	// +optional
+	IndexDocument string `json:"indexDocument,omitempty"`
+
+	// ErrorDocument404Path is the absolute path of the page served when a
+	// requested file does not exist, for example "404.html".
+	// +optional
+	ErrorDocument404Path string `json:"errorDocument404Path,omitempty"`
+}
+
 // AccountParameters define the desired state of an Azure Blob Storage Account.
 type AccountParameters struct {
 	// ResourceGroupName specifies the resource group for this Account.
@@ -30,6 +105,21 @@ type AccountParameters struct {
 
 	// StorageAccountSpec specifies the desired state of this Account.
 	StorageAccountSpec *StorageAccountSpec `json:"storageAccountSpec"`
+
+	// ConnectionDetails configures the optional connection details this
+	// Account publishes to its connection secret.
+	// +optional
+	ConnectionDetails *ConnectionDetails `json:"connectionDetails,omitempty"`
+
+	// KeyRotationPolicy configures the periodic rotation of this Account's
+	// access keys.
+	// +optional
+	KeyRotationPolicy *KeyRotationPolicy `json:"keyRotationPolicy,omitempty"`
+
+	// StaticWebsite enables static website hosting for this Account. Static
+	// website hosting is disabled when this field is removed.
+	// +optional
+	StaticWebsite *StaticWebsite `json:"staticWebsite,omitempty"`
 }
 
 // An AccountSpec defines the desired state of an Account.
@@ -43,6 +133,41 @@ type AccountStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 
 	*StorageAccountStatus `json:",inline"`
+
+	// SharedAccessSignatureExpiry is the time at which the shared access
+	// signature published to the connection secret expires.
+	SharedAccessSignatureExpiry *metav1.Time `json:"sharedAccessSignatureExpiry,omitempty"`
+
+	// KeyRotation represents the observed state of access key rotation.
+	KeyRotation *KeyRotationStatus `json:"keyRotation,omitempty"`
+
+	// StaticWebsite is the observed static website configuration of this
+	// Account, if static website hosting is enabled.
+	StaticWebsite *StaticWebsite `json:"staticWebsite,omitempty"`
+}
+
+// A KeyRotationStatus represents the observed state of access key rotation.
+type KeyRotationStatus struct {
+	// ActiveKeyName is the name of the access key currently published to the
+	// connection secret, either key1 or key2.
+	ActiveKeyName string `json:"activeKeyName,omitempty"`
+
+	// PendingKeyName is the name of the previously active access key, which
+	// will be regenerated once the grace period has elapsed.
+	PendingKeyName string `json:"pendingKeyName,omitempty"`
+
+	// LastRotationTime is the time at which the active key last changed.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// LastRotationTrigger is the value of the rotate-keys annotation that
+	// triggered the last rotation.
+	LastRotationTrigger string `json:"lastRotationTrigger,omitempty"`
+
+	// Key1RegenerationTime is the time at which key1 was last regenerated.
+	Key1RegenerationTime *metav1.Time `json:"key1RegenerationTime,omitempty"`
+
+	// Key2RegenerationTime is the time at which key2 was last regenerated.
+	Key2RegenerationTime *metav1.Time `json:"key2RegenerationTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -70,6 +195,21 @@ type AccountList struct {
 	Items           []Account `json:"items"`
 }
 
+// An AuthenticationMethod determines how a Container authenticates to the
+// storage data plane.
+type AuthenticationMethod string
+
+// Authentication methods.
+const (
+	// AuthenticationMethodSharedKey authenticates using the access key
+	// published by the Container's Account.
+	AuthenticationMethodSharedKey AuthenticationMethod = "SharedKey"
+
+	// AuthenticationMethodAzureAD authenticates using an Azure AD token for
+	// the service principal of the Account's ProviderConfig.
+	AuthenticationMethodAzureAD AuthenticationMethod = "AzureAD"
+)
+
 // ContainerParameters define the desired state of an Azure Blob Storage
 // Container.
 type ContainerParameters struct {
@@ -80,6 +220,71 @@ type ContainerParameters struct {
 	// PublicAccessType for this container; either "blob" or "container".
 	// +optional
 	PublicAccessType azblob.PublicAccessType `json:"publicAccessType,omitempty"`
+
+	// ConnectionDetails configures the optional connection details this
+	// Container publishes to its connection secret.
+	// +optional
+	ConnectionDetails *ConnectionDetails `json:"connectionDetails,omitempty"`
+
+	// ImmutabilityPolicy configures time-based retention of the blobs in this
+	// Container, making them write once, read many (WORM).
+	// +optional
+	ImmutabilityPolicy *ImmutabilityPolicy `json:"immutabilityPolicy,omitempty"`
+
+	// LegalHoldTags to apply to this Container. Blobs cannot be modified or
+	// deleted while the Container has at least one legal hold tag. Each tag
+	// must be 3 to 23 alphanumeric characters and is normalized to lower case.
+	// +optional
+	LegalHoldTags []string `json:"legalHoldTags,omitempty"`
+
+	// AuthenticationMethod used to manage this Container. AzureAD requires
+	// the service principal of the Account's ProviderConfig to be granted a
+	// Storage Blob Data role on the Account. Defaults to SharedKey, switching
+	// to AzureAD automatically if the Account does not permit shared key
+	// access. Shared access signatures are always signed with the Account's
+	// access key, so they cannot be published by a Container whose Account
+	// does not permit shared key access.
+	// +optional
+	// +kubebuilder:validation:Enum=SharedKey;AzureAD
+	AuthenticationMethod AuthenticationMethod `json:"authenticationMethod,omitempty"`
+}
+
+// An ImmutabilityPolicy configures time-based retention of the blobs in a
+// Container.
+type ImmutabilityPolicy struct {
+	// ImmutabilityPeriodSinceCreationInDays is the number of days for which
+	// blobs are immutable after their creation. The period of a locked
+	// policy can only be extended.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=146000
+	ImmutabilityPeriodSinceCreationInDays int32 `json:"immutabilityPeriodSinceCreationInDays"`
+
+	// AllowProtectedAppendWrites allows new blocks to be written to append
+	// blobs while maintaining immutability protection. Can only be changed
+	// while the policy is unlocked.
+	// +optional
+	AllowProtectedAppendWrites bool `json:"allowProtectedAppendWrites,omitempty"`
+
+	// Locked policies cannot be deleted or shortened, and a Container with
+	// an active locked policy will not be deleted. Locking a policy is
+	// irreversible.
+	// +optional
+	Locked bool `json:"locked,omitempty"`
+}
+
+// An ImmutabilityPolicyObservation represents the observed state of the
+// immutability policy of a Container.
+type ImmutabilityPolicyObservation struct {
+	// State of the policy; either Locked or Unlocked.
+	State string `json:"state,omitempty"`
+
+	// ImmutabilityPeriodSinceCreationInDays is the number of days for which
+	// blobs are immutable after their creation.
+	ImmutabilityPeriodSinceCreationInDays int32 `json:"immutabilityPeriodSinceCreationInDays,omitempty"`
+
+	// AllowProtectedAppendWrites indicates whether new blocks may be written
+	// to append blobs.
+	AllowProtectedAppendWrites bool `json:"allowProtectedAppendWrites,omitempty"`
 }
 
 // A ContainerSpec defines the desired state of a Container.
@@ -88,9 +293,42 @@ type ContainerSpec struct {
 	ContainerParameters `json:",inline"`
 }
 
+// A ContainerObservation represents the observed state of a Container.
+type ContainerObservation struct {
+	// PublicAccessType of the Container.
+	PublicAccessType azblob.PublicAccessType `json:"publicAccessType,omitempty"`
+
+	// Metadata of the Container. Azure returns metadata keys in lower case.
+	Metadata azblob.Metadata `json:"metadata,omitempty"`
+
+	// LastModified is the time at which the Container or its properties
+	// were last modified.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// ETag of the Container, which changes whenever it is modified.
+	ETag string `json:"etag,omitempty"`
+}
+
 // A ContainerStatus represents the observed status of a Container.
 type ContainerStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+
+	// AtProvider is the observed state of this Container.
+	AtProvider ContainerObservation `json:"atProvider,omitempty"`
+
+	// SharedAccessSignatureExpiry is the time at which the shared access
+	// signature published to the connection secret expires.
+	SharedAccessSignatureExpiry *metav1.Time `json:"sharedAccessSignatureExpiry,omitempty"`
+
+	// ImmutabilityPolicy is the observed immutability policy of this
+	// Container.
+	ImmutabilityPolicy *ImmutabilityPolicyObservation `json:"immutabilityPolicy,omitempty"`
+
+	// LegalHoldTags are the observed legal hold tags of this Container.
+	LegalHoldTags []string `json:"legalHoldTags,omitempty"`
+
+	// AuthenticationMethod currently used to manage this Container.
+	AuthenticationMethod AuthenticationMethod `json:"authenticationMethod,omitempty"`
 }
 
 // +kubebuilder:object:root=true
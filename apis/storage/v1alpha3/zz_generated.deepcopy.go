@@ -22,6 +22,7 @@ package v1alpha3
 
 import (
 	"github.com/Azure/azure-storage-blob-go/azblob"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -92,6 +93,21 @@ func (in *AccountParameters) DeepCopyInto(out *AccountParameters) {
 		*out = new(StorageAccountSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConnectionDetails != nil {
+		in, out := &in.ConnectionDetails, &out.ConnectionDetails
+		*out = new(ConnectionDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyRotationPolicy != nil {
+		in, out := &in.KeyRotationPolicy, &out.KeyRotationPolicy
+		*out = new(KeyRotationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticWebsite != nil {
+		in, out := &in.StaticWebsite, &out.StaticWebsite
+		*out = new(StaticWebsite)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountParameters.
@@ -130,6 +146,20 @@ func (in *AccountStatus) DeepCopyInto(out *AccountStatus) {
 		*out = new(StorageAccountStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SharedAccessSignatureExpiry != nil {
+		in, out := &in.SharedAccessSignatureExpiry, &out.SharedAccessSignatureExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.KeyRotation != nil {
+		in, out := &in.KeyRotation, &out.KeyRotation
+		*out = new(KeyRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticWebsite != nil {
+		in, out := &in.StaticWebsite, &out.StaticWebsite
+		*out = new(StaticWebsite)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
@@ -142,6 +172,26 @@ func (in *AccountStatus) DeepCopy() *AccountStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionDetails) DeepCopyInto(out *ConnectionDetails) {
+	*out = *in
+	if in.SharedAccessSignature != nil {
+		in, out := &in.SharedAccessSignature, &out.SharedAccessSignature
+		*out = new(SharedAccessSignature)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionDetails.
+func (in *ConnectionDetails) DeepCopy() *ConnectionDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Container) DeepCopyInto(out *Container) {
 	*out = *in
@@ -201,6 +251,32 @@ func (in *ContainerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerObservation) DeepCopyInto(out *ContainerObservation) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(azblob.Metadata, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerObservation.
+func (in *ContainerObservation) DeepCopy() *ContainerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerParameters) DeepCopyInto(out *ContainerParameters) {
 	*out = *in
@@ -211,6 +287,21 @@ func (in *ContainerParameters) DeepCopyInto(out *ContainerParameters) {
 			(*out)[key] = val
 		}
 	}
+	if in.ConnectionDetails != nil {
+		in, out := &in.ConnectionDetails, &out.ConnectionDetails
+		*out = new(ConnectionDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImmutabilityPolicy != nil {
+		in, out := &in.ImmutabilityPolicy, &out.ImmutabilityPolicy
+		*out = new(ImmutabilityPolicy)
+		**out = **in
+	}
+	if in.LegalHoldTags != nil {
+		in, out := &in.LegalHoldTags, &out.LegalHoldTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerParameters.
@@ -244,6 +335,21 @@ func (in *ContainerSpec) DeepCopy() *ContainerSpec {
 func (in *ContainerStatus) DeepCopyInto(out *ContainerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.SharedAccessSignatureExpiry != nil {
+		in, out := &in.SharedAccessSignatureExpiry, &out.SharedAccessSignatureExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.ImmutabilityPolicy != nil {
+		in, out := &in.ImmutabilityPolicy, &out.ImmutabilityPolicy
+		*out = new(ImmutabilityPolicyObservation)
+		**out = **in
+	}
+	if in.LegalHoldTags != nil {
+		in, out := &in.LegalHoldTags, &out.LegalHoldTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerStatus.
@@ -299,6 +405,11 @@ func (in *Encryption) DeepCopyInto(out *Encryption) {
 		*out = new(KeyVaultProperties)
 		**out = **in
 	}
+	if in.RequireInfrastructureEncryption != nil {
+		in, out := &in.RequireInfrastructureEncryption, &out.RequireInfrastructureEncryption
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Encryption.
@@ -356,6 +467,88 @@ func (in *Identity) DeepCopy() *Identity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutabilityPolicy) DeepCopyInto(out *ImmutabilityPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutabilityPolicy.
+func (in *ImmutabilityPolicy) DeepCopy() *ImmutabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutabilityPolicyObservation) DeepCopyInto(out *ImmutabilityPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutabilityPolicyObservation.
+func (in *ImmutabilityPolicyObservation) DeepCopy() *ImmutabilityPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutabilityPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRotationPolicy) DeepCopyInto(out *KeyRotationPolicy) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRotationPolicy.
+func (in *KeyRotationPolicy) DeepCopy() *KeyRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRotationStatus) DeepCopyInto(out *KeyRotationStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Key1RegenerationTime != nil {
+		in, out := &in.Key1RegenerationTime, &out.Key1RegenerationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Key2RegenerationTime != nil {
+		in, out := &in.Key2RegenerationTime, &out.Key2RegenerationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRotationStatus.
+func (in *KeyRotationStatus) DeepCopy() *KeyRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyVaultProperties) DeepCopyInto(out *KeyVaultProperties) {
 	*out = *in
@@ -396,6 +589,27 @@ func (in *NetworkRuleSet) DeepCopy() *NetworkRuleSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedAccessSignature) DeepCopyInto(out *SharedAccessSignature) {
+	*out = *in
+	out.Expiry = in.Expiry
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedAccessSignature.
+func (in *SharedAccessSignature) DeepCopy() *SharedAccessSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedAccessSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sku) DeepCopyInto(out *Sku) {
 	*out = *in
@@ -421,6 +635,21 @@ func (in *Sku) DeepCopy() *Sku {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticWebsite) DeepCopyInto(out *StaticWebsite) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticWebsite.
+func (in *StaticWebsite) DeepCopy() *StaticWebsite {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticWebsite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageAccountSpec) DeepCopyInto(out *StorageAccountSpec) {
 	*out = *in
@@ -476,6 +705,16 @@ func (in *StorageAccountSpecProperties) DeepCopyInto(out *StorageAccountSpecProp
 		*out = new(NetworkRuleSet)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowBlobPublicAccess != nil {
+		in, out := &in.AllowBlobPublicAccess, &out.AllowBlobPublicAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsHNSEnabled != nil {
+		in, out := &in.IsHNSEnabled, &out.IsHNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAccountSpecProperties.
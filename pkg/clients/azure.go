@@ -43,7 +43,10 @@ const (
 	// AsyncOperationStatusInProgress is the status value for AsyncOperation type
 	// that indicates the operation is still ongoing.
 	AsyncOperationStatusInProgress = "InProgress"
-	asyncOperationPollingMethod    = "AsyncOperation"
+	// AsyncOperationStatusSucceeded is the status value for AsyncOperation
+	// type that indicates the operation completed successfully.
+	AsyncOperationStatusSucceeded = "Succeeded"
+	asyncOperationPollingMethod   = "AsyncOperation"
 )
 
 // Error strings.
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const (
+	errInvalidObjectID = "cannot parse objectId as a UUID"
+	errInvalidTenantID = "cannot parse tenantId as a UUID"
+)
+
+// A ServerAdministrator is the engine agnostic state of the Azure Active
+// Directory administrator of an Azure Database server.
+type ServerAdministrator struct {
+	ID                string
+	Type              string
+	AdministratorType *string
+	Login             *string
+	Sid               *uuid.UUID
+	TenantID          *uuid.UUID
+}
+
+// ServerAdministratorAPI represents the engine agnostic API interface for an
+// Azure Database server administrator client. A server has at most one Azure
+// Active Directory administrator, so it is identified by its server.
+type ServerAdministratorAPI interface {
+	GetServerAdministrator(ctx context.Context, resourceGroupName, serverName string) (ServerAdministrator, error)
+	CreateOrUpdateServerAdministrator(ctx context.Context, resourceGroupName, serverName string, a ServerAdministrator) error
+	DeleteServerAdministrator(ctx context.Context, resourceGroupName, serverName string) error
+}
+
+// NewServerAdministrator returns a ServerAdministrator from an administrator
+// spec.
+func NewServerAdministrator(p azuredbv1alpha3.ActiveDirectoryAdministratorParameters) (ServerAdministrator, error) {
+	sid, tenant, err := activeDirectoryAdministratorIDs(p)
+	if err != nil {
+		return ServerAdministrator{}, err
+	}
+	return ServerAdministrator{
+		AdministratorType: azure.ToStringPtr(azuredbv1alpha3.AdministratorTypeActiveDirectory),
+		Login:             azure.ToStringPtr(p.Login),
+		Sid:               &sid,
+		TenantID:          &tenant,
+	}, nil
+}
+
+// ServerAdministratorIsUpToDate returns true if the supplied
+// ServerAdministrator appears to be up to date with the supplied
+// ActiveDirectoryAdministratorParameters.
+func ServerAdministratorIsUpToDate(p azuredbv1alpha3.ActiveDirectoryAdministratorParameters, az ServerAdministrator) bool {
+	return activeDirectoryAdministratorIsUpToDate(p, az.Login, az.Sid, az.TenantID)
+}
+
+// activeDirectoryAdministratorIDs parses the object and tenant IDs of the
+// supplied administrator parameters.
+func activeDirectoryAdministratorIDs(p azuredbv1alpha3.ActiveDirectoryAdministratorParameters) (sid uuid.UUID, tenant uuid.UUID, err error) {
+	sid, err = uuid.FromString(p.ObjectID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Wrap(err, errInvalidObjectID)
+	}
+	tenant, err = uuid.FromString(p.TenantID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Wrap(err, errInvalidTenantID)
+	}
+	return sid, tenant, nil
+}
+
+// activeDirectoryAdministratorIsUpToDate returns true if the supplied
+// observed login, object ID and tenant ID match the supplied parameters.
+// Object and tenant IDs that cannot be parsed are never up to date.
+func activeDirectoryAdministratorIsUpToDate(p azuredbv1alpha3.ActiveDirectoryAdministratorParameters, login *string, sid, tenant *uuid.UUID) bool {
+	wantSID, wantTenant, err := activeDirectoryAdministratorIDs(p)
+	if err != nil || sid == nil || tenant == nil {
+		return false
+	}
+	return strings.EqualFold(p.Login, azure.ToString(login)) &&
+		uuid.Equal(wantSID, *sid) &&
+		uuid.Equal(wantTenant, *tenant)
+}
+
+// uuidToString returns the string representation of the supplied UUID, or an
+// empty string if it is nil.
+func uuidToString(u *uuid.UUID) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
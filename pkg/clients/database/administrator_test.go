@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestActiveDirectoryAdministratorIsUpToDate(t *testing.T) {
+	sid := uuid.NewV4()
+	tenant := uuid.NewV4()
+	other := uuid.NewV4()
+	p := azuredbv1alpha3.ActiveDirectoryAdministratorParameters{
+		Login:    "cool-admins",
+		ObjectID: sid.String(),
+		TenantID: tenant.String(),
+	}
+
+	type args struct {
+		p      azuredbv1alpha3.ActiveDirectoryAdministratorParameters
+		login  *string
+		sid    *uuid.UUID
+		tenant *uuid.UUID
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{p: p, login: azure.ToStringPtr("cool-admins"), sid: &sid, tenant: &tenant},
+			want: true,
+		},
+		"LoginDiffersInCase": {
+			args: args{p: p, login: azure.ToStringPtr("Cool-Admins"), sid: &sid, tenant: &tenant},
+			want: true,
+		},
+		"LoginChanged": {
+			args: args{p: p, login: azure.ToStringPtr("other-admins"), sid: &sid, tenant: &tenant},
+			want: false,
+		},
+		"ObjectIDChanged": {
+			args: args{p: p, login: azure.ToStringPtr("cool-admins"), sid: &other, tenant: &tenant},
+			want: false,
+		},
+		"TenantIDChanged": {
+			args: args{p: p, login: azure.ToStringPtr("cool-admins"), sid: &sid, tenant: &other},
+			want: false,
+		},
+		"ObjectIDNotObserved": {
+			args: args{p: p, login: azure.ToStringPtr("cool-admins"), tenant: &tenant},
+			want: false,
+		},
+		"InvalidObjectID": {
+			args: args{
+				p:      azuredbv1alpha3.ActiveDirectoryAdministratorParameters{Login: "cool-admins", ObjectID: "nope", TenantID: tenant.String()},
+				login:  azure.ToStringPtr("cool-admins"),
+				sid:    &sid,
+				tenant: &tenant,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := activeDirectoryAdministratorIsUpToDate(tc.args.p, tc.args.login, tc.args.sid, tc.args.tenant)
+			if got != tc.want {
+				t.Errorf("activeDirectoryAdministratorIsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
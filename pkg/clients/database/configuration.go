@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// A Configuration is the engine agnostic state of an Azure Database server
+// configuration. Value and Source are nil if Azure did not report the
+// properties of the configuration.
+type Configuration struct {
+	ID            string
+	Type          string
+	Value         *string
+	Source        *string
+	Description   string
+	DefaultValue  *string
+	DataType      string
+	AllowedValues string
+
+	// RestartRequired is true if the configuration is one of the static
+	// parameters of its engine, whose changes take effect only after the
+	// server is restarted.
+	RestartRequired bool
+}
+
+// ConfigurationAPI represents the engine agnostic API interface for an Azure
+// Database server configuration client. Configurations exist for as long as
+// their server does, so they cannot be deleted.
+type ConfigurationAPI interface {
+	GetConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string) (Configuration, error)
+	CreateOrUpdateConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string, c Configuration) error
+}
+
+// NewConfiguration returns a Configuration from a configuration spec.
+func NewConfiguration(p azuredbv1alpha3.ConfigurationParameters) Configuration {
+	return Configuration{
+		Value:  azure.ToStringPtr(p.Value),
+		Source: azure.ToStringPtr(azuredbv1alpha3.ConfigurationSourceUserOverride),
+	}
+}
+
+// NewDefaultConfiguration returns a Configuration that resets the supplied
+// configuration to its default value.
+func NewDefaultConfiguration(az Configuration) Configuration {
+	return Configuration{
+		Value:  az.DefaultValue,
+		Source: azure.ToStringPtr(azuredbv1alpha3.ConfigurationSourceSystemDefault),
+	}
+}
+
+// ConfigurationIsUpToDate returns true if the supplied Configuration appears
+// to be up to date with the supplied ConfigurationParameters.
+func ConfigurationIsUpToDate(p azuredbv1alpha3.ConfigurationParameters, az Configuration) bool {
+	if az.Value == nil {
+		return false
+	}
+	return *az.Value == p.Value
+}
+
+// ConfigurationIsDefault returns true if the supplied Configuration has been
+// reset to its default value.
+func ConfigurationIsDefault(az Configuration) bool {
+	if az.Source == nil {
+		return true
+	}
+	return *az.Source == azuredbv1alpha3.ConfigurationSourceSystemDefault
+}
+
+// UpdateConfigurationObservation updates the supplied ConfigurationObservation
+// with the supplied Configuration.
+func UpdateConfigurationObservation(o *azuredbv1alpha3.ConfigurationObservation, az Configuration) {
+	o.ID = az.ID
+	o.Type = az.Type
+	o.RestartRequired = az.RestartRequired
+	if az.Value == nil {
+		return
+	}
+	o.Description = az.Description
+	o.DefaultValue = azure.ToString(az.DefaultValue)
+	o.DataType = az.DataType
+	o.AllowedValues = az.AllowedValues
+	o.Source = azure.ToString(az.Source)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// ConnectionSecretDatabaseKey is the key of the database name in the
+// connection secret of a database.
+const ConnectionSecretDatabaseKey = "database"
+
+const (
+	errGetServerConnectionSecret = "cannot get server connection secret"
+)
+
+// GetServerConnectionDetails returns the endpoint, user and password the
+// supplied server published to its connection secret. No details are
+// returned if the server does not publish a connection secret.
+func GetServerConnectionDetails(ctx context.Context, kube client.Reader, s resource.ConnectionSecretWriterTo) (managed.ConnectionDetails, error) {
+	ref := s.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return managed.ConnectionDetails{}, nil
+	}
+	sec := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, sec); err != nil {
+		return nil, errors.Wrap(err, errGetServerConnectionSecret)
+	}
+	cd := managed.ConnectionDetails{}
+	for _, k := range []string{
+		xpv1.ResourceCredentialsSecretEndpointKey,
+		xpv1.ResourceCredentialsSecretUserKey,
+		xpv1.ResourceCredentialsSecretPasswordKey,
+	} {
+		if v, ok := sec.Data[k]; ok {
+			cd[k] = v
+		}
+	}
+	return cd, nil
+}
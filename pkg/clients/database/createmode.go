@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"github.com/pkg/errors"
+
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const (
+	errUnknownCreateMode          = "unknown create mode %q"
+	errSourceServerIDRequired     = "sourceServerId is required in create mode %q"
+	errRestorePointInTimeRequired = "restorePointInTime is required in create mode %q"
+)
+
+// ValidateCreateMode returns an error if the supplied parameters lack a
+// setting the create mode they specify requires.
+func ValidateCreateMode(p azuredbv1beta1.SQLServerParameters) error {
+	m := azure.ToString(p.CreateMode)
+	switch m {
+	case "", azuredbv1beta1.CreateModeDefault:
+		return nil
+	case azuredbv1beta1.CreateModePointInTimeRestore:
+		if p.RestorePointInTime == nil {
+			return errors.Errorf(errRestorePointInTimeRequired, m)
+		}
+	case azuredbv1beta1.CreateModeGeoRestore, azuredbv1beta1.CreateModeReplica:
+	default:
+		return errors.Errorf(errUnknownCreateMode, m)
+	}
+	if azure.ToString(p.SourceServerID) == "" {
+		return errors.Errorf(errSourceServerIDRequired, m)
+	}
+	return nil
+}
+
+// IsDefaultCreateMode returns true if the supplied parameters create a new
+// server rather than restore or replicate an existing one.
+func IsDefaultCreateMode(p azuredbv1beta1.SQLServerParameters) bool {
+	m := azure.ToString(p.CreateMode)
+	return m == "" || m == azuredbv1beta1.CreateModeDefault
+}
+
+// IsPromotion returns true if the supplied parameters ask for a replica to be
+// promoted to a standalone server.
+func IsPromotion(p azuredbv1beta1.SQLServerParameters) bool {
+	return azure.ToString(p.ReplicationRole) == azuredbv1beta1.ReplicationRoleNone
+}
+
+// NeedsPromotion returns true if the supplied parameters ask for a server
+// with the supplied replication role to be promoted to a standalone server.
+func NeedsPromotion(p azuredbv1beta1.SQLServerParameters, role string) bool {
+	return IsPromotion(p) && role == azuredbv1beta1.ReplicationRoleReplica
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestValidateCreateMode(t *testing.T) {
+	sourceID := azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.DBforMySQL/servers/source")
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		want error
+	}{
+		"Default": {
+			p: azuredbv1beta1.SQLServerParameters{},
+		},
+		"PointInTimeRestore": {
+			p: azuredbv1beta1.SQLServerParameters{
+				CreateMode:         azure.ToStringPtr(azuredbv1beta1.CreateModePointInTimeRestore),
+				SourceServerID:     sourceID,
+				RestorePointInTime: &now,
+			},
+		},
+		"RestorePointInTimeMissing": {
+			p: azuredbv1beta1.SQLServerParameters{
+				CreateMode:     azure.ToStringPtr(azuredbv1beta1.CreateModePointInTimeRestore),
+				SourceServerID: sourceID,
+			},
+			want: errors.Errorf(errRestorePointInTimeRequired, azuredbv1beta1.CreateModePointInTimeRestore),
+		},
+		"SourceServerIDMissing": {
+			p: azuredbv1beta1.SQLServerParameters{
+				CreateMode: azure.ToStringPtr(azuredbv1beta1.CreateModeGeoRestore),
+			},
+			want: errors.Errorf(errSourceServerIDRequired, azuredbv1beta1.CreateModeGeoRestore),
+		},
+		"UnknownCreateMode": {
+			p: azuredbv1beta1.SQLServerParameters{
+				CreateMode: azure.ToStringPtr("Clone"),
+			},
+			want: errors.Errorf(errUnknownCreateMode, "Clone"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateCreateMode(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateCreateMode(...): -want error, +got error\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsPromotion(t *testing.T) {
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		role string
+		want bool
+	}{
+		"PromoteReplica": {
+			p:    azuredbv1beta1.SQLServerParameters{ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone)},
+			role: azuredbv1beta1.ReplicationRoleReplica,
+			want: true,
+		},
+		"AlreadyPromoted": {
+			p:    azuredbv1beta1.SQLServerParameters{ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone)},
+			role: azuredbv1beta1.ReplicationRoleNone,
+			want: false,
+		},
+		"KeepReplica": {
+			p:    azuredbv1beta1.SQLServerParameters{ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleReplica)},
+			role: azuredbv1beta1.ReplicationRoleReplica,
+			want: false,
+		},
+		"RoleNotSpecified": {
+			p:    azuredbv1beta1.SQLServerParameters{},
+			role: azuredbv1beta1.ReplicationRoleReplica,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsPromotion(tc.p, tc.role)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsPromotion(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
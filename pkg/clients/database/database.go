@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// A Database is the engine agnostic state of a database of an Azure Database
+// server.
+type Database struct {
+	ID        string
+	Type      string
+	Charset   *string
+	Collation *string
+}
+
+// DatabaseAPI represents the engine agnostic API interface for an Azure
+// Database server database client.
+type DatabaseAPI interface {
+	GetDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (Database, error)
+	CreateOrUpdateDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string, d Database) error
+	DeleteDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error
+}
+
+// NewDatabase returns a Database from a database spec.
+func NewDatabase(p azuredbv1alpha3.DatabaseParameters) Database {
+	return Database{
+		Charset:   p.Charset,
+		Collation: p.Collation,
+	}
+}
+
+// LateInitializeDatabase fills the empty values of DatabaseParameters with the
+// ones that are retrieved from the Azure API.
+func LateInitializeDatabase(p *azuredbv1alpha3.DatabaseParameters, az Database) {
+	p.Charset = azure.LateInitializeStringPtrFromPtr(p.Charset, az.Charset)
+	p.Collation = azure.LateInitializeStringPtrFromPtr(p.Collation, az.Collation)
+}
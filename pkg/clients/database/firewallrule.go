@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"reflect"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// A FirewallRule is the engine agnostic state of an Azure Database server
+// firewall rule.
+type FirewallRule struct {
+	ID             string
+	Type           string
+	StartIPAddress *string
+	EndIPAddress   *string
+}
+
+// FirewallRuleAPI represents the engine agnostic API interface for an Azure
+// Database server firewall rule client.
+type FirewallRuleAPI interface {
+	GetFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (FirewallRule, error)
+	CreateOrUpdateFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r FirewallRule) error
+	DeleteFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error
+}
+
+// NewFirewallRule returns a FirewallRule from a firewall rule spec.
+func NewFirewallRule(p azuredbv1alpha3.FirewallRuleParameters) FirewallRule {
+	return FirewallRule{
+		StartIPAddress: azure.ToStringPtr(p.StartIPAddress),
+		EndIPAddress:   azure.ToStringPtr(p.EndIPAddress),
+	}
+}
+
+// FirewallRuleIsUpToDate returns true if the supplied FirewallRule appears to
+// be up to date with the supplied FirewallRuleParameters.
+func FirewallRuleIsUpToDate(p azuredbv1alpha3.FirewallRuleParameters, az FirewallRule) bool {
+	up := NewFirewallRule(p)
+	return reflect.DeepEqual(up.StartIPAddress, az.StartIPAddress) &&
+		reflect.DeepEqual(up.EndIPAddress, az.EndIPAddress)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewFirewallRule(t *testing.T) {
+	start := "127.0.0.1."
+	end := "It was just a dream Bender - there's no such thing as two."
+
+	cases := map[string]struct {
+		p    v1alpha3.FirewallRuleParameters
+		want FirewallRule
+	}{
+		"Successful": {
+			p: v1alpha3.FirewallRuleParameters{
+				FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
+					StartIPAddress: start,
+					EndIPAddress:   end,
+				},
+			},
+			want: FirewallRule{
+				StartIPAddress: azure.ToStringPtr(start),
+				EndIPAddress:   azure.ToStringPtr(end),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewFirewallRule(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewFirewallRule(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFirewallRuleIsUpToDate(t *testing.T) {
+	start := "127.0.0.1."
+	end := "256"
+	p := v1alpha3.FirewallRuleParameters{FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
+		StartIPAddress: start,
+		EndIPAddress:   end,
+	}}
+
+	cases := map[string]struct {
+		p    v1alpha3.FirewallRuleParameters
+		az   FirewallRule
+		want bool
+	}{
+		"UpToDate": {
+			p: p,
+			az: FirewallRule{
+				StartIPAddress: azure.ToStringPtr(start),
+				EndIPAddress:   azure.ToStringPtr(end),
+			},
+			want: true,
+		},
+		"StartNeedsUpdate": {
+			p: p,
+			az: FirewallRule{
+				StartIPAddress: azure.ToStringPtr("255.255.255.254"),
+				EndIPAddress:   azure.ToStringPtr(end),
+			},
+			want: false,
+		},
+		"EndNeedsUpdate": {
+			p: p,
+			az: FirewallRule{
+				StartIPAddress: azure.ToStringPtr(start),
+				EndIPAddress:   azure.ToStringPtr("192.168.0.1"),
+			},
+			want: false,
+		},
+		"PropertiesMissing": {
+			p:    p,
+			az:   FirewallRule{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FirewallRuleIsUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FirewallRuleIsUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
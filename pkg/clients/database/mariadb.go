@@ -0,0 +1,323 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb/mariadbapi"
+	"github.com/Azure/go-autorest/autorest"
+
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const (
+	errMariaDBUnsupported = "%s is not supported by MariaDB servers"
+)
+
+// MariaDBServerClient is the concrete implementation of the SQLServerAPI
+// interface for MariaDB that calls Azure API.
+type MariaDBServerClient struct {
+	mariadb.ServersClient
+}
+
+// NewMariaDBServerClient creates and initializes a MariaDBServerClient
+// instance.
+func NewMariaDBServerClient(cl mariadb.ServersClient) *MariaDBServerClient {
+	return &MariaDBServerClient{
+		ServersClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *MariaDBServerClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested MariaDB Server.
+func (c *MariaDBServerClient) GetServer(ctx context.Context, resourceGroupName, serverName string) (Server, error) {
+	s, err := c.ServersClient.Get(ctx, resourceGroupName, serverName)
+	return fromMariaDBServer(s), err
+}
+
+// CreateServer creates a MariaDB Server.
+func (c *MariaDBServerClient) CreateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, adminPassword string) (string, error) {
+	if err := validateMariaDBServer(s); err != nil {
+		return "", err
+	}
+	p, err := NewServerCreateParameters(s, adminPassword)
+	if err != nil {
+		return "", err
+	}
+	op, err := c.Create(ctx, s.ResourceGroupName, serverName, newMariaDBServerForCreate(p))
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// UpdateServer updates a MariaDB Server with the supplied observed replication
+// role. The administrator login password is changed as well unless the
+// supplied password is empty.
+func (c *MariaDBServerClient) UpdateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, replicationRole, adminPassword string) (string, error) {
+	if err := validateMariaDBServer(s); err != nil {
+		return "", err
+	}
+	p, err := NewServerUpdateParameters(s, replicationRole, adminPassword)
+	if err != nil {
+		return "", err
+	}
+	op, err := c.Update(ctx, s.ResourceGroupName, serverName, newMariaDBServerUpdateParameters(p))
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// DeleteServer deletes the supplied MariaDB Server.
+func (c *MariaDBServerClient) DeleteServer(ctx context.Context, resourceGroupName, serverName string) (string, error) {
+	op, err := c.ServersClient.Delete(ctx, resourceGroupName, serverName)
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// validateMariaDBServer returns an error if the supplied parameters use a
+// setting that the MariaDB API does not offer. Silently dropping them would
+// leave the server forever out of date.
+func validateMariaDBServer(s azuredbv1beta1.SQLServerParameters) error {
+	switch {
+	case s.MinimalTLSVersion != "":
+		return fmt.Errorf(errMariaDBUnsupported, "minimalTlsVersion")
+	case s.InfrastructureEncryption != nil:
+		return fmt.Errorf(errMariaDBUnsupported, "infrastructureEncryption")
+	case s.Identity != nil:
+		return fmt.Errorf(errMariaDBUnsupported, "identity")
+	}
+	return nil
+}
+
+func newMariaDBSku(s ServerSKU) *mariadb.Sku {
+	return &mariadb.Sku{
+		Name:     s.Name,
+		Tier:     mariadb.SkuTier(s.Tier),
+		Capacity: s.Capacity,
+		Size:     s.Size,
+		Family:   s.Family,
+	}
+}
+
+func newMariaDBStorageProfile(s ServerStorageProfile) *mariadb.StorageProfile {
+	return &mariadb.StorageProfile{
+		BackupRetentionDays: s.BackupRetentionDays,
+		GeoRedundantBackup:  mariadb.GeoRedundantBackup(s.GeoRedundantBackup),
+		StorageMB:           s.StorageMB,
+		StorageAutogrow:     mariadb.StorageAutogrow(s.StorageAutogrow),
+	}
+}
+
+func newMariaDBServerForCreate(p ServerParameters) mariadb.ServerForCreate {
+	var properties mariadb.BasicServerPropertiesForCreate
+	switch p.CreateMode {
+	case azuredbv1beta1.CreateModePointInTimeRestore:
+		properties = &mariadb.ServerPropertiesForRestore{
+			SourceServerID:      p.SourceServerID,
+			RestorePointInTime:  p.RestorePointInTime,
+			Version:             mariadb.ServerVersion(p.Version),
+			SslEnforcement:      mariadb.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:          mariadb.CreateModePointInTimeRestore,
+			StorageProfile:      newMariaDBStorageProfile(p.StorageProfile),
+		}
+	case azuredbv1beta1.CreateModeGeoRestore:
+		properties = &mariadb.ServerPropertiesForGeoRestore{
+			SourceServerID:      p.SourceServerID,
+			Version:             mariadb.ServerVersion(p.Version),
+			SslEnforcement:      mariadb.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:          mariadb.CreateModeGeoRestore,
+			StorageProfile:      newMariaDBStorageProfile(p.StorageProfile),
+		}
+	case azuredbv1beta1.CreateModeReplica:
+		properties = &mariadb.ServerPropertiesForReplica{
+			SourceServerID:      p.SourceServerID,
+			Version:             mariadb.ServerVersion(p.Version),
+			SslEnforcement:      mariadb.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess: mariadb.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:          mariadb.CreateModeReplica,
+			StorageProfile:      newMariaDBStorageProfile(p.StorageProfile),
+		}
+	default:
+		properties = &mariadb.ServerPropertiesForDefaultCreate{
+			AdministratorLogin:         p.AdministratorLogin,
+			AdministratorLoginPassword: p.AdministratorLoginPassword,
+			Version:                    mariadb.ServerVersion(p.Version),
+			SslEnforcement:             mariadb.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess:        mariadb.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:                 mariadb.CreateModeDefault,
+			StorageProfile:             newMariaDBStorageProfile(p.StorageProfile),
+		}
+	}
+	return mariadb.ServerForCreate{
+		Sku:        newMariaDBSku(p.SKU),
+		Properties: properties,
+		Location:   azure.ToStringPtr(p.Location),
+		Tags:       p.Tags,
+	}
+}
+
+func newMariaDBServerUpdateParameters(p ServerParameters) mariadb.ServerUpdateParameters {
+	return mariadb.ServerUpdateParameters{
+		Sku: newMariaDBSku(p.SKU),
+		ServerUpdateParametersProperties: &mariadb.ServerUpdateParametersProperties{
+			AdministratorLoginPassword: p.AdministratorLoginPassword,
+			Version:                    mariadb.ServerVersion(p.Version),
+			SslEnforcement:             mariadb.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess:        mariadb.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			ReplicationRole:            p.ReplicationRole,
+			StorageProfile:             newMariaDBStorageProfile(p.StorageProfile),
+		},
+		Tags: p.Tags,
+	}
+}
+
+func fromMariaDBServer(in mariadb.Server) Server {
+	s := Server{
+		ID:   azure.ToString(in.ID),
+		Name: azure.ToString(in.Name),
+		Type: azure.ToString(in.Type),
+		Tags: in.Tags,
+	}
+	if in.Sku != nil {
+		s.SKU = &ServerSKU{
+			Name:     in.Sku.Name,
+			Tier:     string(in.Sku.Tier),
+			Capacity: in.Sku.Capacity,
+			Size:     in.Sku.Size,
+			Family:   in.Sku.Family,
+		}
+	}
+	if in.ServerProperties == nil {
+		return s
+	}
+	s.Version = string(in.Version)
+	s.SSLEnforcement = string(in.SslEnforcement)
+	s.PublicNetworkAccess = string(in.PublicNetworkAccess)
+	s.UserVisibleState = string(in.UserVisibleState)
+	s.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+	s.MasterServerID = azure.ToString(in.MasterServerID)
+	s.ReplicationRole = azure.ToString(in.ReplicationRole)
+	s.ReplicaCapacity = in.ReplicaCapacity
+	if in.StorageProfile != nil {
+		s.StorageProfile = &ServerStorageProfile{
+			BackupRetentionDays: in.StorageProfile.BackupRetentionDays,
+			GeoRedundantBackup:  string(in.StorageProfile.GeoRedundantBackup),
+			StorageMB:           in.StorageProfile.StorageMB,
+			StorageAutogrow:     string(in.StorageProfile.StorageAutogrow),
+		}
+	}
+	return s
+}
+
+// MariaDBFirewallRulesClient is the concrete implementation of the
+// FirewallRuleAPI interface for MariaDB that calls Azure API.
+type MariaDBFirewallRulesClient struct {
+	client mariadbapi.FirewallRulesClientAPI
+}
+
+// NewMariaDBFirewallRulesClient creates and initializes a
+// MariaDBFirewallRulesClient instance.
+func NewMariaDBFirewallRulesClient(cl mariadbapi.FirewallRulesClientAPI) *MariaDBFirewallRulesClient {
+	return &MariaDBFirewallRulesClient{client: cl}
+}
+
+// GetFirewallRule retrieves the requested MariaDB firewall rule.
+func (c *MariaDBFirewallRulesClient) GetFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (FirewallRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	r := FirewallRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.FirewallRuleProperties != nil {
+		r.StartIPAddress = az.StartIPAddress
+		r.EndIPAddress = az.EndIPAddress
+	}
+	return r, err
+}
+
+// CreateOrUpdateFirewallRule creates or updates the supplied MariaDB firewall
+// rule.
+func (c *MariaDBFirewallRulesClient) CreateOrUpdateFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r FirewallRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, mariadb.FirewallRule{
+		Name: azure.ToStringPtr(ruleName),
+		FirewallRuleProperties: &mariadb.FirewallRuleProperties{
+			StartIPAddress: r.StartIPAddress,
+			EndIPAddress:   r.EndIPAddress,
+		},
+	})
+	return err
+}
+
+// DeleteFirewallRule deletes the supplied MariaDB firewall rule.
+func (c *MariaDBFirewallRulesClient) DeleteFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
+
+// MariaDBVirtualNetworkRulesClient is the concrete implementation of the
+// VirtualNetworkRuleAPI interface for MariaDB that calls Azure API.
+type MariaDBVirtualNetworkRulesClient struct {
+	client mariadbapi.VirtualNetworkRulesClientAPI
+}
+
+// NewMariaDBVirtualNetworkRulesClient creates and initializes a
+// MariaDBVirtualNetworkRulesClient instance.
+func NewMariaDBVirtualNetworkRulesClient(cl mariadbapi.VirtualNetworkRulesClientAPI) *MariaDBVirtualNetworkRulesClient {
+	return &MariaDBVirtualNetworkRulesClient{client: cl}
+}
+
+// GetVirtualNetworkRule retrieves the requested MariaDB virtual network rule.
+func (c *MariaDBVirtualNetworkRulesClient) GetVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (VirtualNetworkRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	r := VirtualNetworkRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.VirtualNetworkRuleProperties != nil {
+		r.State = string(az.State)
+		r.VirtualNetworkSubnetID = az.VirtualNetworkSubnetID
+		r.IgnoreMissingVnetServiceEndpoint = az.IgnoreMissingVnetServiceEndpoint
+	}
+	return r, err
+}
+
+// CreateOrUpdateVirtualNetworkRule creates or updates the supplied MariaDB
+// virtual network rule.
+func (c *MariaDBVirtualNetworkRulesClient) CreateOrUpdateVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r VirtualNetworkRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, mariadb.VirtualNetworkRule{
+		Name: azure.ToStringPtr(ruleName),
+		VirtualNetworkRuleProperties: &mariadb.VirtualNetworkRuleProperties{
+			VirtualNetworkSubnetID:           r.VirtualNetworkSubnetID,
+			IgnoreMissingVnetServiceEndpoint: r.IgnoreMissingVnetServiceEndpoint,
+		},
+	})
+	return err
+}
+
+// DeleteVirtualNetworkRule deletes the supplied MariaDB virtual network rule.
+func (c *MariaDBVirtualNetworkRulesClient) DeleteVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
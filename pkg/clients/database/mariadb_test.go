@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewMariaDBServerForCreate(t *testing.T) {
+	sourceID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.DBforMariaDB/servers/source"
+	restoreTime := metav1.NewTime(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	admin := "cooladmin"
+	pw := "verysecure"
+	sku := azuredbv1beta1.SKU{Tier: SKUTierBasic, Family: "Gen5", Capacity: 1}
+	storage := &mariadb.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)}
+	params := func(mode string) azuredbv1beta1.SQLServerParameters {
+		return azuredbv1beta1.SQLServerParameters{
+			AdministratorLogin: admin,
+			CreateMode:         azure.ToStringPtr(mode),
+			SourceServerID:     azure.ToStringPtr(sourceID),
+			RestorePointInTime: &restoreTime,
+			SKU:                sku,
+			StorageProfile:     azuredbv1beta1.StorageProfile{StorageMB: 5120},
+		}
+	}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		want mariadb.BasicServerPropertiesForCreate
+	}{
+		"Default": {
+			p: azuredbv1beta1.SQLServerParameters{
+				AdministratorLogin:  admin,
+				PublicNetworkAccess: azure.ToStringPtr("Disabled"),
+				SKU:                 sku,
+				StorageProfile:      azuredbv1beta1.StorageProfile{StorageMB: 5120},
+			},
+			want: &mariadb.ServerPropertiesForDefaultCreate{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(pw),
+				PublicNetworkAccess:        mariadb.PublicNetworkAccessEnumDisabled,
+				CreateMode:                 mariadb.CreateModeDefault,
+				StorageProfile:             storage,
+			},
+		},
+		"PointInTimeRestore": {
+			p: params(azuredbv1beta1.CreateModePointInTimeRestore),
+			want: &mariadb.ServerPropertiesForRestore{
+				SourceServerID:     azure.ToStringPtr(sourceID),
+				RestorePointInTime: &date.Time{Time: restoreTime.Time},
+				CreateMode:         mariadb.CreateModePointInTimeRestore,
+				StorageProfile:     storage,
+			},
+		},
+		"GeoRestore": {
+			p: params(azuredbv1beta1.CreateModeGeoRestore),
+			want: &mariadb.ServerPropertiesForGeoRestore{
+				SourceServerID: azure.ToStringPtr(sourceID),
+				CreateMode:     mariadb.CreateModeGeoRestore,
+				StorageProfile: storage,
+			},
+		},
+		"Replica": {
+			p: params(azuredbv1beta1.CreateModeReplica),
+			want: &mariadb.ServerPropertiesForReplica{
+				SourceServerID: azure.ToStringPtr(sourceID),
+				CreateMode:     mariadb.CreateModeReplica,
+				StorageProfile: storage,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewServerCreateParameters(tc.p, pw)
+			if err != nil {
+				t.Fatalf("NewServerCreateParameters(...): %s", err)
+			}
+			got := newMariaDBServerForCreate(p)
+			if diff := cmp.Diff(tc.want, got.Properties); diff != "" {
+				t.Errorf("newMariaDBServerForCreate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMariaDBServer(t *testing.T) {
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		want error
+	}{
+		"Supported": {
+			p: azuredbv1beta1.SQLServerParameters{
+				SSLEnforcement:      "Enabled",
+				PublicNetworkAccess: azure.ToStringPtr("Enabled"),
+			},
+		},
+		"MinimalTLSVersion": {
+			p:    azuredbv1beta1.SQLServerParameters{MinimalTLSVersion: "TLS1_2"},
+			want: fmt.Errorf(errMariaDBUnsupported, "minimalTlsVersion"),
+		},
+		"InfrastructureEncryption": {
+			p:    azuredbv1beta1.SQLServerParameters{InfrastructureEncryption: azure.ToStringPtr("Enabled")},
+			want: fmt.Errorf(errMariaDBUnsupported, "infrastructureEncryption"),
+		},
+		"Identity": {
+			p:    azuredbv1beta1.SQLServerParameters{Identity: &azuredbv1beta1.Identity{Type: azuredbv1beta1.IdentityTypeSystemAssigned}},
+			want: fmt.Errorf(errMariaDBUnsupported, "identity"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateMariaDBServer(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateMariaDBServer(...): -want error, +got error\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMariaDBServerUpToDate(t *testing.T) {
+	params := func(m ...func(*azuredbv1beta1.SQLServerParameters)) azuredbv1beta1.SQLServerParameters {
+		p := azuredbv1beta1.SQLServerParameters{
+			SKU:                 azuredbv1beta1.SKU{Tier: "GeneralPurpose", Capacity: 2, Family: "Gen5"},
+			Version:             "10.3",
+			SSLEnforcement:      "Enabled",
+			PublicNetworkAccess: azure.ToStringPtr("Enabled"),
+			StorageProfile:      azuredbv1beta1.StorageProfile{StorageMB: 5120},
+		}
+		for _, fn := range m {
+			fn(&p)
+		}
+		return p
+	}
+	server := func(m ...func(*mariadb.Server)) mariadb.Server {
+		s := mariadb.Server{
+			Sku: &mariadb.Sku{Tier: mariadb.GeneralPurpose, Capacity: azure.ToInt32Ptr(2), Family: azure.ToStringPtr("Gen5")},
+			ServerProperties: &mariadb.ServerProperties{
+				Version:             mariadb.ServerVersion("10.3"),
+				SslEnforcement:      mariadb.SslEnforcementEnumEnabled,
+				PublicNetworkAccess: mariadb.PublicNetworkAccessEnumEnabled,
+				StorageProfile:      &mariadb.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)},
+			},
+		}
+		for _, fn := range m {
+			fn(&s)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		in   mariadb.Server
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			in:   server(),
+			want: true,
+		},
+		"NoProperties": {
+			p:    params(),
+			in:   server(func(s *mariadb.Server) { s.ServerProperties = nil }),
+			want: false,
+		},
+		"SSLEnforcementChanged": {
+			p:    params(func(p *azuredbv1beta1.SQLServerParameters) { p.SSLEnforcement = "Disabled" }),
+			in:   server(),
+			want: false,
+		},
+		"StorageChanged": {
+			p:    params(func(p *azuredbv1beta1.SQLServerParameters) { p.StorageProfile.StorageMB = 10240 }),
+			in:   server(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServerUpToDate(tc.p, fromMariaDBServer(tc.in))
+			if got != tc.want {
+				t.Errorf("IsServerUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
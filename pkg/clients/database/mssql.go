@@ -0,0 +1,455 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/pkg/errors"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// MSSQLPort is the port Azure SQL servers accept connections on.
+const MSSQLPort = "1433"
+
+// ConnectionSecretConnectionStringKey is the key of the ADO.NET connection
+// string in the connection secret of an Azure SQL server or database.
+const ConnectionSecretConnectionStringKey = "connectionString"
+
+const (
+	errParseMinCapacity = "cannot parse perDatabaseSettings.minCapacity"
+	errParseMaxCapacity = "cannot parse perDatabaseSettings.maxCapacity"
+)
+
+// MSSQLConnectionString returns an ADO.NET connection string for the supplied
+// Azure SQL server endpoint and credentials. The initial catalog is omitted
+// if no database is supplied.
+func MSSQLConnectionString(endpoint, database, user, password string) string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "Server=tcp:%s,%s;", endpoint, MSSQLPort)
+	if database != "" {
+		fmt.Fprintf(b, "Initial Catalog=%s;", adoNetValue(database))
+	}
+	fmt.Fprintf(b, "Persist Security Info=False;User ID=%s;Password=%s;", adoNetValue(user), adoNetValue(password))
+	b.WriteString("MultipleActiveResultSets=False;Encrypt=True;TrustServerCertificate=False;Connection Timeout=30;")
+	return b.String()
+}
+
+// adoNetValue quotes the supplied connection string value if it contains
+// characters that would otherwise end or alter it.
+func adoNetValue(v string) string {
+	if !strings.ContainsAny(v, ";'\"") && strings.TrimSpace(v) == v {
+		return v
+	}
+	return `"` + strings.ReplaceAll(v, `"`, `""`) + `"`
+}
+
+// NewMSSQLSku returns the Azure SKU of the supplied SKU spec.
+func NewMSSQLSku(s *azuredbv1alpha3.MSSQLSKU) *sql.Sku {
+	if s == nil {
+		return nil
+	}
+	return &sql.Sku{
+		Name:     azure.ToStringPtr(s.Name),
+		Tier:     s.Tier,
+		Family:   s.Family,
+		Capacity: azure.ToInt32PtrFromIntPtr(s.Capacity),
+	}
+}
+
+// isMSSQLSkuUpToDate returns true if the supplied Azure SKU matches the
+// fields of the SKU spec that are set.
+func isMSSQLSkuUpToDate(s azuredbv1alpha3.MSSQLSKU, az *sql.Sku) bool {
+	switch {
+	case az == nil:
+		return false
+	case !strings.EqualFold(s.Name, azure.ToString(az.Name)):
+		return false
+	case s.Tier != nil && !strings.EqualFold(*s.Tier, azure.ToString(az.Tier)):
+		return false
+	case s.Family != nil && !strings.EqualFold(*s.Family, azure.ToString(az.Family)):
+		return false
+	case s.Capacity != nil && *s.Capacity != azure.ToInt(az.Capacity):
+		return false
+	}
+	return true
+}
+
+// NewMSSQLServer returns the Azure SQL server that the supplied parameters
+// describe.
+func NewMSSQLServer(p azuredbv1alpha3.MSSQLServerParameters, adminPassword string) sql.Server {
+	return sql.Server{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		ServerProperties: &sql.ServerProperties{
+			AdministratorLogin:         azure.ToStringPtr(p.AdministratorLogin),
+			AdministratorLoginPassword: &adminPassword,
+			Version:                    p.Version,
+			MinimalTLSVersion:          p.MinimalTLSVersion,
+			PublicNetworkAccess:        sql.ServerPublicNetworkAccess(azure.ToString(p.PublicNetworkAccess)),
+		},
+	}
+}
+
+// NewMSSQLServerUpdate returns the update of an Azure SQL server that the
+// supplied parameters describe. The administrator login password is changed
+// as well unless the supplied password is empty.
+func NewMSSQLServerUpdate(p azuredbv1alpha3.MSSQLServerParameters, adminPassword string) sql.ServerUpdate {
+	u := sql.ServerUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		ServerProperties: &sql.ServerProperties{
+			MinimalTLSVersion:   p.MinimalTLSVersion,
+			PublicNetworkAccess: sql.ServerPublicNetworkAccess(azure.ToString(p.PublicNetworkAccess)),
+		},
+	}
+	if adminPassword != "" {
+		u.AdministratorLoginPassword = &adminPassword
+	}
+	return u
+}
+
+// LateInitializeMSSQLServer fills the empty fields of the supplied parameters
+// with the values of the supplied Azure SQL server.
+func LateInitializeMSSQLServer(p *azuredbv1alpha3.MSSQLServerParameters, az sql.Server) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.ServerProperties == nil {
+		return
+	}
+	p.Version = azure.LateInitializeStringPtrFromPtr(p.Version, az.Version)
+	p.MinimalTLSVersion = azure.LateInitializeStringPtrFromPtr(p.MinimalTLSVersion, az.MinimalTLSVersion)
+	if az.PublicNetworkAccess != "" {
+		p.PublicNetworkAccess = azure.LateInitializeStringPtrFromVal(p.PublicNetworkAccess, string(az.PublicNetworkAccess))
+	}
+}
+
+// IsMSSQLServerUpToDate returns true if the supplied Azure SQL server is in
+// sync with the supplied parameters.
+func IsMSSQLServerUpToDate(p azuredbv1alpha3.MSSQLServerParameters, az sql.Server) bool {
+	if az.ServerProperties == nil {
+		return false
+	}
+	switch {
+	case p.MinimalTLSVersion != nil && *p.MinimalTLSVersion != azure.ToString(az.MinimalTLSVersion):
+		return false
+	case p.PublicNetworkAccess != nil && *p.PublicNetworkAccess != string(az.PublicNetworkAccess):
+		return false
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), az.Tags):
+		return false
+	}
+	return true
+}
+
+// UpdateMSSQLServerObservation produces an MSSQLServerObservation from the
+// supplied Azure SQL server.
+func UpdateMSSQLServerObservation(o *azuredbv1alpha3.MSSQLServerObservation, az sql.Server) {
+	o.ID = azure.ToString(az.ID)
+	o.Name = azure.ToString(az.Name)
+	o.Type = azure.ToString(az.Type)
+	if az.ServerProperties == nil {
+		return
+	}
+	o.State = azure.ToString(az.State)
+	o.FullyQualifiedDomainName = azure.ToString(az.FullyQualifiedDomainName)
+}
+
+// NewMSSQLDatabase returns the Azure SQL database that the supplied
+// parameters describe. The SKU is omitted for members of an elastic pool.
+func NewMSSQLDatabase(p azuredbv1alpha3.MSSQLDatabaseParameters) sql.Database {
+	d := sql.Database{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		DatabaseProperties: &sql.DatabaseProperties{
+			Collation:     p.Collation,
+			MaxSizeBytes:  p.MaxSizeBytes,
+			ElasticPoolID: p.ElasticPoolID,
+			ZoneRedundant: p.ZoneRedundant,
+			LicenseType:   sql.DatabaseLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}
+	if p.ElasticPoolID == nil {
+		d.Sku = NewMSSQLSku(p.SKU)
+	}
+	return d
+}
+
+// NewMSSQLDatabaseUpdate returns the update of an Azure SQL database that the
+// supplied parameters describe. A database leaves its elastic pool when it is
+// updated with a SKU.
+func NewMSSQLDatabaseUpdate(p azuredbv1alpha3.MSSQLDatabaseParameters) sql.DatabaseUpdate {
+	u := sql.DatabaseUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		DatabaseProperties: &sql.DatabaseProperties{
+			MaxSizeBytes:  p.MaxSizeBytes,
+			ElasticPoolID: p.ElasticPoolID,
+			ZoneRedundant: p.ZoneRedundant,
+			LicenseType:   sql.DatabaseLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}
+	if p.ElasticPoolID == nil {
+		u.Sku = NewMSSQLSku(p.SKU)
+	}
+	return u
+}
+
+// LateInitializeMSSQLDatabase fills the empty fields of the supplied
+// parameters with the values of the supplied Azure SQL database. The SKU and
+// elastic pool of a database are not late initialized, since the one
+// determines the other and either may be omitted to switch between them.
+func LateInitializeMSSQLDatabase(p *azuredbv1alpha3.MSSQLDatabaseParameters, az sql.Database) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.DatabaseProperties == nil {
+		return
+	}
+	p.Collation = azure.LateInitializeStringPtrFromPtr(p.Collation, az.Collation)
+	if p.MaxSizeBytes == nil {
+		p.MaxSizeBytes = az.MaxSizeBytes
+	}
+	p.ZoneRedundant = azure.LateInitializeBoolPtrFromPtr(p.ZoneRedundant, az.ZoneRedundant)
+	if az.LicenseType != "" {
+		p.LicenseType = azure.LateInitializeStringPtrFromVal(p.LicenseType, string(az.LicenseType))
+	}
+}
+
+// IsMSSQLDatabaseUpToDate returns true if the supplied Azure SQL database is
+// in sync with the supplied parameters.
+func IsMSSQLDatabaseUpToDate(p azuredbv1alpha3.MSSQLDatabaseParameters, az sql.Database) bool { // nolint:gocyclo
+	if az.DatabaseProperties == nil {
+		return false
+	}
+	switch {
+	case p.ElasticPoolID != nil && !strings.EqualFold(*p.ElasticPoolID, azure.ToString(az.ElasticPoolID)):
+		return false
+	case p.ElasticPoolID == nil && p.SKU != nil && !isMSSQLSkuUpToDate(*p.SKU, az.Sku):
+		return false
+	case p.MaxSizeBytes != nil && !reflect.DeepEqual(p.MaxSizeBytes, az.MaxSizeBytes):
+		return false
+	case p.ZoneRedundant != nil && *p.ZoneRedundant != azure.ToBool(az.ZoneRedundant):
+		return false
+	case p.LicenseType != nil && *p.LicenseType != string(az.LicenseType):
+		return false
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), az.Tags):
+		return false
+	}
+	return true
+}
+
+// UpdateMSSQLDatabaseObservation produces an MSSQLDatabaseObservation from
+// the supplied Azure SQL database.
+func UpdateMSSQLDatabaseObservation(o *azuredbv1alpha3.MSSQLDatabaseObservation, az sql.Database) {
+	o.ID = azure.ToString(az.ID)
+	o.Name = azure.ToString(az.Name)
+	o.Type = azure.ToString(az.Type)
+	if az.DatabaseProperties == nil {
+		return
+	}
+	o.Status = string(az.Status)
+	o.CurrentServiceObjectiveName = azure.ToString(az.CurrentServiceObjectiveName)
+	if az.DatabaseID != nil {
+		o.DatabaseID = az.DatabaseID.String()
+	}
+}
+
+// NewMSSQLElasticPool returns the Azure SQL elastic pool that the supplied
+// parameters describe.
+func NewMSSQLElasticPool(p azuredbv1alpha3.MSSQLElasticPoolParameters) (sql.ElasticPool, error) {
+	s, err := newMSSQLElasticPoolPerDatabaseSettings(p.PerDatabaseSettings)
+	if err != nil {
+		return sql.ElasticPool{}, err
+	}
+	return sql.ElasticPool{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		Sku:      NewMSSQLSku(&p.SKU),
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes:        p.MaxSizeBytes,
+			PerDatabaseSettings: s,
+			ZoneRedundant:       p.ZoneRedundant,
+			LicenseType:         sql.ElasticPoolLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}, nil
+}
+
+// NewMSSQLElasticPoolUpdate returns the update of an Azure SQL elastic pool
+// that the supplied parameters describe.
+func NewMSSQLElasticPoolUpdate(p azuredbv1alpha3.MSSQLElasticPoolParameters) (sql.ElasticPoolUpdate, error) {
+	s, err := newMSSQLElasticPoolPerDatabaseSettings(p.PerDatabaseSettings)
+	if err != nil {
+		return sql.ElasticPoolUpdate{}, err
+	}
+	return sql.ElasticPoolUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		Sku:  NewMSSQLSku(&p.SKU),
+		ElasticPoolUpdateProperties: &sql.ElasticPoolUpdateProperties{
+			MaxSizeBytes:        p.MaxSizeBytes,
+			PerDatabaseSettings: s,
+			ZoneRedundant:       p.ZoneRedundant,
+			LicenseType:         sql.ElasticPoolLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}, nil
+}
+
+func newMSSQLElasticPoolPerDatabaseSettings(s *azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings) (*sql.ElasticPoolPerDatabaseSettings, error) {
+	if s == nil {
+		return nil, nil
+	}
+	minCapacity, err := parseCapacity(s.MinCapacity)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseMinCapacity)
+	}
+	maxCapacity, err := parseCapacity(s.MaxCapacity)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseMaxCapacity)
+	}
+	return &sql.ElasticPoolPerDatabaseSettings{MinCapacity: minCapacity, MaxCapacity: maxCapacity}, nil
+}
+
+func parseCapacity(c *string) (*float64, error) {
+	if c == nil {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(*c, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func formatCapacity(c *float64) *string {
+	if c == nil {
+		return nil
+	}
+	s := strconv.FormatFloat(*c, 'f', -1, 64)
+	return &s
+}
+
+// LateInitializeMSSQLElasticPool fills the empty fields of the supplied
+// parameters with the values of the supplied Azure SQL elastic pool.
+func LateInitializeMSSQLElasticPool(p *azuredbv1alpha3.MSSQLElasticPoolParameters, az sql.ElasticPool) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil {
+		p.SKU.Tier = azure.LateInitializeStringPtrFromPtr(p.SKU.Tier, az.Sku.Tier)
+		p.SKU.Family = azure.LateInitializeStringPtrFromPtr(p.SKU.Family, az.Sku.Family)
+		p.SKU.Capacity = azure.LateInitializeIntPtrFromInt32Ptr(p.SKU.Capacity, az.Sku.Capacity)
+	}
+	if az.ElasticPoolProperties == nil {
+		return
+	}
+	if p.MaxSizeBytes == nil {
+		p.MaxSizeBytes = az.MaxSizeBytes
+	}
+	if s := az.PerDatabaseSettings; s != nil {
+		if p.PerDatabaseSettings == nil {
+			p.PerDatabaseSettings = &azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings{}
+		}
+		p.PerDatabaseSettings.MinCapacity = azure.LateInitializeStringPtrFromPtr(p.PerDatabaseSettings.MinCapacity, formatCapacity(s.MinCapacity))
+		p.PerDatabaseSettings.MaxCapacity = azure.LateInitializeStringPtrFromPtr(p.PerDatabaseSettings.MaxCapacity, formatCapacity(s.MaxCapacity))
+	}
+	p.ZoneRedundant = azure.LateInitializeBoolPtrFromPtr(p.ZoneRedundant, az.ZoneRedundant)
+	if az.LicenseType != "" {
+		p.LicenseType = azure.LateInitializeStringPtrFromVal(p.LicenseType, string(az.LicenseType))
+	}
+}
+
+// IsMSSQLElasticPoolUpToDate returns true if the supplied Azure SQL elastic
+// pool is in sync with the supplied parameters.
+func IsMSSQLElasticPoolUpToDate(p azuredbv1alpha3.MSSQLElasticPoolParameters, az sql.ElasticPool) (bool, error) { // nolint:gocyclo
+	if az.ElasticPoolProperties == nil {
+		return false, nil
+	}
+	s, err := newMSSQLElasticPoolPerDatabaseSettings(p.PerDatabaseSettings)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case !isMSSQLSkuUpToDate(p.SKU, az.Sku):
+		return false, nil
+	case p.MaxSizeBytes != nil && !reflect.DeepEqual(p.MaxSizeBytes, az.MaxSizeBytes):
+		return false, nil
+	case s != nil && (az.PerDatabaseSettings == nil ||
+		s.MinCapacity != nil && !reflect.DeepEqual(s.MinCapacity, az.PerDatabaseSettings.MinCapacity) ||
+		s.MaxCapacity != nil && !reflect.DeepEqual(s.MaxCapacity, az.PerDatabaseSettings.MaxCapacity)):
+		return false, nil
+	case p.ZoneRedundant != nil && *p.ZoneRedundant != azure.ToBool(az.ZoneRedundant):
+		return false, nil
+	case p.LicenseType != nil && *p.LicenseType != string(az.LicenseType):
+		return false, nil
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), az.Tags):
+		return false, nil
+	}
+	return true, nil
+}
+
+// UpdateMSSQLElasticPoolObservation produces an MSSQLElasticPoolObservation
+// from the supplied Azure SQL elastic pool.
+func UpdateMSSQLElasticPoolObservation(o *azuredbv1alpha3.MSSQLElasticPoolObservation, az sql.ElasticPool) {
+	o.ID = azure.ToString(az.ID)
+	o.Name = azure.ToString(az.Name)
+	o.Type = azure.ToString(az.Type)
+	if az.ElasticPoolProperties == nil {
+		return
+	}
+	o.State = string(az.State)
+}
+
+// MSSQLFirewallRulesClient is the concrete implementation of the
+// FirewallRuleAPI interface for Azure SQL that calls Azure API.
+type MSSQLFirewallRulesClient struct {
+	client sqlapi.FirewallRulesClientAPI
+}
+
+// NewMSSQLFirewallRulesClient creates and initializes a
+// MSSQLFirewallRulesClient instance.
+func NewMSSQLFirewallRulesClient(cl sqlapi.FirewallRulesClientAPI) *MSSQLFirewallRulesClient {
+	return &MSSQLFirewallRulesClient{client: cl}
+}
+
+// GetFirewallRule retrieves the requested Azure SQL firewall rule.
+func (c *MSSQLFirewallRulesClient) GetFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (FirewallRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	r := FirewallRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.FirewallRuleProperties != nil {
+		r.StartIPAddress = az.StartIPAddress
+		r.EndIPAddress = az.EndIPAddress
+	}
+	return r, err
+}
+
+// CreateOrUpdateFirewallRule creates or updates the supplied Azure SQL
+// firewall rule.
+func (c *MSSQLFirewallRulesClient) CreateOrUpdateFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r FirewallRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, sql.FirewallRule{
+		FirewallRuleProperties: &sql.FirewallRuleProperties{
+			StartIPAddress: r.StartIPAddress,
+			EndIPAddress:   r.EndIPAddress,
+		},
+	})
+	return err
+}
+
+// DeleteFirewallRule deletes the supplied Azure SQL firewall rule.
+func (c *MSSQLFirewallRulesClient) DeleteFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
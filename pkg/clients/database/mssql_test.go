@@ -0,0 +1,303 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestMSSQLConnectionString(t *testing.T) {
+	endpoint := "cool.database.windows.net"
+
+	cases := map[string]struct {
+		database string
+		user     string
+		password string
+		want     string
+	}{
+		"Server": {
+			user:     "cooladmin",
+			password: "verysecure",
+			want:     "Server=tcp:cool.database.windows.net,1433;Persist Security Info=False;User ID=cooladmin;Password=verysecure;MultipleActiveResultSets=False;Encrypt=True;TrustServerCertificate=False;Connection Timeout=30;",
+		},
+		"Database": {
+			database: "cooldb",
+			user:     "cooladmin",
+			password: "verysecure",
+			want:     "Server=tcp:cool.database.windows.net,1433;Initial Catalog=cooldb;Persist Security Info=False;User ID=cooladmin;Password=verysecure;MultipleActiveResultSets=False;Encrypt=True;TrustServerCertificate=False;Connection Timeout=30;",
+		},
+		"QuotedPassword": {
+			user:     "cooladmin",
+			password: `very;"secure"`,
+			want:     `Server=tcp:cool.database.windows.net,1433;Persist Security Info=False;User ID=cooladmin;Password="very;""secure""";MultipleActiveResultSets=False;Encrypt=True;TrustServerCertificate=False;Connection Timeout=30;`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MSSQLConnectionString(endpoint, tc.database, tc.user, tc.password)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MSSQLConnectionString(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMSSQLServerUpdate(t *testing.T) {
+	p := azuredbv1alpha3.MSSQLServerParameters{
+		AdministratorLogin:  "cooladmin",
+		MinimalTLSVersion:   azure.ToStringPtr("1.2"),
+		PublicNetworkAccess: azure.ToStringPtr("Disabled"),
+	}
+
+	cases := map[string]struct {
+		password string
+		want     sql.ServerUpdate
+	}{
+		"PasswordUnchanged": {
+			want: sql.ServerUpdate{
+				ServerProperties: &sql.ServerProperties{
+					MinimalTLSVersion:   azure.ToStringPtr("1.2"),
+					PublicNetworkAccess: sql.ServerPublicNetworkAccessDisabled,
+				},
+			},
+		},
+		"PasswordChanged": {
+			password: "verysecure",
+			want: sql.ServerUpdate{
+				ServerProperties: &sql.ServerProperties{
+					AdministratorLoginPassword: azure.ToStringPtr("verysecure"),
+					MinimalTLSVersion:          azure.ToStringPtr("1.2"),
+					PublicNetworkAccess:        sql.ServerPublicNetworkAccessDisabled,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewMSSQLServerUpdate(p, tc.password)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewMSSQLServerUpdate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMSSQLDatabase(t *testing.T) {
+	poolID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Sql/servers/cool/elasticPools/pool"
+
+	cases := map[string]struct {
+		p    azuredbv1alpha3.MSSQLDatabaseParameters
+		want sql.Database
+	}{
+		"DTU": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				Location: "westeurope",
+				SKU:      &azuredbv1alpha3.MSSQLSKU{Name: "S0", Tier: azure.ToStringPtr("Standard")},
+			},
+			want: sql.Database{
+				Location:           azure.ToStringPtr("westeurope"),
+				Sku:                &sql.Sku{Name: azure.ToStringPtr("S0"), Tier: azure.ToStringPtr("Standard")},
+				DatabaseProperties: &sql.DatabaseProperties{},
+			},
+		},
+		"VCore": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				Location:    "westeurope",
+				SKU:         &azuredbv1alpha3.MSSQLSKU{Name: "GP_Gen5", Family: azure.ToStringPtr("Gen5"), Capacity: to.IntPtr(2)},
+				LicenseType: azure.ToStringPtr("BasePrice"),
+			},
+			want: sql.Database{
+				Location: azure.ToStringPtr("westeurope"),
+				Sku:      &sql.Sku{Name: azure.ToStringPtr("GP_Gen5"), Family: azure.ToStringPtr("Gen5"), Capacity: azure.ToInt32Ptr(2)},
+				DatabaseProperties: &sql.DatabaseProperties{
+					LicenseType: sql.BasePrice,
+				},
+			},
+		},
+		"ElasticPool": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				Location:      "westeurope",
+				SKU:           &azuredbv1alpha3.MSSQLSKU{Name: "S0"},
+				ElasticPoolID: azure.ToStringPtr(poolID),
+			},
+			want: sql.Database{
+				Location:           azure.ToStringPtr("westeurope"),
+				DatabaseProperties: &sql.DatabaseProperties{ElasticPoolID: azure.ToStringPtr(poolID)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewMSSQLDatabase(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewMSSQLDatabase(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMSSQLDatabaseUpToDate(t *testing.T) {
+	poolID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Sql/servers/cool/elasticPools/pool"
+
+	cases := map[string]struct {
+		p    azuredbv1alpha3.MSSQLDatabaseParameters
+		az   sql.Database
+		want bool
+	}{
+		"UpToDate": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				SKU:          &azuredbv1alpha3.MSSQLSKU{Name: "S0"},
+				MaxSizeBytes: to.Int64Ptr(1024),
+			},
+			az: sql.Database{
+				Sku:                &sql.Sku{Name: azure.ToStringPtr("S0"), Tier: azure.ToStringPtr("Standard"), Capacity: azure.ToInt32Ptr(10)},
+				DatabaseProperties: &sql.DatabaseProperties{MaxSizeBytes: to.Int64Ptr(1024)},
+			},
+			want: true,
+		},
+		"SKUChanged": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				SKU: &azuredbv1alpha3.MSSQLSKU{Name: "S1"},
+			},
+			az: sql.Database{
+				Sku:                &sql.Sku{Name: azure.ToStringPtr("S0")},
+				DatabaseProperties: &sql.DatabaseProperties{},
+			},
+			want: false,
+		},
+		"InElasticPool": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				SKU:           &azuredbv1alpha3.MSSQLSKU{Name: "S1"},
+				ElasticPoolID: azure.ToStringPtr(poolID),
+			},
+			az: sql.Database{
+				Sku:                &sql.Sku{Name: azure.ToStringPtr("ElasticPool")},
+				DatabaseProperties: &sql.DatabaseProperties{ElasticPoolID: azure.ToStringPtr(poolID)},
+			},
+			want: true,
+		},
+		"ElasticPoolChanged": {
+			p: azuredbv1alpha3.MSSQLDatabaseParameters{
+				ElasticPoolID: azure.ToStringPtr(poolID),
+			},
+			az: sql.Database{
+				DatabaseProperties: &sql.DatabaseProperties{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMSSQLDatabaseUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMSSQLDatabaseUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMSSQLElasticPool(t *testing.T) {
+	cases := map[string]struct {
+		p       azuredbv1alpha3.MSSQLElasticPoolParameters
+		want    sql.ElasticPool
+		wantErr error
+	}{
+		"Successful": {
+			p: azuredbv1alpha3.MSSQLElasticPoolParameters{
+				Location: "westeurope",
+				SKU:      azuredbv1alpha3.MSSQLSKU{Name: "StandardPool", Capacity: to.IntPtr(50)},
+				PerDatabaseSettings: &azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MinCapacity: azure.ToStringPtr("0"),
+					MaxCapacity: azure.ToStringPtr("0.5"),
+				},
+			},
+			want: sql.ElasticPool{
+				Location: azure.ToStringPtr("westeurope"),
+				Sku:      &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Capacity: azure.ToInt32Ptr(50)},
+				ElasticPoolProperties: &sql.ElasticPoolProperties{
+					PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{
+						MinCapacity: to.Float64Ptr(0),
+						MaxCapacity: to.Float64Ptr(0.5),
+					},
+				},
+			},
+		},
+		"InvalidCapacity": {
+			p: azuredbv1alpha3.MSSQLElasticPoolParameters{
+				PerDatabaseSettings: &azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+					MaxCapacity: azure.ToStringPtr("lots"),
+				},
+			},
+			wantErr: errors.Wrap(errors.New(`strconv.ParseFloat: parsing "lots": invalid syntax`), errParseMaxCapacity),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewMSSQLElasticPool(tc.p)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewMSSQLElasticPool(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewMSSQLElasticPool(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeMSSQLElasticPool(t *testing.T) {
+	p := azuredbv1alpha3.MSSQLElasticPoolParameters{
+		SKU: azuredbv1alpha3.MSSQLSKU{Name: "GP_Gen5"},
+	}
+	LateInitializeMSSQLElasticPool(&p, sql.ElasticPool{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("GP_Gen5"), Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: azure.ToInt32Ptr(2)},
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes: to.Int64Ptr(1024),
+			PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{
+				MinCapacity: to.Float64Ptr(0.25),
+				MaxCapacity: to.Float64Ptr(2),
+			},
+			LicenseType: sql.ElasticPoolLicenseTypeLicenseIncluded,
+		},
+	})
+	want := azuredbv1alpha3.MSSQLElasticPoolParameters{
+		SKU: azuredbv1alpha3.MSSQLSKU{Name: "GP_Gen5", Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: to.IntPtr(2)},
+		PerDatabaseSettings: &azuredbv1alpha3.MSSQLElasticPoolPerDatabaseSettings{
+			MinCapacity: azure.ToStringPtr("0.25"),
+			MaxCapacity: azure.ToStringPtr("2"),
+		},
+		MaxSizeBytes: to.Int64Ptr(1024),
+		LicenseType:  azure.ToStringPtr("LicenseIncluded"),
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeMSSQLElasticPool(...): -want, +got\n%s", diff)
+	}
+}
@@ -18,14 +18,12 @@ package database
 
 import (
 	"context"
-	"fmt"
 	"net/http"
-	"reflect"
-	"strconv"
 
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql/mysqlapi"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/google/go-cmp/cmp"
+	"github.com/Azure/go-autorest/autorest/to"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
@@ -35,22 +33,544 @@ import (
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
-// NOTE: postgresql and mysql structs and functions live in their respective
-// packages even though they are exactly the same. However, Crossplane does not
-// make that assumption and use the respective package for each type, although,
-// they both share the same SQLServerParameters and SQLServerObservation objects.
-// https://github.com/Azure/azure-sdk-for-go/blob/master/services/mysql/mgmt/2017-12-01/mysql/models.go
-// https://github.com/Azure/azure-sdk-for-go/blob/master/services/postgresql/mgmt/2017-12-01/postgresql/models.go
+// MySQLServersClient is the concrete implementation of the SQLServerAPI
+// interface for MySQL that calls Azure API.
+type MySQLServersClient struct {
+	mysql.ServersClient
+}
 
-var (
-	skuShortTiers = map[mysql.SkuTier]string{
-		mysql.Basic:           "B",
-		mysql.GeneralPurpose:  "GP",
-		mysql.MemoryOptimized: "MO",
+// NewMySQLServersClient creates and initializes a MySQLServersClient
+// instance.
+func NewMySQLServersClient(cl mysql.ServersClient) *MySQLServersClient {
+	return &MySQLServersClient{
+		ServersClient: cl,
 	}
-)
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *MySQLServersClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested MySQL Server.
+func (c *MySQLServersClient) GetServer(ctx context.Context, resourceGroupName, serverName string) (Server, error) {
+	s, err := c.ServersClient.Get(ctx, resourceGroupName, serverName)
+	return fromMySQLServer(s), err
+}
+
+// CreateServer creates a MySQL Server.
+func (c *MySQLServersClient) CreateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, adminPassword string) (string, error) {
+	p, err := NewServerCreateParameters(s, adminPassword)
+	if err != nil {
+		return "", err
+	}
+	op, err := c.Create(ctx, s.ResourceGroupName, serverName, newMySQLServerForCreate(p))
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// UpdateServer updates a MySQL Server with the supplied observed replication
+// role. The administrator login password is changed as well unless the
+// supplied password is empty.
+func (c *MySQLServersClient) UpdateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, replicationRole, adminPassword string) (string, error) {
+	p, err := NewServerUpdateParameters(s, replicationRole, adminPassword)
+	if err != nil {
+		return "", err
+	}
+	op, err := c.Update(ctx, s.ResourceGroupName, serverName, newMySQLServerUpdateParameters(p))
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// DeleteServer deletes the supplied MySQL Server.
+func (c *MySQLServersClient) DeleteServer(ctx context.Context, resourceGroupName, serverName string) (string, error) {
+	op, err := c.ServersClient.Delete(ctx, resourceGroupName, serverName)
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+func newMySQLSku(s ServerSKU) *mysql.Sku {
+	return &mysql.Sku{
+		Name:     s.Name,
+		Tier:     mysql.SkuTier(s.Tier),
+		Capacity: s.Capacity,
+		Size:     s.Size,
+		Family:   s.Family,
+	}
+}
+
+func newMySQLResourceIdentity(i *ServerIdentity) *mysql.ResourceIdentity {
+	if i == nil {
+		return nil
+	}
+	return &mysql.ResourceIdentity{Type: mysql.IdentityType(i.Type)}
+}
+
+func newMySQLStorageProfile(s ServerStorageProfile) *mysql.StorageProfile {
+	return &mysql.StorageProfile{
+		BackupRetentionDays: s.BackupRetentionDays,
+		GeoRedundantBackup:  mysql.GeoRedundantBackup(s.GeoRedundantBackup),
+		StorageMB:           s.StorageMB,
+		StorageAutogrow:     mysql.StorageAutogrow(s.StorageAutogrow),
+	}
+}
+
+func newMySQLServerPropertiesForCreate(p ServerParameters) mysql.BasicServerPropertiesForCreate {
+	switch p.CreateMode {
+	case azuredbv1beta1.CreateModePointInTimeRestore:
+		return &mysql.ServerPropertiesForRestore{
+			SourceServerID:           p.SourceServerID,
+			RestorePointInTime:       p.RestorePointInTime,
+			MinimalTLSVersion:        mysql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			Version:                  mysql.ServerVersion(p.Version),
+			SslEnforcement:           mysql.SslEnforcementEnum(p.SSLEnforcement),
+			InfrastructureEncryption: mysql.InfrastructureEncryption(p.InfrastructureEncryption),
+			PublicNetworkAccess:      mysql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:               mysql.CreateModePointInTimeRestore,
+			StorageProfile:           newMySQLStorageProfile(p.StorageProfile),
+		}
+	case azuredbv1beta1.CreateModeGeoRestore:
+		return &mysql.ServerPropertiesForGeoRestore{
+			SourceServerID:           p.SourceServerID,
+			MinimalTLSVersion:        mysql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			Version:                  mysql.ServerVersion(p.Version),
+			SslEnforcement:           mysql.SslEnforcementEnum(p.SSLEnforcement),
+			InfrastructureEncryption: mysql.InfrastructureEncryption(p.InfrastructureEncryption),
+			PublicNetworkAccess:      mysql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:               mysql.CreateModeGeoRestore,
+			StorageProfile:           newMySQLStorageProfile(p.StorageProfile),
+		}
+	case azuredbv1beta1.CreateModeReplica:
+		return &mysql.ServerPropertiesForReplica{
+			SourceServerID:           p.SourceServerID,
+			MinimalTLSVersion:        mysql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			Version:                  mysql.ServerVersion(p.Version),
+			SslEnforcement:           mysql.SslEnforcementEnum(p.SSLEnforcement),
+			InfrastructureEncryption: mysql.InfrastructureEncryption(p.InfrastructureEncryption),
+			PublicNetworkAccess:      mysql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:               mysql.CreateModeReplica,
+			StorageProfile:           newMySQLStorageProfile(p.StorageProfile),
+		}
+	}
+	return &mysql.ServerPropertiesForDefaultCreate{
+		AdministratorLogin:         p.AdministratorLogin,
+		AdministratorLoginPassword: p.AdministratorLoginPassword,
+		MinimalTLSVersion:          mysql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+		Version:                    mysql.ServerVersion(p.Version),
+		SslEnforcement:             mysql.SslEnforcementEnum(p.SSLEnforcement),
+		InfrastructureEncryption:   mysql.InfrastructureEncryption(p.InfrastructureEncryption),
+		PublicNetworkAccess:        mysql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+		CreateMode:                 mysql.CreateModeDefault,
+		StorageProfile:             newMySQLStorageProfile(p.StorageProfile),
+	}
+}
+
+func newMySQLServerForCreate(p ServerParameters) mysql.ServerForCreate {
+	return mysql.ServerForCreate{
+		Identity:   newMySQLResourceIdentity(p.Identity),
+		Sku:        newMySQLSku(p.SKU),
+		Properties: newMySQLServerPropertiesForCreate(p),
+		Location:   azure.ToStringPtr(p.Location),
+		Tags:       p.Tags,
+	}
+}
+
+func newMySQLServerUpdateParameters(p ServerParameters) mysql.ServerUpdateParameters {
+	return mysql.ServerUpdateParameters{
+		Identity: newMySQLResourceIdentity(p.Identity),
+		Sku:      newMySQLSku(p.SKU),
+		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
+			AdministratorLoginPassword: p.AdministratorLoginPassword,
+			Version:                    mysql.ServerVersion(p.Version),
+			MinimalTLSVersion:          mysql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			SslEnforcement:             mysql.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess:        mysql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			ReplicationRole:            p.ReplicationRole,
+			StorageProfile:             newMySQLStorageProfile(p.StorageProfile),
+		},
+		Tags: p.Tags,
+	}
+}
+
+func fromMySQLServer(in mysql.Server) Server { // nolint:gocyclo
+	s := Server{
+		ID:   azure.ToString(in.ID),
+		Name: azure.ToString(in.Name),
+		Type: azure.ToString(in.Type),
+		Tags: in.Tags,
+	}
+	if in.Sku != nil {
+		s.SKU = &ServerSKU{
+			Name:     in.Sku.Name,
+			Tier:     string(in.Sku.Tier),
+			Capacity: in.Sku.Capacity,
+			Size:     in.Sku.Size,
+			Family:   in.Sku.Family,
+		}
+	}
+	if in.Identity != nil {
+		s.Identity = &ServerIdentity{
+			Type:        string(in.Identity.Type),
+			PrincipalID: uuidToString(in.Identity.PrincipalID),
+			TenantID:    uuidToString(in.Identity.TenantID),
+		}
+	}
+	if in.ServerProperties == nil {
+		return s
+	}
+	s.Version = string(in.Version)
+	s.SSLEnforcement = string(in.SslEnforcement)
+	s.MinimalTLSVersion = string(in.MinimalTLSVersion)
+	s.InfrastructureEncryption = string(in.InfrastructureEncryption)
+	s.PublicNetworkAccess = string(in.PublicNetworkAccess)
+	s.UserVisibleState = string(in.UserVisibleState)
+	s.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+	s.MasterServerID = azure.ToString(in.MasterServerID)
+	s.ReplicationRole = azure.ToString(in.ReplicationRole)
+	s.ReplicaCapacity = in.ReplicaCapacity
+	s.ByokEnforcement = azure.ToString(in.ByokEnforcement)
+	if in.StorageProfile != nil {
+		s.StorageProfile = &ServerStorageProfile{
+			BackupRetentionDays: in.StorageProfile.BackupRetentionDays,
+			GeoRedundantBackup:  string(in.StorageProfile.GeoRedundantBackup),
+			StorageMB:           in.StorageProfile.StorageMB,
+			StorageAutogrow:     string(in.StorageProfile.StorageAutogrow),
+		}
+	}
+	return s
+}
+
+// MySQLFirewallRulesClient is the concrete implementation of the
+// FirewallRuleAPI interface for MySQL that calls Azure API.
+type MySQLFirewallRulesClient struct {
+	client mysqlapi.FirewallRulesClientAPI
+}
+
+// NewMySQLFirewallRulesClient creates and initializes a
+// MySQLFirewallRulesClient instance.
+func NewMySQLFirewallRulesClient(cl mysqlapi.FirewallRulesClientAPI) *MySQLFirewallRulesClient {
+	return &MySQLFirewallRulesClient{client: cl}
+}
+
+// GetFirewallRule retrieves the requested MySQL firewall rule.
+func (c *MySQLFirewallRulesClient) GetFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (FirewallRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	return fromMySQLFirewallRule(az), err
+}
+
+// CreateOrUpdateFirewallRule creates or updates the supplied MySQL firewall
+// rule.
+func (c *MySQLFirewallRulesClient) CreateOrUpdateFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r FirewallRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, newMySQLFirewallRule(ruleName, r))
+	return err
+}
+
+// DeleteFirewallRule deletes the supplied MySQL firewall rule.
+func (c *MySQLFirewallRulesClient) DeleteFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
+
+func newMySQLFirewallRule(name string, r FirewallRule) mysql.FirewallRule {
+	return mysql.FirewallRule{
+		Name: azure.ToStringPtr(name),
+		FirewallRuleProperties: &mysql.FirewallRuleProperties{
+			StartIPAddress: r.StartIPAddress,
+			EndIPAddress:   r.EndIPAddress,
+		},
+	}
+}
+
+func fromMySQLFirewallRule(az mysql.FirewallRule) FirewallRule {
+	r := FirewallRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.FirewallRuleProperties != nil {
+		r.StartIPAddress = az.StartIPAddress
+		r.EndIPAddress = az.EndIPAddress
+	}
+	return r
+}
+
+// MySQLVirtualNetworkRulesClient is the concrete implementation of the
+// VirtualNetworkRuleAPI interface for MySQL that calls Azure API.
+type MySQLVirtualNetworkRulesClient struct {
+	client mysqlapi.VirtualNetworkRulesClientAPI
+}
+
+// NewMySQLVirtualNetworkRulesClient creates and initializes a
+// MySQLVirtualNetworkRulesClient instance.
+func NewMySQLVirtualNetworkRulesClient(cl mysqlapi.VirtualNetworkRulesClientAPI) *MySQLVirtualNetworkRulesClient {
+	return &MySQLVirtualNetworkRulesClient{client: cl}
+}
+
+// GetVirtualNetworkRule retrieves the requested MySQL virtual network rule.
+func (c *MySQLVirtualNetworkRulesClient) GetVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (VirtualNetworkRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	return fromMySQLVirtualNetworkRule(az), err
+}
+
+// CreateOrUpdateVirtualNetworkRule creates or updates the supplied MySQL
+// virtual network rule.
+func (c *MySQLVirtualNetworkRulesClient) CreateOrUpdateVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r VirtualNetworkRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, newMySQLVirtualNetworkRule(ruleName, r))
+	return err
+}
+
+// DeleteVirtualNetworkRule deletes the supplied MySQL virtual network rule.
+func (c *MySQLVirtualNetworkRulesClient) DeleteVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
+
+func newMySQLVirtualNetworkRule(name string, r VirtualNetworkRule) mysql.VirtualNetworkRule {
+	return mysql.VirtualNetworkRule{
+		Name: azure.ToStringPtr(name),
+		VirtualNetworkRuleProperties: &mysql.VirtualNetworkRuleProperties{
+			VirtualNetworkSubnetID:           r.VirtualNetworkSubnetID,
+			IgnoreMissingVnetServiceEndpoint: r.IgnoreMissingVnetServiceEndpoint,
+		},
+	}
+}
+
+func fromMySQLVirtualNetworkRule(az mysql.VirtualNetworkRule) VirtualNetworkRule {
+	r := VirtualNetworkRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.VirtualNetworkRuleProperties != nil {
+		r.State = string(az.State)
+		r.VirtualNetworkSubnetID = az.VirtualNetworkSubnetID
+		r.IgnoreMissingVnetServiceEndpoint = az.IgnoreMissingVnetServiceEndpoint
+	}
+	return r
+}
+
+// MySQLConfigurationsClient is the concrete implementation of the
+// ConfigurationAPI interface for MySQL that calls Azure API.
+type MySQLConfigurationsClient struct {
+	client mysqlapi.ConfigurationsClientAPI
+}
+
+// NewMySQLConfigurationsClient creates and initializes a
+// MySQLConfigurationsClient instance.
+func NewMySQLConfigurationsClient(cl mysqlapi.ConfigurationsClientAPI) *MySQLConfigurationsClient {
+	return &MySQLConfigurationsClient{client: cl}
+}
+
+// GetConfiguration retrieves the requested MySQL configuration.
+func (c *MySQLConfigurationsClient) GetConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string) (Configuration, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, configurationName)
+	return fromMySQLConfiguration(configurationName, az), err
+}
+
+// CreateOrUpdateConfiguration creates or updates the supplied MySQL
+// configuration.
+func (c *MySQLConfigurationsClient) CreateOrUpdateConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string, cfg Configuration) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, configurationName, newMySQLConfiguration(cfg))
+	return err
+}
+
+// mysqlStaticParameters are the MySQL server parameters that cannot be
+// changed while the server is running. Changes to them take effect only
+// after the server is restarted.
+var mysqlStaticParameters = map[string]bool{
+	"innodb_buffer_pool_instances": true,
+	"innodb_log_file_size":         true,
+	"innodb_page_size":             true,
+	"innodb_read_io_threads":       true,
+	"innodb_write_io_threads":      true,
+	"lower_case_table_names":       true,
+	"performance_schema":           true,
+}
+
+func newMySQLConfiguration(c Configuration) mysql.Configuration {
+	return mysql.Configuration{
+		ConfigurationProperties: &mysql.ConfigurationProperties{
+			Value:  c.Value,
+			Source: c.Source,
+		},
+	}
+}
+
+func fromMySQLConfiguration(name string, az mysql.Configuration) Configuration {
+	c := Configuration{
+		ID:              azure.ToString(az.ID),
+		Type:            azure.ToString(az.Type),
+		RestartRequired: mysqlStaticParameters[name],
+	}
+	if az.ConfigurationProperties == nil {
+		return c
+	}
+	c.Value = to.StringPtr(azure.ToString(az.Value))
+	c.Source = to.StringPtr(azure.ToString(az.Source))
+	c.Description = azure.ToString(az.Description)
+	c.DefaultValue = az.DefaultValue
+	c.DataType = azure.ToString(az.DataType)
+	c.AllowedValues = azure.ToString(az.AllowedValues)
+	return c
+}
+
+// MySQLDatabasesClient is the concrete implementation of the DatabaseAPI
+// interface for MySQL that calls Azure API.
+type MySQLDatabasesClient struct {
+	client mysqlapi.DatabasesClientAPI
+}
+
+// NewMySQLDatabasesClient creates and initializes a MySQLDatabasesClient
+// instance.
+func NewMySQLDatabasesClient(cl mysqlapi.DatabasesClientAPI) *MySQLDatabasesClient {
+	return &MySQLDatabasesClient{client: cl}
+}
+
+// GetDatabase retrieves the requested MySQL database.
+func (c *MySQLDatabasesClient) GetDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (Database, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, databaseName)
+	return fromMySQLDatabase(az), err
+}
+
+// CreateOrUpdateDatabase creates or updates the supplied MySQL database.
+func (c *MySQLDatabasesClient) CreateOrUpdateDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string, d Database) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, newMySQLDatabase(d))
+	return err
+}
+
+// DeleteDatabase deletes the supplied MySQL database.
+func (c *MySQLDatabasesClient) DeleteDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, databaseName)
+	return err
+}
+
+func newMySQLDatabase(d Database) mysql.Database {
+	return mysql.Database{
+		DatabaseProperties: &mysql.DatabaseProperties{
+			Charset:   d.Charset,
+			Collation: d.Collation,
+		},
+	}
+}
+
+func fromMySQLDatabase(az mysql.Database) Database {
+	d := Database{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.DatabaseProperties != nil {
+		d.Charset = az.Charset
+		d.Collation = az.Collation
+	}
+	return d
+}
+
+// MySQLServerKeysClient is the concrete implementation of the ServerKeyAPI
+// interface for MySQL that calls Azure API.
+type MySQLServerKeysClient struct {
+	client mysqlapi.ServerKeysClientAPI
+}
+
+// NewMySQLServerKeysClient creates and initializes a MySQLServerKeysClient
+// instance.
+func NewMySQLServerKeysClient(cl mysqlapi.ServerKeysClientAPI) *MySQLServerKeysClient {
+	return &MySQLServerKeysClient{client: cl}
+}
+
+// GetServerKey retrieves the requested MySQL server key.
+func (c *MySQLServerKeysClient) GetServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) (ServerKey, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, keyName)
+	return fromMySQLServerKey(az), err
+}
+
+// CreateOrUpdateServerKey creates or updates the supplied MySQL server key.
+func (c *MySQLServerKeysClient) CreateOrUpdateServerKey(ctx context.Context, resourceGroupName, serverName, keyName string, k ServerKey) error {
+	_, err := c.client.CreateOrUpdate(ctx, serverName, keyName, newMySQLServerKey(k), resourceGroupName)
+	return err
+}
+
+// DeleteServerKey deletes the supplied MySQL server key.
+func (c *MySQLServerKeysClient) DeleteServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) error {
+	_, err := c.client.Delete(ctx, serverName, keyName, resourceGroupName)
+	return err
+}
+
+func newMySQLServerKey(k ServerKey) mysql.ServerKey {
+	return mysql.ServerKey{
+		ServerKeyProperties: &mysql.ServerKeyProperties{
+			ServerKeyType: k.ServerKeyType,
+			URI:           k.URI,
+		},
+	}
+}
+
+func fromMySQLServerKey(az mysql.ServerKey) ServerKey {
+	k := ServerKey{ID: azure.ToString(az.ID), Name: azure.ToString(az.Name), Type: azure.ToString(az.Type)}
+	if az.ServerKeyProperties != nil {
+		k.ServerKeyType = az.ServerKeyType
+		k.URI = az.URI
+		k.CreationDate = az.CreationDate
+	}
+	return k
+}
+
+// MySQLServerAdministratorsClient is the concrete implementation of the
+// ServerAdministratorAPI interface for MySQL that calls Azure API.
+type MySQLServerAdministratorsClient struct {
+	client mysqlapi.ServerAdministratorsClientAPI
+}
+
+// NewMySQLServerAdministratorsClient creates and initializes a
+// MySQLServerAdministratorsClient instance.
+func NewMySQLServerAdministratorsClient(cl mysqlapi.ServerAdministratorsClientAPI) *MySQLServerAdministratorsClient {
+	return &MySQLServerAdministratorsClient{client: cl}
+}
+
+// GetServerAdministrator retrieves the administrator of the requested MySQL
+// server.
+func (c *MySQLServerAdministratorsClient) GetServerAdministrator(ctx context.Context, resourceGroupName, serverName string) (ServerAdministrator, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName)
+	return fromMySQLServerAdministrator(az), err
+}
+
+// CreateOrUpdateServerAdministrator creates or updates the administrator of
+// the supplied MySQL server.
+func (c *MySQLServerAdministratorsClient) CreateOrUpdateServerAdministrator(ctx context.Context, resourceGroupName, serverName string, a ServerAdministrator) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, newMySQLServerAdministrator(a))
+	return err
+}
+
+// DeleteServerAdministrator deletes the administrator of the supplied MySQL
+// server.
+func (c *MySQLServerAdministratorsClient) DeleteServerAdministrator(ctx context.Context, resourceGroupName, serverName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName)
+	return err
+}
+
+func newMySQLServerAdministrator(a ServerAdministrator) mysql.ServerAdministratorResource {
+	return mysql.ServerAdministratorResource{
+		ServerAdministratorProperties: &mysql.ServerAdministratorProperties{
+			AdministratorType: a.AdministratorType,
+			Login:             a.Login,
+			Sid:               a.Sid,
+			TenantID:          a.TenantID,
+		},
+	}
+}
+
+func fromMySQLServerAdministrator(az mysql.ServerAdministratorResource) ServerAdministrator {
+	a := ServerAdministrator{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.ServerAdministratorProperties != nil {
+		a.AdministratorType = az.AdministratorType
+		a.Login = az.Login
+		a.Sid = az.Sid
+		a.TenantID = az.TenantID
+	}
+	return a
+}
+
+// NOTE: The MySQL specific API below predates the engine agnostic API above.
+// It is kept for backward compatibility and delegates to the engine agnostic
+// API.
 
 // MySQLServerAPI represents the API interface for a MySQL Server client
+//
+// Deprecated: Use SQLServerAPI.
 type MySQLServerAPI interface {
 	GetServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) (mysql.Server, error)
 	CreateServer(ctx context.Context, s *azuredbv1beta1.MySQLServer, adminPassword string) error
@@ -61,11 +581,15 @@ type MySQLServerAPI interface {
 
 // MySQLServerClient is the concrete implementation of the MySQLServerAPI
 // interface for MySQL that calls Azure API.
+//
+// Deprecated: Use MySQLServersClient.
 type MySQLServerClient struct {
 	mysql.ServersClient
 }
 
 // NewMySQLServerClient creates and initializes a MySQLServerClient instance.
+//
+// Deprecated: Use NewMySQLServersClient.
 func NewMySQLServerClient(cl mysql.ServersClient) *MySQLServerClient {
 	return &MySQLServerClient{
 		ServersClient: cl,
@@ -84,37 +608,12 @@ func (c *MySQLServerClient) GetServer(ctx context.Context, cr *azuredbv1beta1.My
 
 // CreateServer creates a MySQL Server.
 func (c *MySQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer, adminPassword string) error {
-	s := cr.Spec.ForProvider
-	properties := &mysql.ServerPropertiesForDefaultCreate{
-		AdministratorLogin:         azure.ToStringPtr(s.AdministratorLogin),
-		AdministratorLoginPassword: &adminPassword,
-		MinimalTLSVersion:          mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-		Version:                    mysql.ServerVersion(s.Version),
-		SslEnforcement:             mysql.SslEnforcementEnum(s.SSLEnforcement),
-		CreateMode:                 mysql.CreateModeDefault,
-		StorageProfile: &mysql.StorageProfile{
-			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
-			GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
-			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
-			StorageAutogrow:     mysql.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
-		},
-	}
-	sku, err := ToMySQLSKU(s.SKU)
-	if err != nil {
-		return err
-	}
-	createParams := mysql.ServerForCreate{
-		Sku:        sku,
-		Properties: properties,
-		Location:   &s.Location,
-		Tags:       azure.ToStringPtrMap(s.Tags),
-	}
-	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), createParams)
+	u, err := NewMySQLServersClient(c.ServersClient).CreateServer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, adminPassword)
 	if err != nil {
 		return err
 	}
 	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
+		PollingURL: u,
 		Method:     http.MethodPut,
 	}
 	return nil
@@ -122,35 +621,12 @@ func (c *MySQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1
 
 // UpdateServer updates a MySQL Server.
 func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer) error {
-	// TODO(muvaf): password update via Update call is supported by Azure but
-	// we don't support that.
-	s := cr.Spec.ForProvider
-	properties := &mysql.ServerUpdateParametersProperties{
-		Version:           mysql.ServerVersion(s.Version),
-		MinimalTLSVersion: mysql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-		SslEnforcement:    mysql.SslEnforcementEnum(s.SSLEnforcement),
-		StorageProfile: &mysql.StorageProfile{
-			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
-			GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
-			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
-			StorageAutogrow:     mysql.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
-		},
-	}
-	sku, err := ToMySQLSKU(s.SKU)
-	if err != nil {
-		return err
-	}
-	updateParams := mysql.ServerUpdateParameters{
-		Sku:                              sku,
-		ServerUpdateParametersProperties: properties,
-		Tags:                             azure.ToStringPtrMap(s.Tags),
-	}
-	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), updateParams)
+	u, err := NewMySQLServersClient(c.ServersClient).UpdateServer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider.ReplicationRole, "")
 	if err != nil {
 		return err
 	}
 	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
+		PollingURL: u,
 		Method:     http.MethodPatch,
 	}
 	return nil
@@ -158,140 +634,87 @@ func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1
 
 // DeleteServer deletes the given MySQLServer resource.
 func (c *MySQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer) error {
-	op, err := c.ServersClient.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	u, err := NewMySQLServersClient(c.ServersClient).DeleteServer(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
 	if err != nil {
 		return err
 	}
 	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
+		PollingURL: u,
 		Method:     http.MethodDelete,
 	}
 	return nil
 }
 
-// NewMySQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule object from a virtual network spec
-func NewMySQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.MySQLServerVirtualNetworkRule) mysql.VirtualNetworkRule {
-	return mysql.VirtualNetworkRule{
-		Name: azure.ToStringPtr(meta.GetExternalName(v)),
-		VirtualNetworkRuleProperties: &mysql.VirtualNetworkRuleProperties{
-			VirtualNetworkSubnetID:           azure.ToStringPtr(v.Spec.VirtualNetworkRuleProperties.VirtualNetworkSubnetID),
-			IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(v.Spec.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, azure.FieldRequired),
-		},
+// ToMySQLSKU returns a *mysql.Sku object that can be used in Azure API calls.
+//
+// Deprecated: Use NewServerSKU.
+func ToMySQLSKU(skuSpec azuredbv1beta1.SKU) (*mysql.Sku, error) {
+	s, err := NewServerSKU(skuSpec)
+	if err != nil {
+		return nil, err
 	}
+	return newMySQLSku(s), nil
 }
 
-// MySQLServerVirtualNetworkRuleNeedsUpdate determines if a virtual network rule needs to be updated
-func MySQLServerVirtualNetworkRuleNeedsUpdate(kube *azuredbv1alpha3.MySQLServerVirtualNetworkRule, az mysql.VirtualNetworkRule) bool {
-	up := NewMySQLVirtualNetworkRuleParameters(kube)
-
-	switch {
-	case !reflect.DeepEqual(up.VirtualNetworkRuleProperties.VirtualNetworkSubnetID, az.VirtualNetworkRuleProperties.VirtualNetworkSubnetID):
-		return true
-	case !reflect.DeepEqual(up.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, az.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint):
-		return true
-	}
+// UpdateMySQLObservation produces SQLServerObservation from mysql.Server.
+//
+// Deprecated: Use UpdateServerObservation.
+func UpdateMySQLObservation(o *azuredbv1beta1.SQLServerObservation, in mysql.Server) {
+	UpdateServerObservation(o, fromMySQLServer(in))
+}
 
-	return false
+// LateInitializeMySQL fills the empty values of SQLServerParameters with the
+// ones that are retrieved from the Azure API.
+//
+// Deprecated: Use LateInitializeServer.
+func LateInitializeMySQL(p *azuredbv1beta1.SQLServerParameters, in mysql.Server) {
+	LateInitializeServer(p, fromMySQLServer(in))
 }
 
-// UpdateMySQLVirtualNetworkRuleStatusFromAzure updates the status related to the external
-// Azure MySQLVirtualNetworkRule in the VirtualNetworkStatus
-func UpdateMySQLVirtualNetworkRuleStatusFromAzure(v *azuredbv1alpha3.MySQLServerVirtualNetworkRule, az mysql.VirtualNetworkRule) {
-	v.Status.State = string(az.VirtualNetworkRuleProperties.State)
-	v.Status.ID = azure.ToString(az.ID)
-	v.Status.Type = azure.ToString(az.Type)
+// IsMySQLUpToDate is used to report whether given mysql.Server is in
+// sync with the SQLServerParameters that user desires.
+//
+// Deprecated: Use IsServerUpToDate.
+func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool {
+	return IsServerUpToDate(p, fromMySQLServer(in))
 }
 
 // NewMySQLFirewallRuleParameters returns an Azure FirewallRule object from a
 // firewall spec.
+//
+// Deprecated: Use NewFirewallRule.
 func NewMySQLFirewallRuleParameters(r *azuredbv1alpha3.MySQLServerFirewallRule) mysql.FirewallRule {
-	return mysql.FirewallRule{
-		Name: azure.ToStringPtr(meta.GetExternalName(r)),
-		FirewallRuleProperties: &mysql.FirewallRuleProperties{
-			StartIPAddress: azure.ToStringPtr(r.Spec.ForProvider.StartIPAddress),
-			EndIPAddress:   azure.ToStringPtr(r.Spec.ForProvider.EndIPAddress),
-		},
-	}
+	return newMySQLFirewallRule(meta.GetExternalName(r), NewFirewallRule(r.Spec.ForProvider))
 }
 
 // MySQLServerFirewallRuleIsUpToDate returns true if the supplied FirewallRule
 // appears to be up to date with the supplied MySQLServerFirewallRule.
+//
+// Deprecated: Use FirewallRuleIsUpToDate.
 func MySQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.MySQLServerFirewallRule, az mysql.FirewallRule) bool {
-	up := NewMySQLFirewallRuleParameters(kube)
-	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
-}
-
-// The name must match the specification of the SKU, so, we don't allow user
-// to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
-
-// ToMySQLSKU returns a *mysql.Sku object that can be used in Azure API calls.
-func ToMySQLSKU(skuSpec azuredbv1beta1.SKU) (*mysql.Sku, error) {
-	t, ok := skuShortTiers[mysql.SkuTier(skuSpec.Tier)]
-	if !ok {
-		return nil, fmt.Errorf("tier '%s' is not one of the supported values: %+v", skuSpec.Tier, mysql.PossibleSkuTierValues())
-	}
-	return &mysql.Sku{
-		Name:     azure.ToStringPtr(fmt.Sprintf("%s_%s_%s", t, skuSpec.Family, strconv.Itoa(skuSpec.Capacity))),
-		Tier:     mysql.SkuTier(skuSpec.Tier),
-		Capacity: azure.ToInt32Ptr(skuSpec.Capacity),
-		Family:   azure.ToStringPtr(skuSpec.Family),
-		Size:     skuSpec.Size,
-	}, nil
+	return FirewallRuleIsUpToDate(kube.Spec.ForProvider, fromMySQLFirewallRule(az))
 }
 
-// UpdateMySQLObservation produces SQLServerObservation from mysql.Server.
-func UpdateMySQLObservation(o *azuredbv1beta1.SQLServerObservation, in mysql.Server) {
-	o.ID = azure.ToString(in.ID)
-	o.Name = azure.ToString(in.Name)
-	o.Type = azure.ToString(in.Type)
-	o.UserVisibleState = string(in.UserVisibleState)
-	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
-	o.MasterServerID = azure.ToString(in.MasterServerID)
+// NewMySQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule
+// object from a virtual network spec.
+//
+// Deprecated: Use NewVirtualNetworkRule.
+func NewMySQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.MySQLServerVirtualNetworkRule) mysql.VirtualNetworkRule {
+	return newMySQLVirtualNetworkRule(meta.GetExternalName(v), NewVirtualNetworkRule(v.Spec.VirtualNetworkRuleProperties))
 }
 
-// LateInitializeMySQL fills the empty values of SQLServerParameters with the
-// ones that are retrieved from the Azure API.
-func LateInitializeMySQL(p *azuredbv1beta1.SQLServerParameters, in mysql.Server) {
-	if in.Sku != nil {
-		p.SKU.Size = azure.LateInitializeStringPtrFromPtr(p.SKU.Size, in.Sku.Size)
-	}
-	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
-	if in.StorageProfile != nil {
-		p.StorageProfile.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageProfile.BackupRetentionDays, in.StorageProfile.BackupRetentionDays)
-		p.StorageProfile.GeoRedundantBackup = azure.LateInitializeStringPtrFromVal(p.StorageProfile.GeoRedundantBackup, string(in.StorageProfile.GeoRedundantBackup))
-		p.StorageProfile.StorageAutogrow = azure.LateInitializeStringPtrFromVal(p.StorageProfile.StorageAutogrow, string(in.StorageProfile.StorageAutogrow))
-	}
+// MySQLServerVirtualNetworkRuleNeedsUpdate determines if a virtual network
+// rule needs to be updated.
+//
+// Deprecated: Use VirtualNetworkRuleNeedsUpdate.
+func MySQLServerVirtualNetworkRuleNeedsUpdate(kube *azuredbv1alpha3.MySQLServerVirtualNetworkRule, az mysql.VirtualNetworkRule) bool {
+	return VirtualNetworkRuleNeedsUpdate(kube.Spec.VirtualNetworkRuleProperties, fromMySQLVirtualNetworkRule(az))
 }
 
-// IsMySQLUpToDate is used to report whether given mysql.Server is in
-// sync with the SQLServerParameters that user desires.
-func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool { // nolint:gocyclo
-	if in.StorageProfile == nil || in.Sku == nil {
-		return false
-	}
-	switch {
-	case p.MinimalTLSVersion != azuredbv1beta1.MinimalTLSVersionEnum(in.MinimalTLSVersion):
-		return false
-	case p.SSLEnforcement != string(in.SslEnforcement):
-		return false
-	case p.Version != string(in.Version):
-		return false
-	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
-		return false
-	case p.SKU.Tier != string(in.Sku.Tier):
-		return false
-	case p.SKU.Capacity != azure.ToInt(in.Sku.Capacity):
-		return false
-	case p.SKU.Family != azure.ToString(in.Sku.Family):
-		return false
-	case !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageProfile.BackupRetentionDays), in.StorageProfile.BackupRetentionDays):
-		return false
-	case azure.ToString(p.StorageProfile.GeoRedundantBackup) != string(in.StorageProfile.GeoRedundantBackup):
-		return false
-	case p.StorageProfile.StorageMB != azure.ToInt(in.StorageProfile.StorageMB):
-		return false
-	case azure.ToString(p.StorageProfile.StorageAutogrow) != string(in.StorageProfile.StorageAutogrow):
-		return false
-	}
-	return true
+// UpdateMySQLVirtualNetworkRuleStatusFromAzure updates the status related to
+// the external Azure MySQLVirtualNetworkRule in the VirtualNetworkStatus.
+//
+// Deprecated: Use UpdateVirtualNetworkRuleStatus.
+func UpdateMySQLVirtualNetworkRuleStatusFromAzure(v *azuredbv1alpha3.MySQLServerVirtualNetworkRule, az mysql.VirtualNetworkRule) {
+	UpdateVirtualNetworkRuleStatus(&v.Status, fromMySQLVirtualNetworkRule(az))
 }
@@ -18,15 +18,21 @@ package database
 
 import (
 	"testing"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
@@ -357,3 +363,226 @@ func TestMySQLServerFirewallRuleIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMySQLServerForCreate(t *testing.T) {
+	sourceID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.DBforMySQL/servers/source"
+	restoreTime := metav1.NewTime(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	admin := "cooladmin"
+	pw := "verysecure"
+	sku := azuredbv1beta1.SKU{Tier: SKUTierBasic, Family: "Gen5", Capacity: 1}
+	storage := &mysql.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)}
+	params := func(mode string) azuredbv1beta1.SQLServerParameters {
+		return azuredbv1beta1.SQLServerParameters{
+			AdministratorLogin: admin,
+			CreateMode:         azure.ToStringPtr(mode),
+			SourceServerID:     azure.ToStringPtr(sourceID),
+			RestorePointInTime: &restoreTime,
+			SKU:                sku,
+			StorageProfile:     azuredbv1beta1.StorageProfile{StorageMB: 5120},
+		}
+	}
+
+	type want struct {
+		p   mysql.BasicServerPropertiesForCreate
+		err error
+	}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		want want
+	}{
+		"Default": {
+			p: azuredbv1beta1.SQLServerParameters{
+				AdministratorLogin: admin,
+				SKU:                sku,
+				StorageProfile:     azuredbv1beta1.StorageProfile{StorageMB: 5120},
+			},
+			want: want{p: &mysql.ServerPropertiesForDefaultCreate{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(pw),
+				CreateMode:                 mysql.CreateModeDefault,
+				StorageProfile:             storage,
+			}},
+		},
+		"DefaultWithEncryptionAndNetworkAccess": {
+			p: azuredbv1beta1.SQLServerParameters{
+				AdministratorLogin:       admin,
+				InfrastructureEncryption: azure.ToStringPtr("Enabled"),
+				PublicNetworkAccess:      azure.ToStringPtr("Disabled"),
+				SKU:                      sku,
+				StorageProfile:           azuredbv1beta1.StorageProfile{StorageMB: 5120},
+			},
+			want: want{p: &mysql.ServerPropertiesForDefaultCreate{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(pw),
+				InfrastructureEncryption:   mysql.InfrastructureEncryptionEnabled,
+				PublicNetworkAccess:        mysql.PublicNetworkAccessEnumDisabled,
+				CreateMode:                 mysql.CreateModeDefault,
+				StorageProfile:             storage,
+			}},
+		},
+		"PointInTimeRestore": {
+			p: params(azuredbv1beta1.CreateModePointInTimeRestore),
+			want: want{p: &mysql.ServerPropertiesForRestore{
+				SourceServerID:     azure.ToStringPtr(sourceID),
+				RestorePointInTime: &date.Time{Time: restoreTime.Time},
+				CreateMode:         mysql.CreateModePointInTimeRestore,
+				StorageProfile:     storage,
+			}},
+		},
+		"GeoRestore": {
+			p: params(azuredbv1beta1.CreateModeGeoRestore),
+			want: want{p: &mysql.ServerPropertiesForGeoRestore{
+				SourceServerID: azure.ToStringPtr(sourceID),
+				CreateMode:     mysql.CreateModeGeoRestore,
+				StorageProfile: storage,
+			}},
+		},
+		"Replica": {
+			p: params(azuredbv1beta1.CreateModeReplica),
+			want: want{p: &mysql.ServerPropertiesForReplica{
+				SourceServerID: azure.ToStringPtr(sourceID),
+				CreateMode:     mysql.CreateModeReplica,
+				StorageProfile: storage,
+			}},
+		},
+		"InvalidCreateMode": {
+			p:    azuredbv1beta1.SQLServerParameters{CreateMode: azure.ToStringPtr(azuredbv1beta1.CreateModeReplica)},
+			want: want{err: errors.Errorf(errSourceServerIDRequired, azuredbv1beta1.CreateModeReplica)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewServerCreateParameters(tc.p, pw)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewServerCreateParameters(...): -want error, +got error\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got := newMySQLServerForCreate(p)
+			if diff := cmp.Diff(tc.want.p, got.Properties); diff != "" {
+				t.Errorf("newMySQLServerForCreate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMySQLServerUpToDate(t *testing.T) {
+	params := func(m ...func(*azuredbv1beta1.SQLServerParameters)) azuredbv1beta1.SQLServerParameters {
+		p := azuredbv1beta1.SQLServerParameters{
+			SKU:                 azuredbv1beta1.SKU{Tier: "GeneralPurpose", Capacity: 2, Family: "Gen5"},
+			Version:             "5.7",
+			SSLEnforcement:      "Enabled",
+			PublicNetworkAccess: azure.ToStringPtr("Enabled"),
+			StorageProfile:      azuredbv1beta1.StorageProfile{StorageMB: 5120},
+		}
+		for _, fn := range m {
+			fn(&p)
+		}
+		return p
+	}
+	server := func(m ...func(*mysql.Server)) mysql.Server {
+		s := mysql.Server{
+			Sku: &mysql.Sku{Tier: mysql.GeneralPurpose, Capacity: azure.ToInt32Ptr(2), Family: azure.ToStringPtr("Gen5")},
+			ServerProperties: &mysql.ServerProperties{
+				Version:             mysql.FiveFullStopSeven,
+				SslEnforcement:      mysql.SslEnforcementEnumEnabled,
+				PublicNetworkAccess: mysql.PublicNetworkAccessEnumEnabled,
+				StorageProfile:      &mysql.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)},
+			},
+		}
+		for _, fn := range m {
+			fn(&s)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		in   mysql.Server
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			in:   server(),
+			want: true,
+		},
+		"PublicNetworkAccessChanged": {
+			p:    params(func(p *azuredbv1beta1.SQLServerParameters) { p.PublicNetworkAccess = azure.ToStringPtr("Disabled") }),
+			in:   server(),
+			want: false,
+		},
+		"InfrastructureEncryptionIgnored": {
+			p:    params(func(p *azuredbv1beta1.SQLServerParameters) { p.InfrastructureEncryption = azure.ToStringPtr("Enabled") }),
+			in:   server(),
+			want: true,
+		},
+		"IdentityMissing": {
+			p: params(func(p *azuredbv1beta1.SQLServerParameters) {
+				p.Identity = &azuredbv1beta1.Identity{Type: azuredbv1beta1.IdentityTypeSystemAssigned}
+			}),
+			in:   server(),
+			want: false,
+		},
+		"IdentityAssigned": {
+			p: params(func(p *azuredbv1beta1.SQLServerParameters) {
+				p.Identity = &azuredbv1beta1.Identity{Type: azuredbv1beta1.IdentityTypeSystemAssigned}
+			}),
+			in:   server(func(s *mysql.Server) { s.Identity = &mysql.ResourceIdentity{Type: mysql.SystemAssigned} }),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServerUpToDate(tc.p, fromMySQLServer(tc.in))
+			if got != tc.want {
+				t.Errorf("IsServerUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateMySQLConfigurationObservation(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		az   mysql.Configuration
+		want v1alpha3.ConfigurationObservation
+	}{
+		"DynamicParameter": {
+			name: "slow_query_log",
+			az: mysql.Configuration{
+				ID: azure.ToStringPtr(id),
+				ConfigurationProperties: &mysql.ConfigurationProperties{
+					DefaultValue: azure.ToStringPtr("10"),
+					Source:       azure.ToStringPtr(v1alpha3.ConfigurationSourceUserOverride),
+				},
+			},
+			want: v1alpha3.ConfigurationObservation{
+				ID:           id,
+				DefaultValue: "10",
+				Source:       v1alpha3.ConfigurationSourceUserOverride,
+			},
+		},
+		"StaticParameter": {
+			name: "innodb_log_file_size",
+			az:   mysql.Configuration{ID: azure.ToStringPtr(id)},
+			want: v1alpha3.ConfigurationObservation{
+				ID:              id,
+				RestartRequired: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := v1alpha3.ConfigurationObservation{}
+			UpdateConfigurationObservation(&got, fromMySQLConfiguration(tc.name, tc.az))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateConfigurationObservation(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	errGetPasswordSecret   = "cannot get administrator login password secret"
+	errPasswordKeyNotFound = "administrator login password secret does not contain key %q"
+	errGenPasswordSalt     = "cannot generate administrator login password salt"
+)
+
+// GetAdminPassword returns the administrator login password stored under the
+// key of the secret referenced by the supplied selector.
+func GetAdminPassword(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetPasswordSecret)
+	}
+	pw, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errPasswordKeyNotFound, ref.Key)
+	}
+	return string(pw), nil
+}
+
+// passwordSaltLength is the number of random bytes in a password salt.
+const passwordSaltLength = 16
+
+// NewPasswordSalt returns a random, hex encoded salt with which administrator
+// login passwords of a single server are hashed.
+func NewPasswordSalt() (string, error) {
+	b := make([]byte, passwordSaltLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, errGenPasswordSalt)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashPassword returns the hex encoded HMAC-SHA256 of the supplied password,
+// keyed with the supplied salt, so that it can be recorded without revealing
+// the password itself.
+func HashPassword(pw, salt string) string {
+	h := hmac.New(sha256.New, []byte(salt))
+	h.Write([]byte(pw)) // nolint:errcheck
+	return hex.EncodeToString(h.Sum(nil))
+}
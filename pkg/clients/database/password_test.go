@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestGetAdminPassword(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "coolns", Name: "coolsecret"},
+		Key:             "password",
+	}
+
+	type want struct {
+		pw  string
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("verysecret")}
+				return nil
+			}},
+			want: want{pw: "verysecret"},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errors.Wrap(errBoom, errGetPasswordSecret)},
+		},
+		"KeyNotFound": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			want: want{err: errors.Errorf(errPasswordKeyNotFound, "password")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pw, err := GetAdminPassword(context.Background(), tc.kube, ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetAdminPassword(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pw, pw); diff != "" {
+				t.Errorf("GetAdminPassword(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPasswordSalt(t *testing.T) {
+	a, err := NewPasswordSalt()
+	if err != nil {
+		t.Fatalf("NewPasswordSalt(): %s", err)
+	}
+	b, err := NewPasswordSalt()
+	if err != nil {
+		t.Fatalf("NewPasswordSalt(): %s", err)
+	}
+	if a == b {
+		t.Errorf("NewPasswordSalt(): subsequent calls must not return the same salt")
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	if HashPassword("a", "salt") == HashPassword("b", "salt") {
+		t.Errorf("HashPassword(...): different passwords must not produce the same hash")
+	}
+	if HashPassword("a", "salt") == HashPassword("a", "pepper") {
+		t.Errorf("HashPassword(...): different salts must not produce the same hash")
+	}
+	if diff := cmp.Diff(HashPassword("a", "salt"), HashPassword("a", "salt")); diff != "" {
+		t.Errorf("HashPassword(...): -want, +got:\n%s", diff)
+	}
+}
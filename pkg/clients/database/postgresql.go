@@ -18,15 +18,12 @@ package database
 
 import (
 	"context"
-	"fmt"
 	"net/http"
-	"reflect"
-	"strconv"
 
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql/postgresqlapi"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/google/go-cmp/cmp"
+	"github.com/Azure/go-autorest/autorest/to"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
@@ -36,28 +33,566 @@ import (
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
-// NOTE: postgresql and mysql structs and functions live in their respective
-// packages even though they are exactly the same. However, Crossplane does not
-// make that assumption and use the respective package for each type, although,
-// they both share the same SQLServerParameters and SQLServerObservation objects.
-// https://github.com/Azure/azure-sdk-for-go/blob/master/services/mysql/mgmt/2017-12-01/mysql/models.go
-// https://github.com/Azure/azure-sdk-for-go/blob/master/services/postgresql/mgmt/2017-12-01/postgresql/models.go
+// PostgreSQLServersClient is the concrete implementation of the SQLServerAPI
+// interface for PostgreSQL that calls Azure API.
+type PostgreSQLServersClient struct {
+	postgresql.ServersClient
+}
+
+// NewPostgreSQLServersClient creates and initializes a PostgreSQLServersClient
+// instance.
+func NewPostgreSQLServersClient(cl postgresql.ServersClient) *PostgreSQLServersClient {
+	return &PostgreSQLServersClient{
+		ServersClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *PostgreSQLServersClient) GetRESTClient() autorest.Sender {
+	return c.ServersClient.Client
+}
+
+// GetServer retrieves the requested PostgreSQL Server.
+func (c *PostgreSQLServersClient) GetServer(ctx context.Context, resourceGroupName, serverName string) (Server, error) {
+	s, err := c.ServersClient.Get(ctx, resourceGroupName, serverName)
+	return fromPostgreSQLServer(s), err
+}
+
+// CreateServer creates a PostgreSQL Server.
+func (c *PostgreSQLServersClient) CreateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, adminPassword string) (string, error) {
+	p, err := NewServerCreateParameters(s, adminPassword)
+	if err != nil {
+		return "", err
+	}
+	op, err := c.Create(ctx, s.ResourceGroupName, serverName, newPostgreSQLServerForCreate(p))
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// UpdateServer updates a PostgreSQL Server with the supplied observed replication
+// role. The administrator login password is changed as well unless the
+// supplied password is empty.
+func (c *PostgreSQLServersClient) UpdateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, replicationRole, adminPassword string) (string, error) {
+	p, err := NewServerUpdateParameters(s, replicationRole, adminPassword)
+	if err != nil {
+		return "", err
+	}
+	op, err := c.Update(ctx, s.ResourceGroupName, serverName, newPostgreSQLServerUpdateParameters(p))
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+// DeleteServer deletes the supplied PostgreSQL Server.
+func (c *PostgreSQLServersClient) DeleteServer(ctx context.Context, resourceGroupName, serverName string) (string, error) {
+	op, err := c.ServersClient.Delete(ctx, resourceGroupName, serverName)
+	if err != nil {
+		return "", err
+	}
+	return op.PollingURL(), nil
+}
+
+func newPostgreSQLSku(s ServerSKU) *postgresql.Sku {
+	return &postgresql.Sku{
+		Name:     s.Name,
+		Tier:     postgresql.SkuTier(s.Tier),
+		Capacity: s.Capacity,
+		Size:     s.Size,
+		Family:   s.Family,
+	}
+}
+
+func newPostgreSQLResourceIdentity(i *ServerIdentity) *postgresql.ResourceIdentity {
+	if i == nil {
+		return nil
+	}
+	return &postgresql.ResourceIdentity{Type: postgresql.IdentityType(i.Type)}
+}
+
+func newPostgreSQLStorageProfile(s ServerStorageProfile) *postgresql.StorageProfile {
+	return &postgresql.StorageProfile{
+		BackupRetentionDays: s.BackupRetentionDays,
+		GeoRedundantBackup:  postgresql.GeoRedundantBackup(s.GeoRedundantBackup),
+		StorageMB:           s.StorageMB,
+		StorageAutogrow:     postgresql.StorageAutogrow(s.StorageAutogrow),
+	}
+}
+
+func newPostgreSQLServerPropertiesForCreate(p ServerParameters) postgresql.BasicServerPropertiesForCreate {
+	switch p.CreateMode {
+	case azuredbv1beta1.CreateModePointInTimeRestore:
+		return &postgresql.ServerPropertiesForRestore{
+			SourceServerID:           p.SourceServerID,
+			RestorePointInTime:       p.RestorePointInTime,
+			MinimalTLSVersion:        postgresql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			Version:                  postgresql.ServerVersion(p.Version),
+			SslEnforcement:           postgresql.SslEnforcementEnum(p.SSLEnforcement),
+			InfrastructureEncryption: postgresql.InfrastructureEncryption(p.InfrastructureEncryption),
+			PublicNetworkAccess:      postgresql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:               postgresql.CreateModePointInTimeRestore,
+			StorageProfile:           newPostgreSQLStorageProfile(p.StorageProfile),
+		}
+	case azuredbv1beta1.CreateModeGeoRestore:
+		return &postgresql.ServerPropertiesForGeoRestore{
+			SourceServerID:           p.SourceServerID,
+			MinimalTLSVersion:        postgresql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			Version:                  postgresql.ServerVersion(p.Version),
+			SslEnforcement:           postgresql.SslEnforcementEnum(p.SSLEnforcement),
+			InfrastructureEncryption: postgresql.InfrastructureEncryption(p.InfrastructureEncryption),
+			PublicNetworkAccess:      postgresql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:               postgresql.CreateModeGeoRestore,
+			StorageProfile:           newPostgreSQLStorageProfile(p.StorageProfile),
+		}
+	case azuredbv1beta1.CreateModeReplica:
+		return &postgresql.ServerPropertiesForReplica{
+			SourceServerID:           p.SourceServerID,
+			MinimalTLSVersion:        postgresql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			Version:                  postgresql.ServerVersion(p.Version),
+			SslEnforcement:           postgresql.SslEnforcementEnum(p.SSLEnforcement),
+			InfrastructureEncryption: postgresql.InfrastructureEncryption(p.InfrastructureEncryption),
+			PublicNetworkAccess:      postgresql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			CreateMode:               postgresql.CreateModeReplica,
+			StorageProfile:           newPostgreSQLStorageProfile(p.StorageProfile),
+		}
+	}
+	return &postgresql.ServerPropertiesForDefaultCreate{
+		AdministratorLogin:         p.AdministratorLogin,
+		AdministratorLoginPassword: p.AdministratorLoginPassword,
+		MinimalTLSVersion:          postgresql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+		Version:                    postgresql.ServerVersion(p.Version),
+		SslEnforcement:             postgresql.SslEnforcementEnum(p.SSLEnforcement),
+		InfrastructureEncryption:   postgresql.InfrastructureEncryption(p.InfrastructureEncryption),
+		PublicNetworkAccess:        postgresql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+		CreateMode:                 postgresql.CreateModeDefault,
+		StorageProfile:             newPostgreSQLStorageProfile(p.StorageProfile),
+	}
+}
+
+func newPostgreSQLServerForCreate(p ServerParameters) postgresql.ServerForCreate {
+	return postgresql.ServerForCreate{
+		Identity:   newPostgreSQLResourceIdentity(p.Identity),
+		Sku:        newPostgreSQLSku(p.SKU),
+		Properties: newPostgreSQLServerPropertiesForCreate(p),
+		Location:   azure.ToStringPtr(p.Location),
+		Tags:       p.Tags,
+	}
+}
+
+func newPostgreSQLServerUpdateParameters(p ServerParameters) postgresql.ServerUpdateParameters {
+	return postgresql.ServerUpdateParameters{
+		Identity: newPostgreSQLResourceIdentity(p.Identity),
+		Sku:      newPostgreSQLSku(p.SKU),
+		ServerUpdateParametersProperties: &postgresql.ServerUpdateParametersProperties{
+			AdministratorLoginPassword: p.AdministratorLoginPassword,
+			Version:                    postgresql.ServerVersion(p.Version),
+			MinimalTLSVersion:          postgresql.MinimalTLSVersionEnum(p.MinimalTLSVersion),
+			SslEnforcement:             postgresql.SslEnforcementEnum(p.SSLEnforcement),
+			PublicNetworkAccess:        postgresql.PublicNetworkAccessEnum(p.PublicNetworkAccess),
+			ReplicationRole:            p.ReplicationRole,
+			StorageProfile:             newPostgreSQLStorageProfile(p.StorageProfile),
+		},
+		Tags: p.Tags,
+	}
+}
+
+func fromPostgreSQLServer(in postgresql.Server) Server { // nolint:gocyclo
+	s := Server{
+		ID:   azure.ToString(in.ID),
+		Name: azure.ToString(in.Name),
+		Type: azure.ToString(in.Type),
+		Tags: in.Tags,
+	}
+	if in.Sku != nil {
+		s.SKU = &ServerSKU{
+			Name:     in.Sku.Name,
+			Tier:     string(in.Sku.Tier),
+			Capacity: in.Sku.Capacity,
+			Size:     in.Sku.Size,
+			Family:   in.Sku.Family,
+		}
+	}
+	if in.Identity != nil {
+		s.Identity = &ServerIdentity{
+			Type:        string(in.Identity.Type),
+			PrincipalID: uuidToString(in.Identity.PrincipalID),
+			TenantID:    uuidToString(in.Identity.TenantID),
+		}
+	}
+	if in.ServerProperties == nil {
+		return s
+	}
+	s.Version = string(in.Version)
+	s.SSLEnforcement = string(in.SslEnforcement)
+	s.MinimalTLSVersion = string(in.MinimalTLSVersion)
+	s.InfrastructureEncryption = string(in.InfrastructureEncryption)
+	s.PublicNetworkAccess = string(in.PublicNetworkAccess)
+	s.UserVisibleState = string(in.UserVisibleState)
+	s.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
+	s.MasterServerID = azure.ToString(in.MasterServerID)
+	s.ReplicationRole = azure.ToString(in.ReplicationRole)
+	s.ReplicaCapacity = in.ReplicaCapacity
+	s.ByokEnforcement = azure.ToString(in.ByokEnforcement)
+	if in.StorageProfile != nil {
+		s.StorageProfile = &ServerStorageProfile{
+			BackupRetentionDays: in.StorageProfile.BackupRetentionDays,
+			GeoRedundantBackup:  string(in.StorageProfile.GeoRedundantBackup),
+			StorageMB:           in.StorageProfile.StorageMB,
+			StorageAutogrow:     string(in.StorageProfile.StorageAutogrow),
+		}
+	}
+	return s
+}
+
+// PostgreSQLFirewallRulesClient is the concrete implementation of the
+// FirewallRuleAPI interface for PostgreSQL that calls Azure API.
+type PostgreSQLFirewallRulesClient struct {
+	client postgresqlapi.FirewallRulesClientAPI
+}
+
+// NewPostgreSQLFirewallRulesClient creates and initializes a
+// PostgreSQLFirewallRulesClient instance.
+func NewPostgreSQLFirewallRulesClient(cl postgresqlapi.FirewallRulesClientAPI) *PostgreSQLFirewallRulesClient {
+	return &PostgreSQLFirewallRulesClient{client: cl}
+}
+
+// GetFirewallRule retrieves the requested PostgreSQL firewall rule.
+func (c *PostgreSQLFirewallRulesClient) GetFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (FirewallRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	return fromPostgreSQLFirewallRule(az), err
+}
+
+// CreateOrUpdateFirewallRule creates or updates the supplied PostgreSQL firewall
+// rule.
+func (c *PostgreSQLFirewallRulesClient) CreateOrUpdateFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r FirewallRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, newPostgreSQLFirewallRule(ruleName, r))
+	return err
+}
+
+// DeleteFirewallRule deletes the supplied PostgreSQL firewall rule.
+func (c *PostgreSQLFirewallRulesClient) DeleteFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
+
+func newPostgreSQLFirewallRule(name string, r FirewallRule) postgresql.FirewallRule {
+	return postgresql.FirewallRule{
+		Name: azure.ToStringPtr(name),
+		FirewallRuleProperties: &postgresql.FirewallRuleProperties{
+			StartIPAddress: r.StartIPAddress,
+			EndIPAddress:   r.EndIPAddress,
+		},
+	}
+}
+
+func fromPostgreSQLFirewallRule(az postgresql.FirewallRule) FirewallRule {
+	r := FirewallRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.FirewallRuleProperties != nil {
+		r.StartIPAddress = az.StartIPAddress
+		r.EndIPAddress = az.EndIPAddress
+	}
+	return r
+}
+
+// PostgreSQLVirtualNetworkRulesClient is the concrete implementation of the
+// VirtualNetworkRuleAPI interface for PostgreSQL that calls Azure API.
+type PostgreSQLVirtualNetworkRulesClient struct {
+	client postgresqlapi.VirtualNetworkRulesClientAPI
+}
+
+// NewPostgreSQLVirtualNetworkRulesClient creates and initializes a
+// PostgreSQLVirtualNetworkRulesClient instance.
+func NewPostgreSQLVirtualNetworkRulesClient(cl postgresqlapi.VirtualNetworkRulesClientAPI) *PostgreSQLVirtualNetworkRulesClient {
+	return &PostgreSQLVirtualNetworkRulesClient{client: cl}
+}
+
+// GetVirtualNetworkRule retrieves the requested PostgreSQL virtual network rule.
+func (c *PostgreSQLVirtualNetworkRulesClient) GetVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (VirtualNetworkRule, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, ruleName)
+	return fromPostgreSQLVirtualNetworkRule(az), err
+}
+
+// CreateOrUpdateVirtualNetworkRule creates or updates the supplied PostgreSQL
+// virtual network rule.
+func (c *PostgreSQLVirtualNetworkRulesClient) CreateOrUpdateVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r VirtualNetworkRule) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, ruleName, newPostgreSQLVirtualNetworkRule(ruleName, r))
+	return err
+}
+
+// DeleteVirtualNetworkRule deletes the supplied PostgreSQL virtual network rule.
+func (c *PostgreSQLVirtualNetworkRulesClient) DeleteVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, ruleName)
+	return err
+}
+
+func newPostgreSQLVirtualNetworkRule(name string, r VirtualNetworkRule) postgresql.VirtualNetworkRule {
+	return postgresql.VirtualNetworkRule{
+		Name: azure.ToStringPtr(name),
+		VirtualNetworkRuleProperties: &postgresql.VirtualNetworkRuleProperties{
+			VirtualNetworkSubnetID:           r.VirtualNetworkSubnetID,
+			IgnoreMissingVnetServiceEndpoint: r.IgnoreMissingVnetServiceEndpoint,
+		},
+	}
+}
+
+func fromPostgreSQLVirtualNetworkRule(az postgresql.VirtualNetworkRule) VirtualNetworkRule {
+	r := VirtualNetworkRule{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.VirtualNetworkRuleProperties != nil {
+		r.State = string(az.State)
+		r.VirtualNetworkSubnetID = az.VirtualNetworkSubnetID
+		r.IgnoreMissingVnetServiceEndpoint = az.IgnoreMissingVnetServiceEndpoint
+	}
+	return r
+}
+
+// PostgreSQLConfigurationsClient is the concrete implementation of the
+// ConfigurationAPI interface for PostgreSQL that calls Azure API.
+type PostgreSQLConfigurationsClient struct {
+	client postgresqlapi.ConfigurationsClientAPI
+}
+
+// NewPostgreSQLConfigurationsClient creates and initializes a
+// PostgreSQLConfigurationsClient instance.
+func NewPostgreSQLConfigurationsClient(cl postgresqlapi.ConfigurationsClientAPI) *PostgreSQLConfigurationsClient {
+	return &PostgreSQLConfigurationsClient{client: cl}
+}
+
+// GetConfiguration retrieves the requested PostgreSQL configuration.
+func (c *PostgreSQLConfigurationsClient) GetConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string) (Configuration, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, configurationName)
+	return fromPostgreSQLConfiguration(configurationName, az), err
+}
+
+// CreateOrUpdateConfiguration creates or updates the supplied PostgreSQL
+// configuration.
+func (c *PostgreSQLConfigurationsClient) CreateOrUpdateConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string, cfg Configuration) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, configurationName, newPostgreSQLConfiguration(cfg))
+	return err
+}
+
+// postgresqlStaticParameters are the PostgreSQL server parameters that cannot be
+// changed while the server is running. Changes to them take effect only
+// after the server is restarted.
+var postgresqlStaticParameters = map[string]bool{
+	"max_connections":           true,
+	"max_locks_per_transaction": true,
+	"max_prepared_transactions": true,
+	"max_replication_slots":     true,
+	"max_wal_senders":           true,
+	"max_worker_processes":      true,
+	"shared_buffers":            true,
+	"shared_preload_libraries":  true,
+	"track_activity_query_size": true,
+	"wal_level":                 true,
+}
+
+func newPostgreSQLConfiguration(c Configuration) postgresql.Configuration {
+	return postgresql.Configuration{
+		ConfigurationProperties: &postgresql.ConfigurationProperties{
+			Value:  c.Value,
+			Source: c.Source,
+		},
+	}
+}
+
+func fromPostgreSQLConfiguration(name string, az postgresql.Configuration) Configuration {
+	c := Configuration{
+		ID:              azure.ToString(az.ID),
+		Type:            azure.ToString(az.Type),
+		RestartRequired: postgresqlStaticParameters[name],
+	}
+	if az.ConfigurationProperties == nil {
+		return c
+	}
+	c.Value = to.StringPtr(azure.ToString(az.Value))
+	c.Source = to.StringPtr(azure.ToString(az.Source))
+	c.Description = azure.ToString(az.Description)
+	c.DefaultValue = az.DefaultValue
+	c.DataType = azure.ToString(az.DataType)
+	c.AllowedValues = azure.ToString(az.AllowedValues)
+	return c
+}
+
+// PostgreSQLDatabasesClient is the concrete implementation of the DatabaseAPI
+// interface for PostgreSQL that calls Azure API.
+type PostgreSQLDatabasesClient struct {
+	client postgresqlapi.DatabasesClientAPI
+}
+
+// NewPostgreSQLDatabasesClient creates and initializes a PostgreSQLDatabasesClient
+// instance.
+func NewPostgreSQLDatabasesClient(cl postgresqlapi.DatabasesClientAPI) *PostgreSQLDatabasesClient {
+	return &PostgreSQLDatabasesClient{client: cl}
+}
+
+// GetDatabase retrieves the requested PostgreSQL database.
+func (c *PostgreSQLDatabasesClient) GetDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (Database, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, databaseName)
+	return fromPostgreSQLDatabase(az), err
+}
+
+// CreateOrUpdateDatabase creates or updates the supplied PostgreSQL database.
+func (c *PostgreSQLDatabasesClient) CreateOrUpdateDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string, d Database) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, newPostgreSQLDatabase(d))
+	return err
+}
+
+// DeleteDatabase deletes the supplied PostgreSQL database.
+func (c *PostgreSQLDatabasesClient) DeleteDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName, databaseName)
+	return err
+}
+
+func newPostgreSQLDatabase(d Database) postgresql.Database {
+	return postgresql.Database{
+		DatabaseProperties: &postgresql.DatabaseProperties{
+			Charset:   d.Charset,
+			Collation: d.Collation,
+		},
+	}
+}
+
+func fromPostgreSQLDatabase(az postgresql.Database) Database {
+	d := Database{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.DatabaseProperties != nil {
+		d.Charset = az.Charset
+		d.Collation = az.Collation
+	}
+	return d
+}
+
+// PostgreSQLServerKeysClient is the concrete implementation of the ServerKeyAPI
+// interface for PostgreSQL that calls Azure API.
+type PostgreSQLServerKeysClient struct {
+	client postgresqlapi.ServerKeysClientAPI
+}
+
+// NewPostgreSQLServerKeysClient creates and initializes a PostgreSQLServerKeysClient
+// instance.
+func NewPostgreSQLServerKeysClient(cl postgresqlapi.ServerKeysClientAPI) *PostgreSQLServerKeysClient {
+	return &PostgreSQLServerKeysClient{client: cl}
+}
+
+// GetServerKey retrieves the requested PostgreSQL server key.
+func (c *PostgreSQLServerKeysClient) GetServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) (ServerKey, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName, keyName)
+	return fromPostgreSQLServerKey(az), err
+}
+
+// CreateOrUpdateServerKey creates or updates the supplied PostgreSQL server key.
+func (c *PostgreSQLServerKeysClient) CreateOrUpdateServerKey(ctx context.Context, resourceGroupName, serverName, keyName string, k ServerKey) error {
+	_, err := c.client.CreateOrUpdate(ctx, serverName, keyName, newPostgreSQLServerKey(k), resourceGroupName)
+	return err
+}
+
+// DeleteServerKey deletes the supplied PostgreSQL server key.
+func (c *PostgreSQLServerKeysClient) DeleteServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) error {
+	_, err := c.client.Delete(ctx, serverName, keyName, resourceGroupName)
+	return err
+}
+
+func newPostgreSQLServerKey(k ServerKey) postgresql.ServerKey {
+	return postgresql.ServerKey{
+		ServerKeyProperties: &postgresql.ServerKeyProperties{
+			ServerKeyType: k.ServerKeyType,
+			URI:           k.URI,
+		},
+	}
+}
+
+func fromPostgreSQLServerKey(az postgresql.ServerKey) ServerKey {
+	k := ServerKey{ID: azure.ToString(az.ID), Name: azure.ToString(az.Name), Type: azure.ToString(az.Type)}
+	if az.ServerKeyProperties != nil {
+		k.ServerKeyType = az.ServerKeyType
+		k.URI = az.URI
+		k.CreationDate = az.CreationDate
+	}
+	return k
+}
+
+// PostgreSQLServerAdministratorsClient is the concrete implementation of the
+// ServerAdministratorAPI interface for PostgreSQL that calls Azure API.
+type PostgreSQLServerAdministratorsClient struct {
+	client postgresqlapi.ServerAdministratorsClientAPI
+}
+
+// NewPostgreSQLServerAdministratorsClient creates and initializes a
+// PostgreSQLServerAdministratorsClient instance.
+func NewPostgreSQLServerAdministratorsClient(cl postgresqlapi.ServerAdministratorsClientAPI) *PostgreSQLServerAdministratorsClient {
+	return &PostgreSQLServerAdministratorsClient{client: cl}
+}
+
+// GetServerAdministrator retrieves the administrator of the requested PostgreSQL
+// server.
+func (c *PostgreSQLServerAdministratorsClient) GetServerAdministrator(ctx context.Context, resourceGroupName, serverName string) (ServerAdministrator, error) {
+	az, err := c.client.Get(ctx, resourceGroupName, serverName)
+	return fromPostgreSQLServerAdministrator(az), err
+}
+
+// CreateOrUpdateServerAdministrator creates or updates the administrator of
+// the supplied PostgreSQL server.
+func (c *PostgreSQLServerAdministratorsClient) CreateOrUpdateServerAdministrator(ctx context.Context, resourceGroupName, serverName string, a ServerAdministrator) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroupName, serverName, newPostgreSQLServerAdministrator(a))
+	return err
+}
+
+// DeleteServerAdministrator deletes the administrator of the supplied PostgreSQL
+// server.
+func (c *PostgreSQLServerAdministratorsClient) DeleteServerAdministrator(ctx context.Context, resourceGroupName, serverName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, serverName)
+	return err
+}
+
+func newPostgreSQLServerAdministrator(a ServerAdministrator) postgresql.ServerAdministratorResource {
+	return postgresql.ServerAdministratorResource{
+		ServerAdministratorProperties: &postgresql.ServerAdministratorProperties{
+			AdministratorType: a.AdministratorType,
+			Login:             a.Login,
+			Sid:               a.Sid,
+			TenantID:          a.TenantID,
+		},
+	}
+}
+
+func fromPostgreSQLServerAdministrator(az postgresql.ServerAdministratorResource) ServerAdministrator {
+	a := ServerAdministrator{ID: azure.ToString(az.ID), Type: azure.ToString(az.Type)}
+	if az.ServerAdministratorProperties != nil {
+		a.AdministratorType = az.AdministratorType
+		a.Login = az.Login
+		a.Sid = az.Sid
+		a.TenantID = az.TenantID
+	}
+	return a
+}
+
+// NOTE: The PostgreSQL specific API below predates the engine agnostic API above.
+// It is kept for backward compatibility and delegates to the engine agnostic
+// API.
 
 // PostgreSQLServerAPI represents the API interface for a PostgreSQL Server client
+//
+// Deprecated: Use SQLServerAPI.
 type PostgreSQLServerAPI interface {
 	GetServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) (postgresql.Server, error)
 	CreateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer, adminPassword string) error
-	DeleteServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
 	UpdateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
+	DeleteServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
 	GetRESTClient() autorest.Sender
 }
 
-// PostgreSQLServerClient is the concreate implementation of the SQLServerAPI interface for PostgreSQL that calls Azure API.
+// PostgreSQLServerClient is the concrete implementation of the PostgreSQLServerAPI
+// interface for PostgreSQL that calls Azure API.
+//
+// Deprecated: Use PostgreSQLServersClient.
 type PostgreSQLServerClient struct {
 	postgresql.ServersClient
 }
 
 // NewPostgreSQLServerClient creates and initializes a PostgreSQLServerClient instance.
+//
+// Deprecated: Use NewPostgreSQLServersClient.
 func NewPostgreSQLServerClient(cl postgresql.ServersClient) *PostgreSQLServerClient {
 	return &PostgreSQLServerClient{
 		ServersClient: cl,
@@ -74,39 +609,14 @@ func (c *PostgreSQLServerClient) GetServer(ctx context.Context, cr *azuredbv1bet
 	return c.ServersClient.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
 }
 
-// CreateServer creates a PostgreSQL Server
+// CreateServer creates a PostgreSQL Server.
 func (c *PostgreSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer, adminPassword string) error {
-	s := cr.Spec.ForProvider
-	properties := &postgresql.ServerPropertiesForDefaultCreate{
-		AdministratorLogin:         azure.ToStringPtr(s.AdministratorLogin),
-		AdministratorLoginPassword: &adminPassword,
-		MinimalTLSVersion:          postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-		Version:                    postgresql.ServerVersion(s.Version),
-		SslEnforcement:             postgresql.SslEnforcementEnum(s.SSLEnforcement),
-		CreateMode:                 postgresql.CreateModeDefault,
-		StorageProfile: &postgresql.StorageProfile{
-			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
-			GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
-			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
-			StorageAutogrow:     postgresql.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
-		},
-	}
-	sku, err := ToPostgreSQLSKU(s.SKU)
-	if err != nil {
-		return err
-	}
-	createParams := postgresql.ServerForCreate{
-		Sku:        sku,
-		Properties: properties,
-		Location:   &s.Location,
-		Tags:       azure.ToStringPtrMap(s.Tags),
-	}
-	op, err := c.Create(ctx, s.ResourceGroupName, meta.GetExternalName(cr), createParams)
+	u, err := NewPostgreSQLServersClient(c.ServersClient).CreateServer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, adminPassword)
 	if err != nil {
 		return err
 	}
 	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
+		PollingURL: u,
 		Method:     http.MethodPut,
 	}
 	return nil
@@ -114,176 +624,100 @@ func (c *PostgreSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1
 
 // UpdateServer updates a PostgreSQL Server.
 func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer) error {
-	// TODO(muvaf): password update via Update call is supported by Azure but
-	// we don't support that.
-	s := cr.Spec.ForProvider
-	properties := &postgresql.ServerUpdateParametersProperties{
-		Version:           postgresql.ServerVersion(s.Version),
-		MinimalTLSVersion: postgresql.MinimalTLSVersionEnum(s.MinimalTLSVersion),
-		SslEnforcement:    postgresql.SslEnforcementEnum(s.SSLEnforcement),
-		StorageProfile: &postgresql.StorageProfile{
-			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
-			GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
-			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
-			StorageAutogrow:     postgresql.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
-		},
-	}
-	sku, err := ToPostgreSQLSKU(s.SKU)
-	if err != nil {
-		return err
-	}
-	updateParams := postgresql.ServerUpdateParameters{
-		Sku:                              sku,
-		ServerUpdateParametersProperties: properties,
-		Tags:                             azure.ToStringPtrMap(s.Tags),
-	}
-	op, err := c.Update(ctx, s.ResourceGroupName, meta.GetExternalName(cr), updateParams)
+	u, err := NewPostgreSQLServersClient(c.ServersClient).UpdateServer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider.ReplicationRole, "")
 	if err != nil {
 		return err
 	}
 	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
+		PollingURL: u,
 		Method:     http.MethodPatch,
 	}
 	return nil
 }
 
-// DeleteServer deletes the given PostgreSQL resource
+// DeleteServer deletes the given PostgreSQLServer resource.
 func (c *PostgreSQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer) error {
-	op, err := c.ServersClient.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	u, err := NewPostgreSQLServersClient(c.ServersClient).DeleteServer(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
 	if err != nil {
 		return err
 	}
 	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
+		PollingURL: u,
 		Method:     http.MethodDelete,
 	}
 	return nil
 }
 
-// NewPostgreSQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule object from a virtual network spec
-func NewPostgreSQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule) postgresql.VirtualNetworkRule {
-	return postgresql.VirtualNetworkRule{
-		Name: azure.ToStringPtr(meta.GetExternalName(v)),
-		VirtualNetworkRuleProperties: &postgresql.VirtualNetworkRuleProperties{
-			VirtualNetworkSubnetID:           azure.ToStringPtr(v.Spec.VirtualNetworkRuleProperties.VirtualNetworkSubnetID),
-			IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(v.Spec.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, azure.FieldRequired),
-		},
+// ToPostgreSQLSKU returns a *postgresql.Sku object that can be used in Azure API calls.
+//
+// Deprecated: Use NewServerSKU.
+func ToPostgreSQLSKU(skuSpec azuredbv1beta1.SKU) (*postgresql.Sku, error) {
+	s, err := NewServerSKU(skuSpec)
+	if err != nil {
+		return nil, err
 	}
+	return newPostgreSQLSku(s), nil
 }
 
-// PostgreSQLServerVirtualNetworkRuleNeedsUpdate determines if a virtual network rule needs to be updated
-func PostgreSQLServerVirtualNetworkRuleNeedsUpdate(kube *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule, az postgresql.VirtualNetworkRule) bool {
-	up := NewPostgreSQLVirtualNetworkRuleParameters(kube)
-
-	switch {
-	case !reflect.DeepEqual(up.VirtualNetworkRuleProperties.VirtualNetworkSubnetID, az.VirtualNetworkRuleProperties.VirtualNetworkSubnetID):
-		return true
-	case !reflect.DeepEqual(up.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint, az.VirtualNetworkRuleProperties.IgnoreMissingVnetServiceEndpoint):
-		return true
-	}
+// UpdatePostgreSQLObservation produces SQLServerObservation from postgresql.Server.
+//
+// Deprecated: Use UpdateServerObservation.
+func UpdatePostgreSQLObservation(o *azuredbv1beta1.SQLServerObservation, in postgresql.Server) {
+	UpdateServerObservation(o, fromPostgreSQLServer(in))
+}
 
-	return false
+// LateInitializePostgreSQL fills the empty values of SQLServerParameters with the
+// ones that are retrieved from the Azure API.
+//
+// Deprecated: Use LateInitializeServer.
+func LateInitializePostgreSQL(p *azuredbv1beta1.SQLServerParameters, in postgresql.Server) {
+	LateInitializeServer(p, fromPostgreSQLServer(in))
 }
 
-// UpdatePostgreSQLVirtualNetworkRuleStatusFromAzure updates the status related to the external
-// Azure PostgreSQLVirtualNetworkRule in the VirtualNetworkStatus
-func UpdatePostgreSQLVirtualNetworkRuleStatusFromAzure(v *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule, az postgresql.VirtualNetworkRule) {
-	v.Status.State = string(az.VirtualNetworkRuleProperties.State)
-	v.Status.ID = azure.ToString(az.ID)
-	v.Status.Type = azure.ToString(az.Type)
+// IsPostgreSQLUpToDate is used to report whether given postgresql.Server is in
+// sync with the SQLServerParameters that user desires.
+//
+// Deprecated: Use IsServerUpToDate.
+func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Server) bool {
+	return IsServerUpToDate(p, fromPostgreSQLServer(in))
 }
 
 // NewPostgreSQLFirewallRuleParameters returns an Azure FirewallRule object from a
 // firewall spec.
+//
+// Deprecated: Use NewFirewallRule.
 func NewPostgreSQLFirewallRuleParameters(r *azuredbv1alpha3.PostgreSQLServerFirewallRule) postgresql.FirewallRule {
-	return postgresql.FirewallRule{
-		Name: azure.ToStringPtr(meta.GetExternalName(r)),
-		FirewallRuleProperties: &postgresql.FirewallRuleProperties{
-			StartIPAddress: azure.ToStringPtr(r.Spec.ForProvider.StartIPAddress),
-			EndIPAddress:   azure.ToStringPtr(r.Spec.ForProvider.EndIPAddress),
-		},
-	}
+	return newPostgreSQLFirewallRule(meta.GetExternalName(r), NewFirewallRule(r.Spec.ForProvider))
 }
 
 // PostgreSQLServerFirewallRuleIsUpToDate returns true if the supplied FirewallRule
 // appears to be up to date with the supplied PostgreSQLServerFirewallRule.
+//
+// Deprecated: Use FirewallRuleIsUpToDate.
 func PostgreSQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.PostgreSQLServerFirewallRule, az postgresql.FirewallRule) bool {
-	up := NewPostgreSQLFirewallRuleParameters(kube)
-	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
-}
-
-// The name must match the specification of the SKU, so, we don't allow user
-// to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
-
-// ToPostgreSQLSKU returns a *postgresql.Sku object that can be used in Azure API calls.
-func ToPostgreSQLSKU(skuSpec azuredbv1beta1.SKU) (*postgresql.Sku, error) {
-	t, ok := skuShortTiers[mysql.SkuTier(skuSpec.Tier)]
-	if !ok {
-		return nil, fmt.Errorf("tier '%s' is not one of the supported values: %+v", skuSpec.Tier, mysql.PossibleSkuTierValues())
-	}
-	return &postgresql.Sku{
-		Name:     azure.ToStringPtr(fmt.Sprintf("%s_%s_%s", t, skuSpec.Family, strconv.Itoa(skuSpec.Capacity))),
-		Tier:     postgresql.SkuTier(skuSpec.Tier),
-		Capacity: azure.ToInt32Ptr(skuSpec.Capacity),
-		Family:   azure.ToStringPtr(skuSpec.Family),
-		Size:     skuSpec.Size,
-	}, nil
+	return FirewallRuleIsUpToDate(kube.Spec.ForProvider, fromPostgreSQLFirewallRule(az))
 }
 
-// UpdatePostgreSQLObservation produces SQLServerObservation from postgresql.Server.
-func UpdatePostgreSQLObservation(o *azuredbv1beta1.SQLServerObservation, in postgresql.Server) {
-	o.ID = azure.ToString(in.ID)
-	o.Name = azure.ToString(in.Name)
-	o.Type = azure.ToString(in.Type)
-	o.UserVisibleState = string(in.UserVisibleState)
-	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
-	o.MasterServerID = azure.ToString(in.MasterServerID)
+// NewPostgreSQLVirtualNetworkRuleParameters returns an Azure VirtualNetworkRule
+// object from a virtual network spec.
+//
+// Deprecated: Use NewVirtualNetworkRule.
+func NewPostgreSQLVirtualNetworkRuleParameters(v *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule) postgresql.VirtualNetworkRule {
+	return newPostgreSQLVirtualNetworkRule(meta.GetExternalName(v), NewVirtualNetworkRule(v.Spec.VirtualNetworkRuleProperties))
 }
 
-// LateInitializePostgreSQL fills the empty values of SQLServerParameters with the
-// ones that are retrieved from the Azure API.
-func LateInitializePostgreSQL(p *azuredbv1beta1.SQLServerParameters, in postgresql.Server) {
-	if in.Sku != nil {
-		p.SKU.Size = azure.LateInitializeStringPtrFromPtr(p.SKU.Size, in.Sku.Size)
-	}
-	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
-	if in.StorageProfile != nil {
-		p.StorageProfile.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageProfile.BackupRetentionDays, in.StorageProfile.BackupRetentionDays)
-		p.StorageProfile.GeoRedundantBackup = azure.LateInitializeStringPtrFromVal(p.StorageProfile.GeoRedundantBackup, string(in.StorageProfile.GeoRedundantBackup))
-		p.StorageProfile.StorageAutogrow = azure.LateInitializeStringPtrFromVal(p.StorageProfile.StorageAutogrow, string(in.StorageProfile.StorageAutogrow))
-	}
+// PostgreSQLServerVirtualNetworkRuleNeedsUpdate determines if a virtual network
+// rule needs to be updated.
+//
+// Deprecated: Use VirtualNetworkRuleNeedsUpdate.
+func PostgreSQLServerVirtualNetworkRuleNeedsUpdate(kube *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule, az postgresql.VirtualNetworkRule) bool {
+	return VirtualNetworkRuleNeedsUpdate(kube.Spec.VirtualNetworkRuleProperties, fromPostgreSQLVirtualNetworkRule(az))
 }
 
-// IsPostgreSQLUpToDate is used to report whether given postgresql.Server is in
-// sync with the SQLServerParameters that user desires.
-func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Server) bool { // nolint:gocyclo
-	if in.StorageProfile == nil || in.Sku == nil {
-		return false
-	}
-	switch {
-	case p.MinimalTLSVersion != azuredbv1beta1.MinimalTLSVersionEnum(in.MinimalTLSVersion):
-		return false
-	case p.SSLEnforcement != string(in.SslEnforcement):
-		return false
-	case p.Version != string(in.Version):
-		return false
-	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
-		return false
-	case p.SKU.Tier != string(in.Sku.Tier):
-		return false
-	case p.SKU.Capacity != azure.ToInt(in.Sku.Capacity):
-		return false
-	case p.SKU.Family != azure.ToString(in.Sku.Family):
-		return false
-	case !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageProfile.BackupRetentionDays), in.StorageProfile.BackupRetentionDays):
-		return false
-	case azure.ToString(p.StorageProfile.GeoRedundantBackup) != string(in.StorageProfile.GeoRedundantBackup):
-		return false
-	case p.StorageProfile.StorageMB != azure.ToInt(in.StorageProfile.StorageMB):
-		return false
-	case azure.ToString(p.StorageProfile.StorageAutogrow) != string(in.StorageProfile.StorageAutogrow):
-		return false
-	}
-	return true
+// UpdatePostgreSQLVirtualNetworkRuleStatusFromAzure updates the status related to
+// the external Azure PostgreSQLVirtualNetworkRule in the VirtualNetworkStatus.
+//
+// Deprecated: Use UpdateVirtualNetworkRuleStatus.
+func UpdatePostgreSQLVirtualNetworkRuleStatusFromAzure(v *azuredbv1alpha3.PostgreSQLServerVirtualNetworkRule, az postgresql.VirtualNetworkRule) {
+	UpdateVirtualNetworkRuleStatus(&v.Status, fromPostgreSQLVirtualNetworkRule(az))
 }
@@ -18,15 +18,21 @@ package database
 
 import (
 	"testing"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
@@ -345,3 +351,226 @@ func TestPostgreSQLServerFirewallRuleIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPostgreSQLServerForCreate(t *testing.T) {
+	sourceID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.DBforPostgreSQL/servers/source"
+	restoreTime := metav1.NewTime(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	admin := "cooladmin"
+	pw := "verysecure"
+	sku := azuredbv1beta1.SKU{Tier: SKUTierBasic, Family: "Gen5", Capacity: 1}
+	storage := &postgresql.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)}
+	params := func(mode string) azuredbv1beta1.SQLServerParameters {
+		return azuredbv1beta1.SQLServerParameters{
+			AdministratorLogin: admin,
+			CreateMode:         azure.ToStringPtr(mode),
+			SourceServerID:     azure.ToStringPtr(sourceID),
+			RestorePointInTime: &restoreTime,
+			SKU:                sku,
+			StorageProfile:     azuredbv1beta1.StorageProfile{StorageMB: 5120},
+		}
+	}
+
+	type want struct {
+		p   postgresql.BasicServerPropertiesForCreate
+		err error
+	}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		want want
+	}{
+		"Default": {
+			p: azuredbv1beta1.SQLServerParameters{
+				AdministratorLogin: admin,
+				SKU:                sku,
+				StorageProfile:     azuredbv1beta1.StorageProfile{StorageMB: 5120},
+			},
+			want: want{p: &postgresql.ServerPropertiesForDefaultCreate{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(pw),
+				CreateMode:                 postgresql.CreateModeDefault,
+				StorageProfile:             storage,
+			}},
+		},
+		"DefaultWithEncryptionAndNetworkAccess": {
+			p: azuredbv1beta1.SQLServerParameters{
+				AdministratorLogin:       admin,
+				InfrastructureEncryption: azure.ToStringPtr("Enabled"),
+				PublicNetworkAccess:      azure.ToStringPtr("Disabled"),
+				SKU:                      sku,
+				StorageProfile:           azuredbv1beta1.StorageProfile{StorageMB: 5120},
+			},
+			want: want{p: &postgresql.ServerPropertiesForDefaultCreate{
+				AdministratorLogin:         azure.ToStringPtr(admin),
+				AdministratorLoginPassword: azure.ToStringPtr(pw),
+				InfrastructureEncryption:   postgresql.InfrastructureEncryptionEnabled,
+				PublicNetworkAccess:        postgresql.PublicNetworkAccessEnumDisabled,
+				CreateMode:                 postgresql.CreateModeDefault,
+				StorageProfile:             storage,
+			}},
+		},
+		"PointInTimeRestore": {
+			p: params(azuredbv1beta1.CreateModePointInTimeRestore),
+			want: want{p: &postgresql.ServerPropertiesForRestore{
+				SourceServerID:     azure.ToStringPtr(sourceID),
+				RestorePointInTime: &date.Time{Time: restoreTime.Time},
+				CreateMode:         postgresql.CreateModePointInTimeRestore,
+				StorageProfile:     storage,
+			}},
+		},
+		"GeoRestore": {
+			p: params(azuredbv1beta1.CreateModeGeoRestore),
+			want: want{p: &postgresql.ServerPropertiesForGeoRestore{
+				SourceServerID: azure.ToStringPtr(sourceID),
+				CreateMode:     postgresql.CreateModeGeoRestore,
+				StorageProfile: storage,
+			}},
+		},
+		"Replica": {
+			p: params(azuredbv1beta1.CreateModeReplica),
+			want: want{p: &postgresql.ServerPropertiesForReplica{
+				SourceServerID: azure.ToStringPtr(sourceID),
+				CreateMode:     postgresql.CreateModeReplica,
+				StorageProfile: storage,
+			}},
+		},
+		"InvalidCreateMode": {
+			p:    azuredbv1beta1.SQLServerParameters{CreateMode: azure.ToStringPtr(azuredbv1beta1.CreateModeReplica)},
+			want: want{err: errors.Errorf(errSourceServerIDRequired, azuredbv1beta1.CreateModeReplica)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewServerCreateParameters(tc.p, pw)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewServerCreateParameters(...): -want error, +got error\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got := newPostgreSQLServerForCreate(p)
+			if diff := cmp.Diff(tc.want.p, got.Properties); diff != "" {
+				t.Errorf("newPostgreSQLServerForCreate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPostgreSQLServerUpToDate(t *testing.T) {
+	params := func(m ...func(*azuredbv1beta1.SQLServerParameters)) azuredbv1beta1.SQLServerParameters {
+		p := azuredbv1beta1.SQLServerParameters{
+			SKU:                 azuredbv1beta1.SKU{Tier: "GeneralPurpose", Capacity: 2, Family: "Gen5"},
+			Version:             "11",
+			SSLEnforcement:      "Enabled",
+			PublicNetworkAccess: azure.ToStringPtr("Enabled"),
+			StorageProfile:      azuredbv1beta1.StorageProfile{StorageMB: 5120},
+		}
+		for _, fn := range m {
+			fn(&p)
+		}
+		return p
+	}
+	server := func(m ...func(*postgresql.Server)) postgresql.Server {
+		s := postgresql.Server{
+			Sku: &postgresql.Sku{Tier: postgresql.GeneralPurpose, Capacity: azure.ToInt32Ptr(2), Family: azure.ToStringPtr("Gen5")},
+			ServerProperties: &postgresql.ServerProperties{
+				Version:             postgresql.OneOne,
+				SslEnforcement:      postgresql.SslEnforcementEnumEnabled,
+				PublicNetworkAccess: postgresql.PublicNetworkAccessEnumEnabled,
+				StorageProfile:      &postgresql.StorageProfile{StorageMB: azure.ToInt32Ptr(5120)},
+			},
+		}
+		for _, fn := range m {
+			fn(&s)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		in   postgresql.Server
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			in:   server(),
+			want: true,
+		},
+		"PublicNetworkAccessChanged": {
+			p:    params(func(p *azuredbv1beta1.SQLServerParameters) { p.PublicNetworkAccess = azure.ToStringPtr("Disabled") }),
+			in:   server(),
+			want: false,
+		},
+		"InfrastructureEncryptionIgnored": {
+			p:    params(func(p *azuredbv1beta1.SQLServerParameters) { p.InfrastructureEncryption = azure.ToStringPtr("Enabled") }),
+			in:   server(),
+			want: true,
+		},
+		"IdentityMissing": {
+			p: params(func(p *azuredbv1beta1.SQLServerParameters) {
+				p.Identity = &azuredbv1beta1.Identity{Type: azuredbv1beta1.IdentityTypeSystemAssigned}
+			}),
+			in:   server(),
+			want: false,
+		},
+		"IdentityAssigned": {
+			p: params(func(p *azuredbv1beta1.SQLServerParameters) {
+				p.Identity = &azuredbv1beta1.Identity{Type: azuredbv1beta1.IdentityTypeSystemAssigned}
+			}),
+			in:   server(func(s *postgresql.Server) { s.Identity = &postgresql.ResourceIdentity{Type: postgresql.SystemAssigned} }),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServerUpToDate(tc.p, fromPostgreSQLServer(tc.in))
+			if got != tc.want {
+				t.Errorf("IsServerUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdatePostgreSQLConfigurationObservation(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		az   postgresql.Configuration
+		want v1alpha3.ConfigurationObservation
+	}{
+		"DynamicParameter": {
+			name: "log_min_duration_statement",
+			az: postgresql.Configuration{
+				ID: azure.ToStringPtr(id),
+				ConfigurationProperties: &postgresql.ConfigurationProperties{
+					DefaultValue: azure.ToStringPtr("10"),
+					Source:       azure.ToStringPtr(v1alpha3.ConfigurationSourceUserOverride),
+				},
+			},
+			want: v1alpha3.ConfigurationObservation{
+				ID:           id,
+				DefaultValue: "10",
+				Source:       v1alpha3.ConfigurationSourceUserOverride,
+			},
+		},
+		"StaticParameter": {
+			name: "shared_preload_libraries",
+			az:   postgresql.Configuration{ID: azure.ToStringPtr(id)},
+			want: v1alpha3.ConfigurationObservation{
+				ID:              id,
+				RestartRequired: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := v1alpha3.ConfigurationObservation{}
+			UpdateConfigurationObservation(&got, fromPostgreSQLConfiguration(tc.name, tc.az))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateConfigurationObservation(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// NOTE: The Azure SDK has a package per single server database engine, e.g.
+// mysql and postgresql, whose types are identical but distinct. The engine
+// agnostic types below are what the engine agnostic logic of this package
+// works with. Each engine adapts its SDK types to and from them.
+
+// SKU tiers of Azure Database servers.
+const (
+	SKUTierBasic           = "Basic"
+	SKUTierGeneralPurpose  = "GeneralPurpose"
+	SKUTierMemoryOptimized = "MemoryOptimized"
+)
+
+var (
+	skuTiers      = []string{SKUTierBasic, SKUTierGeneralPurpose, SKUTierMemoryOptimized}
+	skuShortTiers = map[string]string{
+		SKUTierBasic:           "B",
+		SKUTierGeneralPurpose:  "GP",
+		SKUTierMemoryOptimized: "MO",
+	}
+)
+
+// A ServerSKU is the engine agnostic SKU of an Azure Database server.
+type ServerSKU struct {
+	Name     *string
+	Tier     string
+	Capacity *int32
+	Size     *string
+	Family   *string
+}
+
+// A ServerStorageProfile is the engine agnostic storage profile of an Azure
+// Database server.
+type ServerStorageProfile struct {
+	BackupRetentionDays *int32
+	GeoRedundantBackup  string
+	StorageMB           *int32
+	StorageAutogrow     string
+}
+
+// A ServerIdentity is the engine agnostic Azure Active Directory identity of
+// an Azure Database server.
+type ServerIdentity struct {
+	Type        string
+	PrincipalID string
+	TenantID    string
+}
+
+// A Server is the engine agnostic state of an Azure Database server as
+// reported by the Azure API. SKU and StorageProfile are nil if Azure did not
+// report them.
+type Server struct {
+	ID                       string
+	Name                     string
+	Type                     string
+	Tags                     map[string]*string
+	SKU                      *ServerSKU
+	Identity                 *ServerIdentity
+	Version                  string
+	SSLEnforcement           string
+	MinimalTLSVersion        string
+	InfrastructureEncryption string
+	PublicNetworkAccess      string
+	UserVisibleState         string
+	FullyQualifiedDomainName string
+	MasterServerID           string
+	ReplicationRole          string
+	ReplicaCapacity          *int32
+	ByokEnforcement          string
+	StorageProfile           *ServerStorageProfile
+}
+
+// ServerParameters are the engine agnostic parameters of a request to create
+// or update an Azure Database server. Update requests ignore the parameters
+// that can only be set at creation time.
+type ServerParameters struct {
+	Location                   string
+	Tags                       map[string]*string
+	SKU                        ServerSKU
+	Identity                   *ServerIdentity
+	CreateMode                 string
+	SourceServerID             *string
+	RestorePointInTime         *date.Time
+	AdministratorLogin         *string
+	AdministratorLoginPassword *string
+	Version                    string
+	SSLEnforcement             string
+	MinimalTLSVersion          string
+	InfrastructureEncryption   string
+	PublicNetworkAccess        string
+	ReplicationRole            *string
+	StorageProfile             ServerStorageProfile
+}
+
+// SQLServerAPI represents the engine agnostic API interface for an Azure
+// Database server client. Operations that start an asynchronous operation
+// return the URL it can be polled at.
+type SQLServerAPI interface {
+	GetServer(ctx context.Context, resourceGroupName, serverName string) (Server, error)
+	CreateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, adminPassword string) (pollingURL string, err error)
+	UpdateServer(ctx context.Context, serverName string, s azuredbv1beta1.SQLServerParameters, replicationRole, adminPassword string) (pollingURL string, err error)
+	DeleteServer(ctx context.Context, resourceGroupName, serverName string) (pollingURL string, err error)
+	GetRESTClient() autorest.Sender
+}
+
+// The name of an SKU is used by Azure to determine the tier, family and
+// capacity of the server, so users are not allowed to specify an arbitrary
+// name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
+
+// NewServerSKU returns the SKU of the supplied SKU spec that can be used in
+// Azure API calls.
+func NewServerSKU(skuSpec azuredbv1beta1.SKU) (ServerSKU, error) {
+	t, ok := skuShortTiers[skuSpec.Tier]
+	if !ok {
+		return ServerSKU{}, fmt.Errorf("tier '%s' is not one of the supported values: %+v", skuSpec.Tier, skuTiers)
+	}
+	return ServerSKU{
+		Name:     azure.ToStringPtr(fmt.Sprintf("%s_%s_%s", t, skuSpec.Family, strconv.Itoa(skuSpec.Capacity))),
+		Tier:     skuSpec.Tier,
+		Capacity: azure.ToInt32Ptr(skuSpec.Capacity),
+		Family:   azure.ToStringPtr(skuSpec.Family),
+		Size:     skuSpec.Size,
+	}, nil
+}
+
+func newServerIdentity(i *azuredbv1beta1.Identity) *ServerIdentity {
+	if i == nil {
+		return nil
+	}
+	return &ServerIdentity{Type: i.Type}
+}
+
+func newServerStorageProfile(s azuredbv1beta1.StorageProfile) ServerStorageProfile {
+	return ServerStorageProfile{
+		BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.BackupRetentionDays),
+		GeoRedundantBackup:  azure.ToString(s.GeoRedundantBackup),
+		StorageMB:           azure.ToInt32Ptr(s.StorageMB),
+		StorageAutogrow:     azure.ToString(s.StorageAutogrow),
+	}
+}
+
+// NewServerCreateParameters returns the parameters a server is created with
+// in the create mode of the supplied parameters. The administrator login and
+// password are used only in the default create mode.
+func NewServerCreateParameters(s azuredbv1beta1.SQLServerParameters, adminPassword string) (ServerParameters, error) {
+	p, err := newServerCreateProperties(s, adminPassword)
+	if err != nil {
+		return ServerParameters{}, err
+	}
+	sku, err := NewServerSKU(s.SKU)
+	if err != nil {
+		return ServerParameters{}, err
+	}
+	p.Location = s.Location
+	p.Tags = azure.ToStringPtrMap(s.Tags)
+	p.SKU = sku
+	p.Identity = newServerIdentity(s.Identity)
+	return p, nil
+}
+
+// newServerCreateProperties returns the parameters of the properties a server
+// is created with, i.e. all but its location, tags, SKU and identity.
+func newServerCreateProperties(s azuredbv1beta1.SQLServerParameters, adminPassword string) (ServerParameters, error) {
+	if err := ValidateCreateMode(s); err != nil {
+		return ServerParameters{}, err
+	}
+	p := ServerParameters{
+		CreateMode:               azuredbv1beta1.CreateModeDefault,
+		Version:                  s.Version,
+		SSLEnforcement:           s.SSLEnforcement,
+		MinimalTLSVersion:        string(s.MinimalTLSVersion),
+		InfrastructureEncryption: azure.ToString(s.InfrastructureEncryption),
+		PublicNetworkAccess:      azure.ToString(s.PublicNetworkAccess),
+		StorageProfile:           newServerStorageProfile(s.StorageProfile),
+	}
+	if IsDefaultCreateMode(s) {
+		p.AdministratorLogin = azure.ToStringPtr(s.AdministratorLogin)
+		p.AdministratorLoginPassword = &adminPassword
+		return p, nil
+	}
+	p.CreateMode = azure.ToString(s.CreateMode)
+	p.SourceServerID = s.SourceServerID
+	if s.RestorePointInTime != nil {
+		p.RestorePointInTime = &date.Time{Time: s.RestorePointInTime.Time}
+	}
+	return p, nil
+}
+
+// NewServerUpdateParameters returns the parameters a server with the supplied
+// observed replication role is updated with. The administrator login password
+// is changed as well unless the supplied password is empty.
+func NewServerUpdateParameters(s azuredbv1beta1.SQLServerParameters, replicationRole, adminPassword string) (ServerParameters, error) {
+	sku, err := NewServerSKU(s.SKU)
+	if err != nil {
+		return ServerParameters{}, err
+	}
+	p := ServerParameters{
+		Tags:                azure.ToStringPtrMap(s.Tags),
+		SKU:                 sku,
+		Identity:            newServerIdentity(s.Identity),
+		Version:             s.Version,
+		SSLEnforcement:      s.SSLEnforcement,
+		MinimalTLSVersion:   string(s.MinimalTLSVersion),
+		PublicNetworkAccess: azure.ToString(s.PublicNetworkAccess),
+		StorageProfile:      newServerStorageProfile(s.StorageProfile),
+	}
+	if adminPassword != "" {
+		p.AdministratorLoginPassword = &adminPassword
+	}
+	// Only replicas can be promoted; Azure rejects the replication role of
+	// servers that are not.
+	if NeedsPromotion(s, replicationRole) {
+		p.ReplicationRole = azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone)
+	}
+	return p, nil
+}
+
+// UpdateServerObservation produces SQLServerObservation from the supplied
+// Server.
+func UpdateServerObservation(o *azuredbv1beta1.SQLServerObservation, in Server) {
+	o.ID = in.ID
+	o.Name = in.Name
+	o.Type = in.Type
+	o.UserVisibleState = in.UserVisibleState
+	o.FullyQualifiedDomainName = in.FullyQualifiedDomainName
+	o.MasterServerID = in.MasterServerID
+	o.ReplicationRole = in.ReplicationRole
+	o.ReplicaCapacity = azure.ToInt(in.ReplicaCapacity)
+	o.ByokEnforcement = in.ByokEnforcement
+	if in.Identity != nil {
+		o.IdentityPrincipalID = in.Identity.PrincipalID
+		o.IdentityTenantID = in.Identity.TenantID
+	}
+}
+
+// LateInitializeServer fills the empty values of SQLServerParameters with the
+// ones that are retrieved from the Azure API.
+func LateInitializeServer(p *azuredbv1beta1.SQLServerParameters, in Server) {
+	if in.SKU != nil {
+		p.SKU.Size = azure.LateInitializeStringPtrFromPtr(p.SKU.Size, in.SKU.Size)
+	}
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.StorageProfile != nil {
+		p.StorageProfile.BackupRetentionDays = azure.LateInitializeIntPtrFromInt32Ptr(p.StorageProfile.BackupRetentionDays, in.StorageProfile.BackupRetentionDays)
+		p.StorageProfile.GeoRedundantBackup = azure.LateInitializeStringPtrFromVal(p.StorageProfile.GeoRedundantBackup, in.StorageProfile.GeoRedundantBackup)
+		p.StorageProfile.StorageAutogrow = azure.LateInitializeStringPtrFromVal(p.StorageProfile.StorageAutogrow, in.StorageProfile.StorageAutogrow)
+	}
+	if in.InfrastructureEncryption != "" {
+		p.InfrastructureEncryption = azure.LateInitializeStringPtrFromVal(p.InfrastructureEncryption, in.InfrastructureEncryption)
+	}
+	if in.PublicNetworkAccess != "" {
+		p.PublicNetworkAccess = azure.LateInitializeStringPtrFromVal(p.PublicNetworkAccess, in.PublicNetworkAccess)
+	}
+}
+
+// IsServerUpToDate is used to report whether the supplied Server is in sync
+// with the SQLServerParameters that user desires.
+// InfrastructureEncryption cannot be changed once the server exists, so it
+// is not compared.
+func IsServerUpToDate(p azuredbv1beta1.SQLServerParameters, in Server) bool { // nolint:gocyclo
+	if in.StorageProfile == nil || in.SKU == nil {
+		return false
+	}
+	switch {
+	case string(p.MinimalTLSVersion) != in.MinimalTLSVersion:
+		return false
+	case p.SSLEnforcement != in.SSLEnforcement:
+		return false
+	case azure.ToString(p.PublicNetworkAccess) != in.PublicNetworkAccess:
+		return false
+	case p.Identity != nil && (in.Identity == nil || p.Identity.Type != in.Identity.Type):
+		return false
+	case p.Version != in.Version:
+		return false
+	case !reflect.DeepEqual(azure.ToStringPtrMap(p.Tags), in.Tags):
+		return false
+	case p.SKU.Tier != in.SKU.Tier:
+		return false
+	case p.SKU.Capacity != azure.ToInt(in.SKU.Capacity):
+		return false
+	case p.SKU.Family != azure.ToString(in.SKU.Family):
+		return false
+	case !reflect.DeepEqual(azure.ToInt32PtrFromIntPtr(p.StorageProfile.BackupRetentionDays), in.StorageProfile.BackupRetentionDays):
+		return false
+	case azure.ToString(p.StorageProfile.GeoRedundantBackup) != in.StorageProfile.GeoRedundantBackup:
+		return false
+	case p.StorageProfile.StorageMB != azure.ToInt(in.StorageProfile.StorageMB):
+		return false
+	case azure.ToString(p.StorageProfile.StorageAutogrow) != in.StorageProfile.StorageAutogrow:
+		return false
+	case NeedsPromotion(p, in.ReplicationRole):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	azuredbv1beta1 "github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewServerSKU(t *testing.T) {
+	type want struct {
+		sku ServerSKU
+		err error
+	}
+
+	cases := map[string]struct {
+		s    azuredbv1beta1.SKU
+		want want
+	}{
+		"Successful": {
+			s: azuredbv1beta1.SKU{Tier: SKUTierGeneralPurpose, Family: "Gen5", Capacity: 2},
+			want: want{sku: ServerSKU{
+				Name:     azure.ToStringPtr("GP_Gen5_2"),
+				Tier:     SKUTierGeneralPurpose,
+				Capacity: azure.ToInt32Ptr(2),
+				Family:   azure.ToStringPtr("Gen5"),
+			}},
+		},
+		"UnknownTier": {
+			s:    azuredbv1beta1.SKU{Tier: "Premium", Family: "Gen5", Capacity: 2},
+			want: want{err: fmt.Errorf("tier 'Premium' is not one of the supported values: [Basic GeneralPurpose MemoryOptimized]")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewServerSKU(tc.s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewServerSKU(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.sku, got); diff != "" {
+				t.Errorf("NewServerSKU(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewServerUpdateParameters(t *testing.T) {
+	pw := "verysecure"
+	sku := azuredbv1beta1.SKU{Tier: SKUTierBasic, Family: "Gen5", Capacity: 1}
+
+	cases := map[string]struct {
+		p    azuredbv1beta1.SQLServerParameters
+		role string
+		pw   string
+		want ServerParameters
+	}{
+		"PasswordUnchanged": {
+			p: azuredbv1beta1.SQLServerParameters{SKU: sku, Version: "5.7"},
+			want: ServerParameters{
+				SKU:     ServerSKU{Name: azure.ToStringPtr("B_Gen5_1"), Tier: SKUTierBasic, Capacity: azure.ToInt32Ptr(1), Family: azure.ToStringPtr("Gen5")},
+				Version: "5.7",
+			},
+		},
+		"PasswordChanged": {
+			p:  azuredbv1beta1.SQLServerParameters{SKU: sku, Version: "5.7"},
+			pw: pw,
+			want: ServerParameters{
+				SKU:                        ServerSKU{Name: azure.ToStringPtr("B_Gen5_1"), Tier: SKUTierBasic, Capacity: azure.ToInt32Ptr(1), Family: azure.ToStringPtr("Gen5")},
+				Version:                    "5.7",
+				AdministratorLoginPassword: azure.ToStringPtr(pw),
+			},
+		},
+		"Promotion": {
+			p:    azuredbv1beta1.SQLServerParameters{SKU: sku, ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone)},
+			role: azuredbv1beta1.ReplicationRoleReplica,
+			want: ServerParameters{
+				SKU:             ServerSKU{Name: azure.ToStringPtr("B_Gen5_1"), Tier: SKUTierBasic, Capacity: azure.ToInt32Ptr(1), Family: azure.ToStringPtr("Gen5")},
+				ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone),
+			},
+		},
+		"AlreadyPromoted": {
+			p:    azuredbv1beta1.SQLServerParameters{SKU: sku, ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone)},
+			role: azuredbv1beta1.ReplicationRoleNone,
+			want: ServerParameters{
+				SKU: ServerSKU{Name: azure.ToStringPtr("B_Gen5_1"), Tier: SKUTierBasic, Capacity: azure.ToInt32Ptr(1), Family: azure.ToStringPtr("Gen5")},
+			},
+		},
+		"Master": {
+			p:    azuredbv1beta1.SQLServerParameters{SKU: sku, ReplicationRole: azure.ToStringPtr(azuredbv1beta1.ReplicationRoleNone)},
+			role: azuredbv1beta1.ReplicationRoleMaster,
+			want: ServerParameters{
+				SKU: ServerSKU{Name: azure.ToStringPtr("B_Gen5_1"), Tier: SKUTierBasic, Capacity: azure.ToInt32Ptr(1), Family: azure.ToStringPtr("Gen5")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewServerUpdateParameters(tc.p, tc.role, tc.pw)
+			if err != nil {
+				t.Errorf("NewServerUpdateParameters(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewServerUpdateParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
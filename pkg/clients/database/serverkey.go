@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const errInvalidKeyURI = "keyUri must be the versioned URI of an Azure Key Vault key"
+
+// A ServerKey is the engine agnostic state of an Azure Database server key.
+type ServerKey struct {
+	ID            string
+	Name          string
+	Type          string
+	ServerKeyType *string
+	URI           *string
+	CreationDate  *date.Time
+}
+
+// ServerKeyAPI represents the engine agnostic API interface for an Azure
+// Database server key client.
+type ServerKeyAPI interface {
+	GetServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) (ServerKey, error)
+	CreateOrUpdateServerKey(ctx context.Context, resourceGroupName, serverName, keyName string, k ServerKey) error
+	DeleteServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) error
+}
+
+// ServerKeyName returns the name Azure expects a server key with the supplied
+// Key Vault key URI to have, i.e. <vault>_<key>_<version>.
+func ServerKeyName(keyURI string) (string, error) {
+	u, err := url.Parse(keyURI)
+	if err != nil {
+		return "", errors.Wrap(err, errInvalidKeyURI)
+	}
+	vault := strings.SplitN(u.Hostname(), ".", 2)[0]
+	path := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Scheme != "https" || vault == "" || len(path) != 3 || path[0] != "keys" || path[1] == "" || path[2] == "" {
+		return "", errors.New(errInvalidKeyURI)
+	}
+	return strings.Join([]string{vault, path[1], path[2]}, "_"), nil
+}
+
+// NewServerKey returns a ServerKey from a server key spec.
+func NewServerKey(p azuredbv1alpha3.ServerKeyParameters) ServerKey {
+	return ServerKey{
+		ServerKeyType: azure.ToStringPtr(azuredbv1alpha3.ServerKeyTypeAzureKeyVault),
+		URI:           azure.ToStringPtr(p.KeyURI),
+	}
+}
+
+// ServerKeyIsUpToDate returns true if the supplied ServerKey appears to be up
+// to date with the supplied ServerKeyParameters.
+func ServerKeyIsUpToDate(p azuredbv1alpha3.ServerKeyParameters, az ServerKey) bool {
+	return strings.EqualFold(p.KeyURI, azure.ToString(az.URI)) &&
+		azure.ToString(az.ServerKeyType) == azuredbv1alpha3.ServerKeyTypeAzureKeyVault
+}
+
+// UpdateServerKeyObservation updates the supplied ServerKeyObservation with
+// the supplied ServerKey.
+func UpdateServerKeyObservation(o *azuredbv1alpha3.ServerKeyObservation, az ServerKey) {
+	o.ID = az.ID
+	o.Name = az.Name
+	o.Type = az.Type
+	o.CreationDate = nil
+	if az.CreationDate != nil {
+		t := metav1.NewTime(az.CreationDate.Time)
+		o.CreationDate = &t
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestServerKeyName(t *testing.T) {
+	type want struct {
+		name string
+		err  error
+	}
+
+	cases := map[string]struct {
+		uri  string
+		want want
+	}{
+		"Valid": {
+			uri:  "https://coolvault.vault.azure.net/keys/coolkey/0123456789abcdef",
+			want: want{name: "coolvault_coolkey_0123456789abcdef"},
+		},
+		"TrailingSlash": {
+			uri:  "https://coolvault.vault.azure.net/keys/coolkey/0123456789abcdef/",
+			want: want{name: "coolvault_coolkey_0123456789abcdef"},
+		},
+		"Unversioned": {
+			uri:  "https://coolvault.vault.azure.net/keys/coolkey",
+			want: want{err: errors.New(errInvalidKeyURI)},
+		},
+		"NotAKey": {
+			uri:  "https://coolvault.vault.azure.net/secrets/coolsecret/0123456789abcdef",
+			want: want{err: errors.New(errInvalidKeyURI)},
+		},
+		"NotHTTPS": {
+			uri:  "http://coolvault.vault.azure.net/keys/coolkey/0123456789abcdef",
+			want: want{err: errors.New(errInvalidKeyURI)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ServerKeyName(tc.uri)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ServerKeyName(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("ServerKeyName(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServerKeyIsUpToDate(t *testing.T) {
+	uri := "https://vault.vault.azure.net/keys/key/0123"
+	p := v1alpha3.ServerKeyParameters{KeyURI: uri}
+
+	cases := map[string]struct {
+		az   ServerKey
+		want bool
+	}{
+		"UpToDate": {
+			az:   NewServerKey(p),
+			want: true,
+		},
+		"DifferentCase": {
+			az: ServerKey{
+				ServerKeyType: azure.ToStringPtr(v1alpha3.ServerKeyTypeAzureKeyVault),
+				URI:           azure.ToStringPtr("https://VAULT.vault.azure.net/keys/key/0123"),
+			},
+			want: true,
+		},
+		"NoProperties": {
+			az:   ServerKey{},
+			want: false,
+		},
+		"URIChanged": {
+			az: ServerKey{
+				ServerKeyType: azure.ToStringPtr(v1alpha3.ServerKeyTypeAzureKeyVault),
+				URI:           azure.ToStringPtr("https://vault.vault.azure.net/keys/key/4567"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ServerKeyIsUpToDate(p, tc.az)
+			if got != tc.want {
+				t.Errorf("ServerKeyIsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"reflect"
+
+	azuredbv1alpha3 "github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// A VirtualNetworkRule is the engine agnostic state of an Azure Database
+// server virtual network rule.
+type VirtualNetworkRule struct {
+	ID                               string
+	Type                             string
+	State                            string
+	VirtualNetworkSubnetID           *string
+	IgnoreMissingVnetServiceEndpoint *bool
+}
+
+// VirtualNetworkRuleAPI represents the engine agnostic API interface for an
+// Azure Database server virtual network rule client.
+type VirtualNetworkRuleAPI interface {
+	GetVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (VirtualNetworkRule, error)
+	CreateOrUpdateVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r VirtualNetworkRule) error
+	DeleteVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error
+}
+
+// NewVirtualNetworkRule returns a VirtualNetworkRule from a virtual network
+// rule spec.
+func NewVirtualNetworkRule(p azuredbv1alpha3.VirtualNetworkRuleProperties) VirtualNetworkRule {
+	return VirtualNetworkRule{
+		VirtualNetworkSubnetID:           azure.ToStringPtr(p.VirtualNetworkSubnetID),
+		IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(p.IgnoreMissingVnetServiceEndpoint, azure.FieldRequired),
+	}
+}
+
+// VirtualNetworkRuleNeedsUpdate determines if a virtual network rule needs to
+// be updated.
+func VirtualNetworkRuleNeedsUpdate(p azuredbv1alpha3.VirtualNetworkRuleProperties, az VirtualNetworkRule) bool {
+	up := NewVirtualNetworkRule(p)
+
+	switch {
+	case !reflect.DeepEqual(up.VirtualNetworkSubnetID, az.VirtualNetworkSubnetID):
+		return true
+	case !reflect.DeepEqual(up.IgnoreMissingVnetServiceEndpoint, az.IgnoreMissingVnetServiceEndpoint):
+		return true
+	}
+
+	return false
+}
+
+// UpdateVirtualNetworkRuleStatus updates the supplied VirtualNetworkRuleStatus
+// with the state of the supplied VirtualNetworkRule.
+func UpdateVirtualNetworkRuleStatus(s *azuredbv1alpha3.VirtualNetworkRuleStatus, az VirtualNetworkRule) {
+	s.State = az.State
+	s.ID = az.ID
+	s.Type = az.Type
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewVirtualNetworkRule(t *testing.T) {
+	cases := []struct {
+		name string
+		p    v1alpha3.VirtualNetworkRuleProperties
+		want VirtualNetworkRule
+	}{
+		{
+			name: "Successful",
+			p: v1alpha3.VirtualNetworkRuleProperties{
+				VirtualNetworkSubnetID:           vnetSubnetID,
+				IgnoreMissingVnetServiceEndpoint: ignoreMissing,
+			},
+			want: VirtualNetworkRule{
+				VirtualNetworkSubnetID:           to.StringPtr(vnetSubnetID),
+				IgnoreMissingVnetServiceEndpoint: to.BoolPtr(ignoreMissing),
+			},
+		},
+		{
+			name: "SuccessfulPartial",
+			p: v1alpha3.VirtualNetworkRuleProperties{
+				VirtualNetworkSubnetID: vnetSubnetID,
+			},
+			want: VirtualNetworkRule{
+				VirtualNetworkSubnetID:           to.StringPtr(vnetSubnetID),
+				IgnoreMissingVnetServiceEndpoint: to.BoolPtr(false),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewVirtualNetworkRule(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewVirtualNetworkRule(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVirtualNetworkRuleNeedsUpdate(t *testing.T) {
+	p := v1alpha3.VirtualNetworkRuleProperties{
+		VirtualNetworkSubnetID:           vnetSubnetID,
+		IgnoreMissingVnetServiceEndpoint: ignoreMissing,
+	}
+
+	cases := []struct {
+		name string
+		az   VirtualNetworkRule
+		want bool
+	}{
+		{
+			name: "NoUpdateNeeded",
+			az: VirtualNetworkRule{
+				VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+				IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+			},
+			want: false,
+		},
+		{
+			name: "UpdateNeededVirtualNetworkSubnetID",
+			az: VirtualNetworkRule{
+				VirtualNetworkSubnetID:           azure.ToStringPtr("some/other/subnet"),
+				IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+			},
+			want: true,
+		},
+		{
+			name: "UpdateNeededIgnoreMissingVnetServiceEndpoint",
+			az: VirtualNetworkRule{
+				VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+				IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(!ignoreMissing),
+			},
+			want: true,
+		},
+		{
+			name: "UpdateNeededPropertiesMissing",
+			az:   VirtualNetworkRule{},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := VirtualNetworkRuleNeedsUpdate(p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("VirtualNetworkRuleNeedsUpdate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateVirtualNetworkRuleStatus(t *testing.T) {
+
+	mockCondition := xpv1.Condition{Message: "mockMessage"}
+	resourceStatus := xpv1.ResourceStatus{
+		ConditionedStatus: xpv1.ConditionedStatus{
+			Conditions: []xpv1.Condition{mockCondition},
+		},
+	}
+
+	cases := []struct {
+		name string
+		r    VirtualNetworkRule
+		want v1alpha3.VirtualNetworkRuleStatus
+	}{
+		{
+			name: "SuccessfulFull",
+			r: VirtualNetworkRule{
+				ID:                               id,
+				Type:                             resourceType,
+				State:                            "Ready",
+				VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+				IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+			},
+			want: v1alpha3.VirtualNetworkRuleStatus{
+				State: "Ready",
+				ID:    id,
+				Type:  resourceType,
+			},
+		},
+		{
+			name: "SuccessfulPartial",
+			r: VirtualNetworkRule{
+				ID:                               id,
+				State:                            "Ready",
+				VirtualNetworkSubnetID:           azure.ToStringPtr(vnetSubnetID),
+				IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(ignoreMissing),
+			},
+			want: v1alpha3.VirtualNetworkRuleStatus{
+				State: "Ready",
+				ID:    id,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &v1alpha3.VirtualNetworkRuleStatus{
+				ResourceStatus: resourceStatus,
+			}
+
+			UpdateVirtualNetworkRuleStatus(s, tc.r)
+
+			// make sure that internal resource status hasn't changed
+			if diff := cmp.Diff(mockCondition, s.ResourceStatus.Conditions[0]); diff != "" {
+				t.Errorf("UpdateVirtualNetworkRuleStatus(...): -want, +got\n%s", diff)
+			}
+
+			// make sure that other resource parameters are updated
+			tc.want.ResourceStatus = resourceStatus
+			if diff := cmp.Diff(tc.want, *s); diff != "" {
+				t.Errorf("UpdateVirtualNetworkRuleStatus(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,10 +19,15 @@ package fake
 import (
 	"context"
 
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql/mysqlapi"
-	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
-	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql/postgresqlapi"
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb/mariadbapi"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql/mysqlapi"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql/postgresqlapi"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/Azure/go-autorest/autorest"
 )
 
 var _ mysqlapi.VirtualNetworkRulesClientAPI = &MockMySQLVirtualNetworkRulesClient{}
@@ -77,6 +82,32 @@ func (c *MockPostgreSQLVirtualNetworkRulesClient) Get(ctx context.Context, resou
 	return c.MockGet(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
 }
 
+var _ mariadbapi.VirtualNetworkRulesClientAPI = &MockMariaDBVirtualNetworkRulesClient{}
+
+// MockMariaDBVirtualNetworkRulesClient is a fake implementation of mariadb.VirtualNetworkRulesClient.
+type MockMariaDBVirtualNetworkRulesClient struct {
+	mariadbapi.VirtualNetworkRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string, parameters mariadb.VirtualNetworkRule) (result mariadb.VirtualNetworkRulesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRulesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRule, err error)
+}
+
+// CreateOrUpdate calls the MockMariaDBVirtualNetworkRulesClient's MockCreateOrUpdate method.
+func (c *MockMariaDBVirtualNetworkRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string, parameters mariadb.VirtualNetworkRule) (result mariadb.VirtualNetworkRulesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, virtualNetworkRuleName, parameters)
+}
+
+// Delete calls the MockMariaDBVirtualNetworkRulesClient's MockDelete method.
+func (c *MockMariaDBVirtualNetworkRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRulesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
+}
+
+// Get calls the MockMariaDBVirtualNetworkRulesClient's MockGet method.
+func (c *MockMariaDBVirtualNetworkRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, virtualNetworkRuleName string) (result mariadb.VirtualNetworkRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, virtualNetworkRuleName)
+}
+
 var _ mysqlapi.FirewallRulesClientAPI = &MockMySQLFirewallRulesClient{}
 
 // MockMySQLFirewallRulesClient is a fake implementation of mysql.FirewallRulesClient.
@@ -128,3 +159,347 @@ func (c *MockPostgreSQLFirewallRulesClient) Delete(ctx context.Context, resource
 func (c *MockPostgreSQLFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result postgresql.FirewallRule, err error) {
 	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
 }
+
+var _ mariadbapi.FirewallRulesClientAPI = &MockMariaDBFirewallRulesClient{}
+
+// MockMariaDBFirewallRulesClient is a fake implementation of mariadb.FirewallRulesClient.
+type MockMariaDBFirewallRulesClient struct {
+	mariadbapi.FirewallRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters mariadb.FirewallRule) (result mariadb.FirewallRulesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRulesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRule, err error)
+}
+
+// CreateOrUpdate calls the MockMariaDBFirewallRulesClient's MockCreateOrUpdate method.
+func (c *MockMariaDBFirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters mariadb.FirewallRule) (result mariadb.FirewallRulesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, firewallRuleName, parameters)
+}
+
+// Delete calls the MockMariaDBFirewallRulesClient's MockDelete method.
+func (c *MockMariaDBFirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRulesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+// Get calls the MockMariaDBFirewallRulesClient's MockGet method.
+func (c *MockMariaDBFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result mariadb.FirewallRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+var _ mysqlapi.ConfigurationsClientAPI = &MockMySQLConfigurationsClient{}
+
+// MockMySQLConfigurationsClient is a fake implementation of mysql.ConfigurationsClient.
+type MockMySQLConfigurationsClient struct {
+	mysqlapi.ConfigurationsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters mysql.Configuration) (result mysql.ConfigurationsCreateOrUpdateFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result mysql.Configuration, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLConfigurationsClient's MockCreateOrUpdate method.
+func (c *MockMySQLConfigurationsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters mysql.Configuration) (result mysql.ConfigurationsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, configurationName, parameters)
+}
+
+// Get calls the MockMySQLConfigurationsClient's MockGet method.
+func (c *MockMySQLConfigurationsClient) Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result mysql.Configuration, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, configurationName)
+}
+
+var _ postgresqlapi.ConfigurationsClientAPI = &MockPostgreSQLConfigurationsClient{}
+
+// MockPostgreSQLConfigurationsClient is a fake implementation of postgresql.ConfigurationsClient.
+type MockPostgreSQLConfigurationsClient struct {
+	postgresqlapi.ConfigurationsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters postgresql.Configuration) (result postgresql.ConfigurationsCreateOrUpdateFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result postgresql.Configuration, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLConfigurationsClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLConfigurationsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, configurationName string, parameters postgresql.Configuration) (result postgresql.ConfigurationsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, configurationName, parameters)
+}
+
+// Get calls the MockPostgreSQLConfigurationsClient's MockGet method.
+func (c *MockPostgreSQLConfigurationsClient) Get(ctx context.Context, resourceGroupName string, serverName string, configurationName string) (result postgresql.Configuration, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, configurationName)
+}
+
+var _ mysqlapi.DatabasesClientAPI = &MockMySQLDatabasesClient{}
+
+// MockMySQLDatabasesClient is a fake implementation of mysql.DatabasesClient.
+type MockMySQLDatabasesClient struct {
+	mysqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters mysql.Database) (result mysql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockMySQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters mysql.Database) (result mysql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockMySQLDatabasesClient's MockDelete method.
+func (c *MockMySQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockMySQLDatabasesClient's MockGet method.
+func (c *MockMySQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ postgresqlapi.DatabasesClientAPI = &MockPostgreSQLDatabasesClient{}
+
+// MockPostgreSQLDatabasesClient is a fake implementation of postgresql.DatabasesClient.
+type MockPostgreSQLDatabasesClient struct {
+	postgresqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters postgresql.Database) (result postgresql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters postgresql.Database) (result postgresql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockPostgreSQLDatabasesClient's MockDelete method.
+func (c *MockPostgreSQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockPostgreSQLDatabasesClient's MockGet method.
+func (c *MockPostgreSQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ mysqlapi.ServerAdministratorsClientAPI = &MockMySQLServerAdministratorsClient{}
+
+// MockMySQLServerAdministratorsClient is a fake implementation of mysql.ServerAdministratorsClient.
+type MockMySQLServerAdministratorsClient struct {
+	mysqlapi.ServerAdministratorsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, properties mysql.ServerAdministratorResource) (result mysql.ServerAdministratorsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string) (result mysql.ServerAdministratorsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string) (result mysql.ServerAdministratorResource, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLServerAdministratorsClient's MockCreateOrUpdate method.
+func (c *MockMySQLServerAdministratorsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, properties mysql.ServerAdministratorResource) (result mysql.ServerAdministratorsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, properties)
+}
+
+// Delete calls the MockMySQLServerAdministratorsClient's MockDelete method.
+func (c *MockMySQLServerAdministratorsClient) Delete(ctx context.Context, resourceGroupName string, serverName string) (result mysql.ServerAdministratorsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName)
+}
+
+// Get calls the MockMySQLServerAdministratorsClient's MockGet method.
+func (c *MockMySQLServerAdministratorsClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result mysql.ServerAdministratorResource, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName)
+}
+
+var _ postgresqlapi.ServerAdministratorsClientAPI = &MockPostgreSQLServerAdministratorsClient{}
+
+// MockPostgreSQLServerAdministratorsClient is a fake implementation of postgresql.ServerAdministratorsClient.
+type MockPostgreSQLServerAdministratorsClient struct {
+	postgresqlapi.ServerAdministratorsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, properties postgresql.ServerAdministratorResource) (result postgresql.ServerAdministratorsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string) (result postgresql.ServerAdministratorsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string) (result postgresql.ServerAdministratorResource, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLServerAdministratorsClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLServerAdministratorsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, properties postgresql.ServerAdministratorResource) (result postgresql.ServerAdministratorsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, properties)
+}
+
+// Delete calls the MockPostgreSQLServerAdministratorsClient's MockDelete method.
+func (c *MockPostgreSQLServerAdministratorsClient) Delete(ctx context.Context, resourceGroupName string, serverName string) (result postgresql.ServerAdministratorsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName)
+}
+
+// Get calls the MockPostgreSQLServerAdministratorsClient's MockGet method.
+func (c *MockPostgreSQLServerAdministratorsClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result postgresql.ServerAdministratorResource, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName)
+}
+
+var _ mysqlapi.ServerKeysClientAPI = &MockMySQLServerKeysClient{}
+
+// MockMySQLServerKeysClient is a fake implementation of mysql.ServerKeysClient.
+type MockMySQLServerKeysClient struct {
+	mysqlapi.ServerKeysClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, serverName string, keyName string, parameters mysql.ServerKey, resourceGroupName string) (result mysql.ServerKeysCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, serverName string, keyName string, resourceGroupName string) (result mysql.ServerKeysDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, keyName string) (result mysql.ServerKey, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLServerKeysClient's MockCreateOrUpdate method.
+func (c *MockMySQLServerKeysClient) CreateOrUpdate(ctx context.Context, serverName string, keyName string, parameters mysql.ServerKey, resourceGroupName string) (result mysql.ServerKeysCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, serverName, keyName, parameters, resourceGroupName)
+}
+
+// Delete calls the MockMySQLServerKeysClient's MockDelete method.
+func (c *MockMySQLServerKeysClient) Delete(ctx context.Context, serverName string, keyName string, resourceGroupName string) (result mysql.ServerKeysDeleteFuture, err error) {
+	return c.MockDelete(ctx, serverName, keyName, resourceGroupName)
+}
+
+// Get calls the MockMySQLServerKeysClient's MockGet method.
+func (c *MockMySQLServerKeysClient) Get(ctx context.Context, resourceGroupName string, serverName string, keyName string) (result mysql.ServerKey, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, keyName)
+}
+
+var _ postgresqlapi.ServerKeysClientAPI = &MockPostgreSQLServerKeysClient{}
+
+// MockPostgreSQLServerKeysClient is a fake implementation of postgresql.ServerKeysClient.
+type MockPostgreSQLServerKeysClient struct {
+	postgresqlapi.ServerKeysClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, serverName string, keyName string, parameters postgresql.ServerKey, resourceGroupName string) (result postgresql.ServerKeysCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, serverName string, keyName string, resourceGroupName string) (result postgresql.ServerKeysDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, keyName string) (result postgresql.ServerKey, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLServerKeysClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLServerKeysClient) CreateOrUpdate(ctx context.Context, serverName string, keyName string, parameters postgresql.ServerKey, resourceGroupName string) (result postgresql.ServerKeysCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, serverName, keyName, parameters, resourceGroupName)
+}
+
+// Delete calls the MockPostgreSQLServerKeysClient's MockDelete method.
+func (c *MockPostgreSQLServerKeysClient) Delete(ctx context.Context, serverName string, keyName string, resourceGroupName string) (result postgresql.ServerKeysDeleteFuture, err error) {
+	return c.MockDelete(ctx, serverName, keyName, resourceGroupName)
+}
+
+// Get calls the MockPostgreSQLServerKeysClient's MockGet method.
+func (c *MockPostgreSQLServerKeysClient) Get(ctx context.Context, resourceGroupName string, serverName string, keyName string) (result postgresql.ServerKey, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, keyName)
+}
+
+var _ sqlapi.ServersClientAPI = &MockMSSQLServersClient{}
+
+// MockMSSQLServersClient is a fake implementation of sql.ServersClient.
+type MockMSSQLServersClient struct {
+	sqlapi.ServersClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, parameters sql.Server) (result sql.ServersCreateOrUpdateFuture, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, serverName string, parameters sql.ServerUpdate) (result sql.ServersUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string) (result sql.ServersDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string) (result sql.Server, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLServersClient's MockCreateOrUpdate method.
+func (c *MockMSSQLServersClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, parameters sql.Server) (result sql.ServersCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, parameters)
+}
+
+// Update calls the MockMSSQLServersClient's MockUpdate method.
+func (c *MockMSSQLServersClient) Update(ctx context.Context, resourceGroupName string, serverName string, parameters sql.ServerUpdate) (result sql.ServersUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, serverName, parameters)
+}
+
+// Delete calls the MockMSSQLServersClient's MockDelete method.
+func (c *MockMSSQLServersClient) Delete(ctx context.Context, resourceGroupName string, serverName string) (result sql.ServersDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName)
+}
+
+// Get calls the MockMSSQLServersClient's MockGet method.
+func (c *MockMSSQLServersClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result sql.Server, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName)
+}
+
+var _ sqlapi.DatabasesClientAPI = &MockMSSQLDatabasesClient{}
+
+// MockMSSQLDatabasesClient is a fake implementation of sql.DatabasesClient.
+type MockMSSQLDatabasesClient struct {
+	sqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.Database) (result sql.DatabasesCreateOrUpdateFuture, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.DatabaseUpdate) (result sql.DatabasesUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockMSSQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.Database) (result sql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Update calls the MockMSSQLDatabasesClient's MockUpdate method.
+func (c *MockMSSQLDatabasesClient) Update(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.DatabaseUpdate) (result sql.DatabasesUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockMSSQLDatabasesClient's MockDelete method.
+func (c *MockMSSQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockMSSQLDatabasesClient's MockGet method.
+func (c *MockMSSQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ sqlapi.ElasticPoolsClientAPI = &MockMSSQLElasticPoolsClient{}
+
+// MockMSSQLElasticPoolsClient is a fake implementation of sql.ElasticPoolsClient.
+type MockMSSQLElasticPoolsClient struct {
+	sqlapi.ElasticPoolsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPool) (result sql.ElasticPoolsCreateOrUpdateFuture, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPoolUpdate) (result sql.ElasticPoolsUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPoolsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPool, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLElasticPoolsClient's MockCreateOrUpdate method.
+func (c *MockMSSQLElasticPoolsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPool) (result sql.ElasticPoolsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, elasticPoolName, parameters)
+}
+
+// Update calls the MockMSSQLElasticPoolsClient's MockUpdate method.
+func (c *MockMSSQLElasticPoolsClient) Update(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPoolUpdate) (result sql.ElasticPoolsUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, serverName, elasticPoolName, parameters)
+}
+
+// Delete calls the MockMSSQLElasticPoolsClient's MockDelete method.
+func (c *MockMSSQLElasticPoolsClient) Delete(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPoolsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, elasticPoolName)
+}
+
+// Get calls the MockMSSQLElasticPoolsClient's MockGet method.
+func (c *MockMSSQLElasticPoolsClient) Get(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPool, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, elasticPoolName)
+}
+
+var _ sqlapi.FirewallRulesClientAPI = &MockMSSQLFirewallRulesClient{}
+
+// MockMSSQLFirewallRulesClient is a fake implementation of sql.FirewallRulesClient.
+type MockMSSQLFirewallRulesClient struct {
+	sqlapi.FirewallRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters sql.FirewallRule) (result sql.FirewallRule, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result sql.FirewallRule, err error)
+}
+
+// CreateOrUpdate calls the MockMSSQLFirewallRulesClient's MockCreateOrUpdate method.
+func (c *MockMSSQLFirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string, parameters sql.FirewallRule) (result sql.FirewallRule, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, firewallRuleName, parameters)
+}
+
+// Delete calls the MockMSSQLFirewallRulesClient's MockDelete method.
+func (c *MockMSSQLFirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, firewallRuleName)
+}
+
+// Get calls the MockMSSQLFirewallRulesClient's MockGet method.
+func (c *MockMSSQLFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result sql.FirewallRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// Connection secret keys published by Redis in addition to the common
+// endpoint, port and password keys.
+const (
+	ConnectionSecretSSLPortKey          = "sslPort"
+	ConnectionSecretSecondaryKeyKey     = "secondaryKey"
+	ConnectionSecretRedisURIKey         = "redisUri"
+	ConnectionSecretRedissURIKey        = "redissUri"
+	ConnectionSecretConnectionStringKey = "connectionString"
+)
+
+const errInvalidKeyRegenerationTrigger = "regenerate-key annotation must name the Primary or Secondary key"
+
+// NewConnectionDetails returns the connection details of the supplied Redis
+// cache. A redis:// URI is only included if the non-SSL port is enabled.
+func NewConnectionDetails(az redis.ResourceType, k redis.AccessKeys) map[string][]byte {
+	o := GenerateObservation(az)
+	password := azure.ToString(k.PrimaryKey)
+	sslAddr := net.JoinHostPort(o.HostName, strconv.Itoa(o.SSLPort))
+
+	cd := map[string][]byte{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(o.HostName),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(o.Port)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+		ConnectionSecretSSLPortKey:                []byte(strconv.Itoa(o.SSLPort)),
+		ConnectionSecretSecondaryKeyKey:           []byte(azure.ToString(k.SecondaryKey)),
+		ConnectionSecretRedissURIKey:              []byte(redisURI("rediss", password, sslAddr)),
+		ConnectionSecretConnectionStringKey:       []byte(fmt.Sprintf("%s,password=%s,ssl=True,abortConnect=False", sslAddr, password)),
+	}
+	if az.Properties != nil && azure.ToBool(az.Properties.EnableNonSslPort) {
+		cd[ConnectionSecretRedisURIKey] = []byte(redisURI("redis", password, net.JoinHostPort(o.HostName, strconv.Itoa(o.Port))))
+	}
+	return cd
+}
+
+func redisURI(scheme, password, addr string) string {
+	u := url.URL{Scheme: scheme, User: url.UserPassword("", password), Host: addr}
+	return u.String()
+}
+
+// ParseKeyRegenerationTrigger returns the type of the access key named by the
+// supplied value of the regenerate-key annotation.
+func ParseKeyRegenerationTrigger(trigger string) (redis.KeyType, error) {
+	kt := redis.KeyType(strings.SplitN(trigger, ":", 2)[0])
+	for _, v := range redis.PossibleKeyTypeValues() {
+		if kt == v {
+			return kt, nil
+		}
+	}
+	return "", errors.New(errInvalidKeyRegenerationTrigger)
+}
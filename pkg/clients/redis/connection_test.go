@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+
+	redismgmt "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewConnectionDetails(t *testing.T) {
+	keys := redismgmt.AccessKeys{
+		PrimaryKey:   azure.ToStringPtr("pri/key="),
+		SecondaryKey: azure.ToStringPtr("sec"),
+	}
+	cache := func(nonSSL bool) redismgmt.ResourceType {
+		return redismgmt.ResourceType{
+			Properties: &redismgmt.Properties{
+				HostName:         azure.ToStringPtr("cool.redis.cache.windows.net"),
+				Port:             azure.ToInt32Ptr(6379),
+				SslPort:          azure.ToInt32Ptr(6380),
+				EnableNonSslPort: azure.ToBoolPtr(nonSSL),
+			},
+		}
+	}
+	base := func() map[string][]byte {
+		return map[string][]byte{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool.redis.cache.windows.net"),
+			xpv1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte("pri/key="),
+			ConnectionSecretSSLPortKey:                []byte("6380"),
+			ConnectionSecretSecondaryKeyKey:           []byte("sec"),
+			ConnectionSecretRedissURIKey:              []byte("rediss://:pri%2Fkey=@cool.redis.cache.windows.net:6380"),
+			ConnectionSecretConnectionStringKey:       []byte("cool.redis.cache.windows.net:6380,password=pri/key=,ssl=True,abortConnect=False"),
+		}
+	}
+	withNonSSL := base()
+	withNonSSL[ConnectionSecretRedisURIKey] = []byte("redis://:pri%2Fkey=@cool.redis.cache.windows.net:6379")
+
+	cases := map[string]struct {
+		az   redismgmt.ResourceType
+		want map[string][]byte
+	}{
+		"SSLOnly": {
+			az:   cache(false),
+			want: base(),
+		},
+		"NonSSLPortEnabled": {
+			az:   cache(true),
+			want: withNonSSL,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewConnectionDetails(tc.az, keys)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewConnectionDetails(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseKeyRegenerationTrigger(t *testing.T) {
+	type want struct {
+		kt  redismgmt.KeyType
+		err error
+	}
+	cases := map[string]struct {
+		trigger string
+		want    want
+	}{
+		"Primary": {
+			trigger: "Primary",
+			want:    want{kt: redismgmt.Primary},
+		},
+		"SecondaryWithSuffix": {
+			trigger: "Secondary:2021-02-06T10:00:00Z",
+			want:    want{kt: redismgmt.Secondary},
+		},
+		"Invalid": {
+			trigger: "2021-02-06T10:00:00Z",
+			want:    want{err: errors.New(errInvalidKeyRegenerationTrigger)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kt, err := ParseKeyRegenerationTrigger(tc.trigger)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseKeyRegenerationTrigger(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.kt, kt); diff != "" {
+				t.Errorf("ParseKeyRegenerationTrigger(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
+)
+
+// exportSASPermissions are the permissions Azure requires to export a cache
+// to a storage container.
+const exportSASPermissions = "rwl"
+
+const errNewContainerSAS = "cannot create storage container shared access signature"
+
+// NewExportParameters returns export parameters suitable for use with the
+// Azure API.
+func NewExportParameters(p v1beta1.RedisExportParameters, containerURL string) redis.ExportRDBParameters {
+	return redis.ExportRDBParameters{
+		Format:    p.Format,
+		Prefix:    azure.ToStringPtr(p.Prefix),
+		Container: azure.ToStringPtr(containerURL),
+	}
+}
+
+// NewContainerSASURL returns the URL of the supplied container, including a
+// shared access signature that allows Azure to write to it until the supplied
+// expiry time.
+func NewContainerSASURL(c StorageAccountCredentials, container string, expiry time.Time) (string, error) {
+	sas, err := azurestorage.NewContainerSAS(c.Name, c.Key, container, storagev1alpha3.SharedAccessSignature{Permissions: exportSASPermissions}, expiry)
+	if err != nil {
+		return "", errors.Wrap(err, errNewContainerSAS)
+	}
+	ep := c.BlobEndpoint
+	if ep == "" {
+		ep = fmt.Sprintf("https://%s.blob.core.windows.net/", c.Name)
+	}
+	return strings.TrimSuffix(ep, "/") + "/" + container + "?" + sas, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	redismgmt "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewExportParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.RedisExportParameters
+		url  string
+		want redismgmt.ExportRDBParameters
+	}{
+		"Successful": {
+			p:   v1beta1.RedisExportParameters{Prefix: "backup", Format: azure.ToStringPtr("RDB")},
+			url: "https://cool.blob.core.windows.net/exports?sig=secret",
+			want: redismgmt.ExportRDBParameters{
+				Prefix:    azure.ToStringPtr("backup"),
+				Format:    azure.ToStringPtr("RDB"),
+				Container: azure.ToStringPtr("https://cool.blob.core.windows.net/exports?sig=secret"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewExportParameters(tc.p, tc.url)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewExportParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewContainerSASURL(t *testing.T) {
+	expiry := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		c      StorageAccountCredentials
+		prefix string
+		err    bool
+	}{
+		"BlobEndpoint": {
+			c:      StorageAccountCredentials{Name: "cool", Key: "c2VjcmV0", BlobEndpoint: "https://cool.example.com/"},
+			prefix: "https://cool.example.com/exports?",
+		},
+		"DefaultBlobEndpoint": {
+			c:      StorageAccountCredentials{Name: "cool", Key: "c2VjcmV0"},
+			prefix: "https://cool.blob.core.windows.net/exports?",
+		},
+		"InvalidKey": {
+			c:   StorageAccountCredentials{Name: "cool", Key: "not base64!"},
+			err: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewContainerSASURL(tc.c, "exports", expiry)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Fatalf("NewContainerSASURL(...): -want error, +got error\n%s\n%v", diff, err)
+			}
+			if tc.err {
+				return
+			}
+			if !strings.HasPrefix(got, tc.prefix) {
+				t.Errorf("NewContainerSASURL(...): want prefix %q, got %q", tc.prefix, got)
+			}
+			q, err := url.ParseQuery(strings.TrimPrefix(got, tc.prefix))
+			if err != nil {
+				t.Fatalf("NewContainerSASURL(...): cannot parse signature: %v", err)
+			}
+			if diff := cmp.Diff(exportSASPermissions, q.Get("sp")); diff != "" {
+				t.Errorf("NewContainerSASURL(...): -want permissions, +got permissions\n%s", diff)
+			}
+		})
+	}
+}
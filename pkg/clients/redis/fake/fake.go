@@ -19,8 +19,9 @@ package fake
 import (
 	"context"
 
-	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis/redisapi"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
+	"github.com/Azure/go-autorest/autorest"
 )
 
 var _ redisapi.ClientAPI = &MockClient{}
@@ -34,6 +35,9 @@ type MockClient struct {
 	MockGet      func(ctx context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error)
 	MockListKeys func(ctx context.Context, resourceGroupName string, name string) (result redis.AccessKeys, err error)
 	MockUpdate   func(ctx context.Context, resourceGroupName string, name string, parameters redis.UpdateParameters) (result redis.ResourceType, err error)
+
+	MockRegenerateKey func(ctx context.Context, resourceGroupName string, name string, parameters redis.RegenerateKeyParameters) (result redis.AccessKeys, err error)
+	MockExportData    func(ctx context.Context, resourceGroupName string, name string, parameters redis.ExportRDBParameters) (result redis.ExportDataFuture, err error)
 }
 
 // Create calls the MockClient's MockCreate method.
@@ -46,6 +50,11 @@ func (c *MockClient) Delete(ctx context.Context, resourceGroupName string, name
 	return c.MockDelete(ctx, resourceGroupName, name)
 }
 
+// ExportData calls the MockClient's MockExportData method.
+func (c *MockClient) ExportData(ctx context.Context, resourceGroupName string, name string, parameters redis.ExportRDBParameters) (result redis.ExportDataFuture, err error) {
+	return c.MockExportData(ctx, resourceGroupName, name, parameters)
+}
+
 // Get calls the MockClient's MockGet method.
 func (c *MockClient) Get(ctx context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error) {
 	return c.MockGet(ctx, resourceGroupName, name)
@@ -56,7 +65,90 @@ func (c *MockClient) ListKeys(ctx context.Context, resourceGroupName string, nam
 	return c.MockListKeys(ctx, resourceGroupName, name)
 }
 
+// RegenerateKey calls the MockClient's MockRegenerateKey method.
+func (c *MockClient) RegenerateKey(ctx context.Context, resourceGroupName string, name string, parameters redis.RegenerateKeyParameters) (result redis.AccessKeys, err error) {
+	return c.MockRegenerateKey(ctx, resourceGroupName, name, parameters)
+}
+
 // Update calls the MockClient's MockUpdate method.
 func (c *MockClient) Update(ctx context.Context, resourceGroupName string, name string, parameters redis.UpdateParameters) (result redis.ResourceType, err error) {
 	return c.MockUpdate(ctx, resourceGroupName, name, parameters)
 }
+
+var _ redisapi.FirewallRulesClientAPI = &MockFirewallRulesClient{}
+
+// MockFirewallRulesClient is a fake implementation of redis.FirewallRulesClient.
+type MockFirewallRulesClient struct {
+	redisapi.FirewallRulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, cacheName string, ruleName string, parameters redis.FirewallRuleCreateParameters) (result redis.FirewallRule, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, cacheName string, ruleName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, cacheName string, ruleName string) (result redis.FirewallRule, err error)
+}
+
+// CreateOrUpdate calls the MockFirewallRulesClient's MockCreateOrUpdate method.
+func (c *MockFirewallRulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, cacheName string, ruleName string, parameters redis.FirewallRuleCreateParameters) (result redis.FirewallRule, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, cacheName, ruleName, parameters)
+}
+
+// Delete calls the MockFirewallRulesClient's MockDelete method.
+func (c *MockFirewallRulesClient) Delete(ctx context.Context, resourceGroupName string, cacheName string, ruleName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, cacheName, ruleName)
+}
+
+// Get calls the MockFirewallRulesClient's MockGet method.
+func (c *MockFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, cacheName string, ruleName string) (result redis.FirewallRule, err error) {
+	return c.MockGet(ctx, resourceGroupName, cacheName, ruleName)
+}
+
+var _ redisapi.PatchSchedulesClientAPI = &MockPatchSchedulesClient{}
+
+// MockPatchSchedulesClient is a fake implementation of redis.PatchSchedulesClient.
+type MockPatchSchedulesClient struct {
+	redisapi.PatchSchedulesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, name string, parameters redis.PatchSchedule) (result redis.PatchSchedule, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, name string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, name string) (result redis.PatchSchedule, err error)
+}
+
+// CreateOrUpdate calls the MockPatchSchedulesClient's MockCreateOrUpdate method.
+func (c *MockPatchSchedulesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, name string, parameters redis.PatchSchedule) (result redis.PatchSchedule, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, name, parameters)
+}
+
+// Delete calls the MockPatchSchedulesClient's MockDelete method.
+func (c *MockPatchSchedulesClient) Delete(ctx context.Context, resourceGroupName string, name string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, name)
+}
+
+// Get calls the MockPatchSchedulesClient's MockGet method.
+func (c *MockPatchSchedulesClient) Get(ctx context.Context, resourceGroupName string, name string) (result redis.PatchSchedule, err error) {
+	return c.MockGet(ctx, resourceGroupName, name)
+}
+
+var _ redisapi.LinkedServerClientAPI = &MockLinkedServerClient{}
+
+// MockLinkedServerClient is a fake implementation of redis.LinkedServerClient.
+type MockLinkedServerClient struct {
+	redisapi.LinkedServerClientAPI
+
+	MockCreate func(ctx context.Context, resourceGroupName string, name string, linkedServerName string, parameters redis.LinkedServerCreateParameters) (result redis.LinkedServerCreateFuture, err error)
+	MockDelete func(ctx context.Context, resourceGroupName string, name string, linkedServerName string) (result autorest.Response, err error)
+	MockGet    func(ctx context.Context, resourceGroupName string, name string, linkedServerName string) (result redis.LinkedServerWithProperties, err error)
+}
+
+// Create calls the MockLinkedServerClient's MockCreate method.
+func (c *MockLinkedServerClient) Create(ctx context.Context, resourceGroupName string, name string, linkedServerName string, parameters redis.LinkedServerCreateParameters) (result redis.LinkedServerCreateFuture, err error) {
+	return c.MockCreate(ctx, resourceGroupName, name, linkedServerName, parameters)
+}
+
+// Delete calls the MockLinkedServerClient's MockDelete method.
+func (c *MockLinkedServerClient) Delete(ctx context.Context, resourceGroupName string, name string, linkedServerName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, name, linkedServerName)
+}
+
+// Get calls the MockLinkedServerClient's MockGet method.
+func (c *MockLinkedServerClient) Get(ctx context.Context, resourceGroupName string, name string, linkedServerName string) (result redis.LinkedServerWithProperties, err error) {
+	return c.MockGet(ctx, resourceGroupName, name, linkedServerName)
+}
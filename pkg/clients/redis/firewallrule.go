@@ -0,0 +1,43 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// NewFirewallRuleParameters returns Redis firewall rule creation parameters
+// suitable for use with the Azure API.
+func NewFirewallRuleParameters(r *v1beta1.RedisFirewallRule) redis.FirewallRuleCreateParameters {
+	return redis.FirewallRuleCreateParameters{
+		FirewallRuleProperties: &redis.FirewallRuleProperties{
+			StartIP: azure.ToStringPtr(r.Spec.ForProvider.StartIP),
+			EndIP:   azure.ToStringPtr(r.Spec.ForProvider.EndIP),
+		},
+	}
+}
+
+// FirewallRuleIsUpToDate returns true if the supplied FirewallRule appears to
+// be up to date with the supplied RedisFirewallRule.
+func FirewallRuleIsUpToDate(kube *v1beta1.RedisFirewallRule, az redis.FirewallRule) bool {
+	up := NewFirewallRuleParameters(kube)
+	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+
+	redismgmt "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const (
+	startIP = "10.0.0.1"
+	endIP   = "10.0.0.10"
+)
+
+func firewallRule() *v1beta1.RedisFirewallRule {
+	return &v1beta1.RedisFirewallRule{
+		Spec: v1beta1.RedisFirewallRuleSpec{
+			ForProvider: v1beta1.RedisFirewallRuleParameters{
+				RedisFirewallRuleProperties: v1beta1.RedisFirewallRuleProperties{
+					StartIP: startIP,
+					EndIP:   endIP,
+				},
+			},
+		},
+	}
+}
+
+func TestNewFirewallRuleParameters(t *testing.T) {
+	want := redismgmt.FirewallRuleCreateParameters{
+		FirewallRuleProperties: &redismgmt.FirewallRuleProperties{
+			StartIP: azure.ToStringPtr(startIP),
+			EndIP:   azure.ToStringPtr(endIP),
+		},
+	}
+	got := NewFirewallRuleParameters(firewallRule())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewFirewallRuleParameters(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestFirewallRuleIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		az   redismgmt.FirewallRule
+		want bool
+	}{
+		"UpToDate": {
+			az: redismgmt.FirewallRule{
+				FirewallRuleProperties: &redismgmt.FirewallRuleProperties{
+					StartIP: azure.ToStringPtr(startIP),
+					EndIP:   azure.ToStringPtr(endIP),
+				},
+			},
+			want: true,
+		},
+		"EndIPDiffers": {
+			az: redismgmt.FirewallRule{
+				FirewallRuleProperties: &redismgmt.FirewallRuleProperties{
+					StartIP: azure.ToStringPtr(startIP),
+					EndIP:   azure.ToStringPtr(startIP),
+				},
+			},
+			want: false,
+		},
+		"NoProperties": {
+			az:   redismgmt.FirewallRule{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FirewallRuleIsUpToDate(firewallRule(), tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FirewallRuleIsUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	autorestazure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// Linked server states
+const (
+	ProvisioningStateLinking   = string(redis.Linking)
+	ProvisioningStateUnlinking = string(redis.Unlinking)
+)
+
+const errParseLinkedRedisCacheID = "cannot parse linked Redis cache ID"
+
+// ParseLinkedRedisCacheID returns the resource group and the name of the
+// linked Redis cache of the supplied parameters. Azure requires the name of a
+// linked server to be the name of the linked Redis cache.
+func ParseLinkedRedisCacheID(p v1beta1.RedisLinkedServerParameters) (resourceGroup, name string, err error) {
+	r, err := autorestazure.ParseResourceID(p.LinkedRedisCacheID)
+	if err != nil {
+		return "", "", errors.Wrap(err, errParseLinkedRedisCacheID)
+	}
+	return r.ResourceGroup, r.ResourceName, nil
+}
+
+// NewLinkedServerCreateParameters returns linked server creation parameters
+// suitable for use with the Azure API. The location of the supplied linked
+// Redis cache is used unless one is specified.
+func NewLinkedServerCreateParameters(p v1beta1.RedisLinkedServerParameters, linked redis.ResourceType) redis.LinkedServerCreateParameters {
+	location := p.LinkedRedisCacheLocation
+	if location == nil {
+		location = linked.Location
+	}
+	return redis.LinkedServerCreateParameters{
+		LinkedServerCreateProperties: &redis.LinkedServerCreateProperties{
+			LinkedRedisCacheID:       azure.ToStringPtr(p.LinkedRedisCacheID),
+			LinkedRedisCacheLocation: location,
+			ServerRole:               redis.ReplicationRole(p.ServerRole),
+		},
+	}
+}
+
+// GenerateLinkedServerObservation produces a RedisLinkedServerObservation
+// object from the redis.LinkedServerWithProperties received from Azure.
+func GenerateLinkedServerObservation(az redis.LinkedServerWithProperties) v1beta1.RedisLinkedServerObservation {
+	o := v1beta1.RedisLinkedServerObservation{
+		ID:   azure.ToString(az.ID),
+		Name: azure.ToString(az.Name),
+	}
+	if az.LinkedServerProperties == nil {
+		return o
+	}
+	o.ProvisioningState = azure.ToString(az.ProvisioningState)
+	o.LinkedRedisCacheID = azure.ToString(az.LinkedRedisCacheID)
+	o.LinkedRedisCacheLocation = azure.ToString(az.LinkedRedisCacheLocation)
+	o.ServerRole = string(az.ServerRole)
+	return o
+}
+
+// LateInitializeLinkedServer fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeLinkedServer(spec *v1beta1.RedisLinkedServerParameters, az redis.LinkedServerWithProperties) {
+	if az.LinkedServerProperties == nil {
+		return
+	}
+	spec.LinkedRedisCacheLocation = azure.LateInitializeStringPtrFromPtr(spec.LinkedRedisCacheLocation, az.LinkedRedisCacheLocation)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+
+	redismgmt "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const (
+	linkedGroup = "linked-rg"
+	linkedName  = "linked-cache"
+	linkedID    = "/subscriptions/sub/resourceGroups/" + linkedGroup + "/providers/Microsoft.Cache/Redis/" + linkedName
+)
+
+func TestParseLinkedRedisCacheID(t *testing.T) {
+	type want struct {
+		rg   string
+		name string
+		err  bool
+	}
+	cases := map[string]struct {
+		id   string
+		want want
+	}{
+		"Valid": {
+			id:   linkedID,
+			want: want{rg: linkedGroup, name: linkedName},
+		},
+		"Invalid": {
+			id:   "linked-cache",
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rg, n, err := ParseLinkedRedisCacheID(v1beta1.RedisLinkedServerParameters{LinkedRedisCacheID: tc.id})
+			if diff := cmp.Diff(tc.want, want{rg: rg, name: n, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ParseLinkedRedisCacheID(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewLinkedServerCreateParameters(t *testing.T) {
+	cases := map[string]struct {
+		p      v1beta1.RedisLinkedServerParameters
+		linked redismgmt.ResourceType
+		want   redismgmt.LinkedServerCreateParameters
+	}{
+		"LocationSpecified": {
+			p: v1beta1.RedisLinkedServerParameters{
+				LinkedRedisCacheID:       linkedID,
+				LinkedRedisCacheLocation: azure.ToStringPtr("westeurope"),
+				ServerRole:               v1beta1.ReplicationRoleSecondary,
+			},
+			linked: redismgmt.ResourceType{Location: azure.ToStringPtr("northeurope")},
+			want: redismgmt.LinkedServerCreateParameters{
+				LinkedServerCreateProperties: &redismgmt.LinkedServerCreateProperties{
+					LinkedRedisCacheID:       azure.ToStringPtr(linkedID),
+					LinkedRedisCacheLocation: azure.ToStringPtr("westeurope"),
+					ServerRole:               redismgmt.ReplicationRoleSecondary,
+				},
+			},
+		},
+		"LocationDefaulted": {
+			p: v1beta1.RedisLinkedServerParameters{
+				LinkedRedisCacheID: linkedID,
+				ServerRole:         v1beta1.ReplicationRoleSecondary,
+			},
+			linked: redismgmt.ResourceType{Location: azure.ToStringPtr("northeurope")},
+			want: redismgmt.LinkedServerCreateParameters{
+				LinkedServerCreateProperties: &redismgmt.LinkedServerCreateProperties{
+					LinkedRedisCacheID:       azure.ToStringPtr(linkedID),
+					LinkedRedisCacheLocation: azure.ToStringPtr("northeurope"),
+					ServerRole:               redismgmt.ReplicationRoleSecondary,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewLinkedServerCreateParameters(tc.p, tc.linked)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewLinkedServerCreateParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLinkedServerObservation(t *testing.T) {
+	cases := map[string]struct {
+		az   redismgmt.LinkedServerWithProperties
+		want v1beta1.RedisLinkedServerObservation
+	}{
+		"NoProperties": {
+			az:   redismgmt.LinkedServerWithProperties{ID: azure.ToStringPtr(resourceID), Name: azure.ToStringPtr(linkedName)},
+			want: v1beta1.RedisLinkedServerObservation{ID: resourceID, Name: linkedName},
+		},
+		"Full": {
+			az: redismgmt.LinkedServerWithProperties{
+				ID:   azure.ToStringPtr(resourceID),
+				Name: azure.ToStringPtr(linkedName),
+				LinkedServerProperties: &redismgmt.LinkedServerProperties{
+					ProvisioningState:        azure.ToStringPtr(ProvisioningStateSucceeded),
+					LinkedRedisCacheID:       azure.ToStringPtr(linkedID),
+					LinkedRedisCacheLocation: azure.ToStringPtr("westeurope"),
+					ServerRole:               redismgmt.ReplicationRoleSecondary,
+				},
+			},
+			want: v1beta1.RedisLinkedServerObservation{
+				ID:                       resourceID,
+				Name:                     linkedName,
+				ProvisioningState:        ProvisioningStateSucceeded,
+				LinkedRedisCacheID:       linkedID,
+				LinkedRedisCacheLocation: "westeurope",
+				ServerRole:               v1beta1.ReplicationRoleSecondary,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLinkedServerObservation(tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateLinkedServerObservation(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// NewPatchSchedule returns a Redis patch schedule suitable for use with the
+// Azure API.
+func NewPatchSchedule(spec v1beta1.RedisPatchScheduleParameters) redis.PatchSchedule {
+	entries := make([]redis.ScheduleEntry, len(spec.ScheduleEntries))
+	for i, e := range spec.ScheduleEntries {
+		entries[i] = redis.ScheduleEntry{
+			DayOfWeek:         redis.DayOfWeek(e.DayOfWeek),
+			StartHourUtc:      azure.ToInt32(e.StartHourUTC),
+			MaintenanceWindow: e.MaintenanceWindow,
+		}
+	}
+	return redis.PatchSchedule{
+		ScheduleEntries: &redis.ScheduleEntries{ScheduleEntries: &entries},
+	}
+}
+
+// PatchScheduleIsUpToDate returns true if the schedule entries of the supplied
+// spec match those of the supplied Azure patch schedule, regardless of order.
+func PatchScheduleIsUpToDate(spec v1beta1.RedisPatchScheduleParameters, az redis.PatchSchedule) bool {
+	var observed []redis.ScheduleEntry
+	if az.ScheduleEntries != nil && az.ScheduleEntries.ScheduleEntries != nil {
+		observed = *az.ScheduleEntries.ScheduleEntries
+	}
+	desired := *NewPatchSchedule(spec).ScheduleEntries.ScheduleEntries
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(i, j redis.ScheduleEntry) bool {
+			if i.DayOfWeek != j.DayOfWeek {
+				return i.DayOfWeek < j.DayOfWeek
+			}
+			return azure.ToInt(i.StartHourUtc) < azure.ToInt(j.StartHourUtc)
+		}))
+}
+
+// LateInitializePatchSchedule fills the schedule entries that user did not
+// fill with their corresponding value in the Azure, if there is any. Entries
+// are matched by their position in the schedule.
+func LateInitializePatchSchedule(spec *v1beta1.RedisPatchScheduleParameters, az redis.PatchSchedule) {
+	if az.ScheduleEntries == nil || az.ScheduleEntries.ScheduleEntries == nil {
+		return
+	}
+	observed := *az.ScheduleEntries.ScheduleEntries
+	if len(spec.ScheduleEntries) == 0 {
+		spec.ScheduleEntries = make([]v1beta1.ScheduleEntry, len(observed))
+		for i, e := range observed {
+			spec.ScheduleEntries[i] = v1beta1.ScheduleEntry{DayOfWeek: string(e.DayOfWeek)}
+		}
+	}
+	for i := range spec.ScheduleEntries {
+		if i >= len(observed) || spec.ScheduleEntries[i].DayOfWeek != string(observed[i].DayOfWeek) {
+			continue
+		}
+		spec.ScheduleEntries[i].StartHourUTC = azure.LateInitializeIntPtrFromInt32Ptr(spec.ScheduleEntries[i].StartHourUTC, observed[i].StartHourUtc)
+		spec.ScheduleEntries[i].MaintenanceWindow = azure.LateInitializeStringPtrFromPtr(spec.ScheduleEntries[i].MaintenanceWindow, observed[i].MaintenanceWindow)
+	}
+}
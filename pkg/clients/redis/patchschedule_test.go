@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+
+	redismgmt "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+var (
+	startHour         = 3
+	maintenanceWindow = "PT5H"
+)
+
+func azureScheduleEntries(e ...redismgmt.ScheduleEntry) redismgmt.PatchSchedule {
+	return redismgmt.PatchSchedule{ScheduleEntries: &redismgmt.ScheduleEntries{ScheduleEntries: &e}}
+}
+
+func TestNewPatchSchedule(t *testing.T) {
+	cases := map[string]struct {
+		spec v1beta1.RedisPatchScheduleParameters
+		want redismgmt.PatchSchedule
+	}{
+		"NoEntries": {
+			spec: v1beta1.RedisPatchScheduleParameters{},
+			want: azureScheduleEntries([]redismgmt.ScheduleEntry{}...),
+		},
+		"Entries": {
+			spec: v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{
+					{DayOfWeek: "Saturday", StartHourUTC: &startHour, MaintenanceWindow: &maintenanceWindow},
+					{DayOfWeek: "Sunday"},
+				},
+			},
+			want: azureScheduleEntries(
+				redismgmt.ScheduleEntry{DayOfWeek: redismgmt.Saturday, StartHourUtc: azure.ToInt32Ptr(startHour), MaintenanceWindow: &maintenanceWindow},
+				redismgmt.ScheduleEntry{DayOfWeek: redismgmt.Sunday},
+			),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewPatchSchedule(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewPatchSchedule(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPatchScheduleIsUpToDate(t *testing.T) {
+	saturday := v1beta1.ScheduleEntry{DayOfWeek: "Saturday", StartHourUTC: &startHour, MaintenanceWindow: &maintenanceWindow}
+	sunday := v1beta1.ScheduleEntry{DayOfWeek: "Sunday", StartHourUTC: &startHour, MaintenanceWindow: &maintenanceWindow}
+	azSaturday := redismgmt.ScheduleEntry{DayOfWeek: redismgmt.Saturday, StartHourUtc: azure.ToInt32Ptr(startHour), MaintenanceWindow: &maintenanceWindow}
+	azSunday := redismgmt.ScheduleEntry{DayOfWeek: redismgmt.Sunday, StartHourUtc: azure.ToInt32Ptr(startHour), MaintenanceWindow: &maintenanceWindow}
+
+	cases := map[string]struct {
+		spec v1beta1.RedisPatchScheduleParameters
+		az   redismgmt.PatchSchedule
+		want bool
+	}{
+		"UpToDate": {
+			spec: v1beta1.RedisPatchScheduleParameters{ScheduleEntries: []v1beta1.ScheduleEntry{saturday, sunday}},
+			az:   azureScheduleEntries(azSaturday, azSunday),
+			want: true,
+		},
+		"UpToDateDifferentOrder": {
+			spec: v1beta1.RedisPatchScheduleParameters{ScheduleEntries: []v1beta1.ScheduleEntry{saturday, sunday}},
+			az:   azureScheduleEntries(azSunday, azSaturday),
+			want: true,
+		},
+		"UpToDateEmpty": {
+			spec: v1beta1.RedisPatchScheduleParameters{},
+			az:   redismgmt.PatchSchedule{},
+			want: true,
+		},
+		"EntryMissing": {
+			spec: v1beta1.RedisPatchScheduleParameters{ScheduleEntries: []v1beta1.ScheduleEntry{saturday, sunday}},
+			az:   azureScheduleEntries(azSaturday),
+			want: false,
+		},
+		"EntryDiffers": {
+			spec: v1beta1.RedisPatchScheduleParameters{ScheduleEntries: []v1beta1.ScheduleEntry{saturday}},
+			az:   azureScheduleEntries(azSunday),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PatchScheduleIsUpToDate(tc.spec, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("PatchScheduleIsUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializePatchSchedule(t *testing.T) {
+	otherHour := 10
+	az := azureScheduleEntries(
+		redismgmt.ScheduleEntry{DayOfWeek: redismgmt.Saturday, StartHourUtc: azure.ToInt32Ptr(startHour), MaintenanceWindow: &maintenanceWindow},
+	)
+
+	cases := map[string]struct {
+		spec *v1beta1.RedisPatchScheduleParameters
+		az   redismgmt.PatchSchedule
+		want *v1beta1.RedisPatchScheduleParameters
+	}{
+		"NothingObserved": {
+			spec: &v1beta1.RedisPatchScheduleParameters{},
+			az:   redismgmt.PatchSchedule{},
+			want: &v1beta1.RedisPatchScheduleParameters{},
+		},
+		"AllEntriesFilled": {
+			spec: &v1beta1.RedisPatchScheduleParameters{},
+			az:   az,
+			want: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Saturday", StartHourUTC: &startHour, MaintenanceWindow: &maintenanceWindow}},
+			},
+		},
+		"MissingFieldsFilled": {
+			spec: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Saturday"}},
+			},
+			az: az,
+			want: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Saturday", StartHourUTC: &startHour, MaintenanceWindow: &maintenanceWindow}},
+			},
+		},
+		"SpecifiedFieldsKept": {
+			spec: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Saturday", StartHourUTC: &otherHour}},
+			},
+			az: az,
+			want: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Saturday", StartHourUTC: &otherHour, MaintenanceWindow: &maintenanceWindow}},
+			},
+		},
+		"DifferentDayNotFilled": {
+			spec: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Sunday"}},
+			},
+			az: az,
+			want: &v1beta1.RedisPatchScheduleParameters{
+				ScheduleEntries: []v1beta1.ScheduleEntry{{DayOfWeek: "Sunday"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePatchSchedule(tc.spec, tc.az)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializePatchSchedule(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
+)
+
+// RedisConfiguration keys of the persistence settings.
+const (
+	ConfigRDBBackupEnabled            = "rdb-backup-enabled"
+	ConfigRDBBackupFrequency          = "rdb-backup-frequency"
+	ConfigRDBBackupMaxSnapshotCount   = "rdb-backup-max-snapshot-count"
+	ConfigRDBStorageConnectionString  = "rdb-storage-connection-string"
+	ConfigAOFBackupEnabled            = "aof-backup-enabled"
+	ConfigAOFStorageConnectionString0 = "aof-storage-connection-string-0"
+	ConfigAOFStorageConnectionString1 = "aof-storage-connection-string-1"
+)
+
+const (
+	errPersistenceRequiresPremium      = "persistence is only supported by Premium caches"
+	errGetStorageAccount               = "cannot get storage account"
+	errGetStorageAccountSecret         = "cannot get storage account connection secret"
+	errStorageAccountSecretNil         = "storage account does not have a connection secret"
+	errStorageAccountConnectionMissing = "storage account connection secret does not contain a connection string"
+)
+
+// persistenceResets are the values the RedisConfiguration keys that enable
+// persistence are reset to once they are removed from the spec. Removing the
+// persistence settings of a cache thereby disables its persistence, while
+// caches that never applied them are left alone.
+var persistenceResets = map[string]string{
+	ConfigRDBBackupEnabled: strconv.FormatBool(false),
+	ConfigAOFBackupEnabled: strconv.FormatBool(false),
+}
+
+// PersistenceConnectionStrings are the storage connection strings a Redis
+// cache persists its data with.
+type PersistenceConnectionStrings struct {
+	RDB          string
+	AOF          string
+	AOFSecondary string
+}
+
+// Hash returns a digest of the supplied connection strings, or an empty string
+// if there are none. The connection strings embed the randomly generated keys
+// of their storage accounts, so the digest does not need to be salted.
+func (cs PersistenceConnectionStrings) Hash() string {
+	if cs == (PersistenceConnectionStrings{}) {
+		return ""
+	}
+	h := sha256.Sum256([]byte(strings.Join([]string{cs.RDB, cs.AOF, cs.AOFSecondary}, "\n")))
+	return hex.EncodeToString(h[:])
+}
+
+// ValidatePersistence returns an error if the supplied parameters configure
+// persistence for a cache that does not support it.
+func ValidatePersistence(spec v1beta1.RedisParameters) error {
+	if spec.Persistence != nil && !strings.EqualFold(spec.SKU.Name, string(redis.Premium)) {
+		return errors.New(errPersistenceRequiresPremium)
+	}
+	return nil
+}
+
+// WithPersistence returns a copy of the supplied parameters whose
+// RedisConfiguration applies their persistence settings. Connection strings
+// are only included if they are supplied; Azure does not report them, so they
+// are omitted when checking whether the cache is up to date.
+func WithPersistence(spec v1beta1.RedisParameters, cs PersistenceConnectionStrings) v1beta1.RedisParameters {
+	p := spec.Persistence
+	if p == nil {
+		return spec
+	}
+	cfg := make(map[string]string, len(spec.RedisConfiguration)+7)
+	for k, v := range spec.RedisConfiguration {
+		cfg[k] = v
+	}
+	cfg[ConfigRDBBackupEnabled] = strconv.FormatBool(p.RDB != nil)
+	if p.RDB != nil {
+		cfg[ConfigRDBBackupFrequency] = strconv.Itoa(p.RDB.BackupFrequency)
+		if p.RDB.MaxSnapshotCount != nil {
+			cfg[ConfigRDBBackupMaxSnapshotCount] = strconv.Itoa(*p.RDB.MaxSnapshotCount)
+		}
+		setIfNotEmpty(cfg, ConfigRDBStorageConnectionString, cs.RDB)
+	}
+	cfg[ConfigAOFBackupEnabled] = strconv.FormatBool(p.AOF != nil)
+	if p.AOF != nil {
+		setIfNotEmpty(cfg, ConfigAOFStorageConnectionString0, cs.AOF)
+		setIfNotEmpty(cfg, ConfigAOFStorageConnectionString1, cs.AOFSecondary)
+	}
+	spec.RedisConfiguration = cfg
+	return spec
+}
+
+func setIfNotEmpty(m map[string]string, k, v string) {
+	if v != "" {
+		m[k] = v
+	}
+}
+
+// StorageAccountCredentials are the credentials a storage Account publishes
+// to its connection secret.
+type StorageAccountCredentials struct {
+	Name             string
+	Key              string
+	BlobEndpoint     string
+	ConnectionString string
+}
+
+// GetStorageAccountCredentials returns the credentials the storage Account
+// with the supplied name published to its connection secret.
+func GetStorageAccountCredentials(ctx context.Context, kube client.Reader, name string) (StorageAccountCredentials, error) {
+	a := &storagev1alpha3.Account{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, a); err != nil {
+		return StorageAccountCredentials{}, errors.Wrap(err, errGetStorageAccount)
+	}
+	ref := a.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return StorageAccountCredentials{}, errors.New(errStorageAccountSecretNil)
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return StorageAccountCredentials{}, errors.Wrap(err, errGetStorageAccountSecret)
+	}
+	c := StorageAccountCredentials{
+		Name:             string(s.Data[xpv1.ResourceCredentialsSecretUserKey]),
+		Key:              string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey]),
+		BlobEndpoint:     string(s.Data[azurestorage.ConnectionSecretBlobEndpointKey]),
+		ConnectionString: string(s.Data[azurestorage.ConnectionSecretConnectionStringKey]),
+	}
+	if c.ConnectionString == "" {
+		return StorageAccountCredentials{}, errors.New(errStorageAccountConnectionMissing)
+	}
+	return c, nil
+}
+
+// GetPersistenceConnectionStrings returns the connection strings of the
+// storage Accounts referenced by the supplied persistence settings.
+func GetPersistenceConnectionStrings(ctx context.Context, kube client.Reader, p *v1beta1.RedisPersistence) (PersistenceConnectionStrings, error) {
+	cs := PersistenceConnectionStrings{}
+	if p == nil {
+		return cs, nil
+	}
+	for _, r := range []struct {
+		ref *xpv1.Reference
+		out *string
+	}{
+		{ref: rdbStorageAccountRef(p), out: &cs.RDB},
+		{ref: aofStorageAccountRef(p), out: &cs.AOF},
+		{ref: aofSecondaryStorageAccountRef(p), out: &cs.AOFSecondary},
+	} {
+		if r.ref == nil {
+			continue
+		}
+		c, err := GetStorageAccountCredentials(ctx, kube, r.ref.Name)
+		if err != nil {
+			return PersistenceConnectionStrings{}, err
+		}
+		*r.out = c.ConnectionString
+	}
+	return cs, nil
+}
+
+func rdbStorageAccountRef(p *v1beta1.RedisPersistence) *xpv1.Reference {
+	if p.RDB == nil {
+		return nil
+	}
+	return &p.RDB.StorageAccountRef
+}
+
+func aofStorageAccountRef(p *v1beta1.RedisPersistence) *xpv1.Reference {
+	if p.AOF == nil {
+		return nil
+	}
+	return &p.AOF.StorageAccountRef
+}
+
+func aofSecondaryStorageAccountRef(p *v1beta1.RedisPersistence) *xpv1.Reference {
+	if p.AOF == nil {
+		return nil
+	}
+	return p.AOF.SecondaryStorageAccountRef
+}
+
+// UpdatesPersistence returns true if the supplied patch changes any
+// persistence setting.
+func UpdatesPersistence(patch redis.UpdateParameters) bool {
+	if patch.UpdateProperties == nil {
+		return false
+	}
+	for k := range patch.RedisConfiguration {
+		if strings.HasPrefix(k, "rdb-") || strings.HasPrefix(k, "aof-") {
+			return true
+		}
+	}
+	return false
+}
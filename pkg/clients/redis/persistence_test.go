@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"testing"
+
+	redismgmt "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
+)
+
+const (
+	storageAccount          = "cool-account"
+	storageConnectionString = "DefaultEndpointsProtocol=https;AccountName=cool;AccountKey=secret"
+)
+
+func TestValidatePersistence(t *testing.T) {
+	cases := map[string]struct {
+		spec v1beta1.RedisParameters
+		want error
+	}{
+		"NoPersistence": {
+			spec: v1beta1.RedisParameters{SKU: v1beta1.SKU{Name: "Basic"}},
+		},
+		"Premium": {
+			spec: v1beta1.RedisParameters{SKU: v1beta1.SKU{Name: "Premium"}, Persistence: &v1beta1.RedisPersistence{}},
+		},
+		"NotPremium": {
+			spec: v1beta1.RedisParameters{SKU: v1beta1.SKU{Name: "Standard"}, Persistence: &v1beta1.RedisPersistence{}},
+			want: errors.New(errPersistenceRequiresPremium),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidatePersistence(tc.spec)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidatePersistence(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithPersistence(t *testing.T) {
+	maxSnapshotCount := 1
+	cases := map[string]struct {
+		spec v1beta1.RedisParameters
+		cs   PersistenceConnectionStrings
+		want map[string]string
+	}{
+		"NoPersistence": {
+			spec: v1beta1.RedisParameters{RedisConfiguration: redisConfiguration},
+			want: redisConfiguration,
+		},
+		"PremiumNoPersistence": {
+			spec: v1beta1.RedisParameters{
+				SKU:                v1beta1.SKU{Name: string(redismgmt.Premium)},
+				RedisConfiguration: map[string]string{"cool": "socool", ConfigAOFBackupEnabled: "true"},
+			},
+			want: map[string]string{
+				"cool":                 "socool",
+				ConfigAOFBackupEnabled: "true",
+			},
+		},
+		"Disabled": {
+			spec: v1beta1.RedisParameters{
+				RedisConfiguration: redisConfiguration,
+				Persistence:        &v1beta1.RedisPersistence{},
+			},
+			want: map[string]string{
+				"cool":                 "socool",
+				ConfigRDBBackupEnabled: "false",
+				ConfigAOFBackupEnabled: "false",
+			},
+		},
+		"WithoutConnectionStrings": {
+			spec: v1beta1.RedisParameters{
+				Persistence: &v1beta1.RedisPersistence{
+					RDB: &v1beta1.RDBPersistence{BackupFrequency: 60, MaxSnapshotCount: &maxSnapshotCount},
+					AOF: &v1beta1.AOFPersistence{},
+				},
+			},
+			want: map[string]string{
+				ConfigRDBBackupEnabled:          "true",
+				ConfigRDBBackupFrequency:        "60",
+				ConfigRDBBackupMaxSnapshotCount: "1",
+				ConfigAOFBackupEnabled:          "true",
+			},
+		},
+		"WithConnectionStrings": {
+			spec: v1beta1.RedisParameters{
+				RedisConfiguration: map[string]string{ConfigRDBBackupFrequency: "15"},
+				Persistence: &v1beta1.RedisPersistence{
+					RDB: &v1beta1.RDBPersistence{BackupFrequency: 60},
+					AOF: &v1beta1.AOFPersistence{},
+				},
+			},
+			cs: PersistenceConnectionStrings{RDB: "rdb", AOF: "aof", AOFSecondary: "aof2"},
+			want: map[string]string{
+				ConfigRDBBackupEnabled:            "true",
+				ConfigRDBBackupFrequency:          "60",
+				ConfigRDBStorageConnectionString:  "rdb",
+				ConfigAOFBackupEnabled:            "true",
+				ConfigAOFStorageConnectionString0: "aof",
+				ConfigAOFStorageConnectionString1: "aof2",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WithPersistence(tc.spec, tc.cs)
+			if diff := cmp.Diff(tc.want, got.RedisConfiguration); diff != "" {
+				t.Errorf("WithPersistence(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func storageAccountSecret(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *storagev1alpha3.Account:
+			o.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Namespace: "ns", Name: "secret"}
+		case *corev1.Secret:
+			o.Data = data
+		}
+		return nil
+	}
+}
+
+func TestPersistenceConnectionStringsHash(t *testing.T) {
+	rdb := PersistenceConnectionStrings{RDB: storageConnectionString}
+	rotated := PersistenceConnectionStrings{RDB: storageConnectionString + "rotated"}
+	aof := PersistenceConnectionStrings{AOF: storageConnectionString}
+
+	if got := (PersistenceConnectionStrings{}).Hash(); got != "" {
+		t.Errorf("Hash(): want empty digest without connection strings, got %q", got)
+	}
+	if rdb.Hash() != rdb.Hash() {
+		t.Errorf("Hash(): want the same digest for the same connection strings")
+	}
+	if rdb.Hash() == rotated.Hash() {
+		t.Errorf("Hash(): want a different digest once a connection string changes")
+	}
+	if rdb.Hash() == aof.Hash() {
+		t.Errorf("Hash(): want a different digest once a connection string moves")
+	}
+}
+
+func TestGetStorageAccountCredentials(t *testing.T) {
+	errBoom := errors.New("boom")
+	type want struct {
+		c   StorageAccountCredentials
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{MockGet: storageAccountSecret(map[string][]byte{
+				xpv1.ResourceCredentialsSecretUserKey:            []byte("cool"),
+				xpv1.ResourceCredentialsSecretPasswordKey:        []byte("secret"),
+				azurestorage.ConnectionSecretBlobEndpointKey:     []byte("https://cool.blob.core.windows.net/"),
+				azurestorage.ConnectionSecretConnectionStringKey: []byte(storageConnectionString),
+			})},
+			want: want{c: StorageAccountCredentials{
+				Name:             "cool",
+				Key:              "secret",
+				BlobEndpoint:     "https://cool.blob.core.windows.net/",
+				ConnectionString: storageConnectionString,
+			}},
+		},
+		"GetAccountFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errors.Wrap(errBoom, errGetStorageAccount)},
+		},
+		"NoConnectionSecret": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			want: want{err: errors.New(errStorageAccountSecretNil)},
+		},
+		"NoConnectionString": {
+			kube: &test.MockClient{MockGet: storageAccountSecret(nil)},
+			want: want{err: errors.New(errStorageAccountConnectionMissing)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetStorageAccountCredentials(context.Background(), tc.kube, storageAccount)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetStorageAccountCredentials(...): -want error, +got error\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("GetStorageAccountCredentials(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdatesPersistence(t *testing.T) {
+	cases := map[string]struct {
+		patch redismgmt.UpdateParameters
+		want  bool
+	}{
+		"NoProperties": {
+			patch: redismgmt.UpdateParameters{},
+		},
+		"OtherSettings": {
+			patch: redismgmt.UpdateParameters{UpdateProperties: &redismgmt.UpdateProperties{
+				RedisConfiguration: azure.ToStringPtrMap(redisConfiguration),
+			}},
+		},
+		"PersistenceSettings": {
+			patch: redismgmt.UpdateParameters{UpdateProperties: &redismgmt.UpdateProperties{
+				RedisConfiguration: azure.ToStringPtrMap(map[string]string{ConfigRDBBackupFrequency: "60"}),
+			}},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := UpdatesPersistence(tc.patch); got != tc.want {
+				t.Errorf("UpdatesPersistence(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
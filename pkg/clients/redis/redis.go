@@ -18,8 +18,10 @@ package redis
 
 import (
 	"reflect"
+	"sort"
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/go-autorest/autorest/to"
 
 	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
@@ -54,15 +56,14 @@ func NewCreateParameters(cr *v1beta1.Redis) redis.CreateParameters {
 }
 
 // NewUpdateParameters returns a redis.UpdateParameters object only with changed
-// fields.
-// TODO(muvaf): Removal of an entry from the maps such as RedisConfiguration and
-// TenantSettings is not properly supported. The user has to give empty string
-// for deletion instead of just deleting the whole entry.
+// fields. RedisConfiguration and TenantSettings are diffed three-way: keys that
+// were last applied but are no longer in the spec are reset, while keys that
+// Azure populates itself are left alone.
 // NOTE(muvaf): This is barely a comparison function with almost identical if
 // statements which increase the cyclomatic complexity even though it's actually
 // easier to maintain all this in one function.
 // nolint:gocyclo
-func NewUpdateParameters(spec v1beta1.RedisParameters, state redis.ResourceType) redis.UpdateParameters {
+func NewUpdateParameters(spec v1beta1.RedisParameters, last v1beta1.RedisLastApplied, state redis.ResourceType) redis.UpdateParameters {
 	patch := redis.UpdateParameters{
 		Tags: azure.ToStringPtrMap(spec.Tags),
 		UpdateProperties: &redis.UpdateProperties{
@@ -79,7 +80,7 @@ func NewUpdateParameters(spec v1beta1.RedisParameters, state redis.ResourceType)
 	// are not that many, I wanted to go with if statements. Hopefully, we'll
 	// generate this code in the future.
 	for k, v := range state.Tags {
-		if patch.Tags[k] == v {
+		if d, ok := patch.Tags[k]; ok && azure.ToString(d) == azure.ToString(v) {
 			delete(patch.Tags, k)
 		}
 	}
@@ -92,34 +93,69 @@ func NewUpdateParameters(spec v1beta1.RedisParameters, state redis.ResourceType)
 	if reflect.DeepEqual(patch.Sku, state.Properties.Sku) {
 		patch.Sku = nil
 	}
-	for k, v := range state.RedisConfiguration {
-		if reflect.DeepEqual(patch.RedisConfiguration[k], v) {
-			delete(patch.RedisConfiguration, k)
-		}
-	}
-	if len(patch.RedisConfiguration) == 0 {
-		patch.RedisConfiguration = nil
-	}
+	patch.RedisConfiguration = patchSettings(spec.RedisConfiguration, last.RedisConfiguration, state.RedisConfiguration, persistenceResets)
 	if reflect.DeepEqual(patch.EnableNonSslPort, state.EnableNonSslPort) {
 		patch.EnableNonSslPort = nil
 	}
 	if reflect.DeepEqual(patch.ShardCount, state.ShardCount) {
 		patch.ShardCount = nil
 	}
-	for k, v := range state.TenantSettings {
-		if reflect.DeepEqual(patch.TenantSettings[k], v) {
-			delete(patch.TenantSettings, k)
+	patch.TenantSettings = patchSettings(spec.TenantSettings, last.TenantSettings, state.TenantSettings, nil)
+	if reflect.DeepEqual(patch.MinimumTLSVersion, state.MinimumTLSVersion) {
+		patch.MinimumTLSVersion = ""
+	}
+	return patch
+}
+
+// patchSettings returns the entries of desired that differ from observed, plus
+// a reset value for every last applied key that was removed from desired but
+// is still set in Azure. Keys are reset to their value in resets, or to an
+// empty value if they have none. Azure does not report keys with empty values,
+// so a missing key is considered equal to an empty one. Observed keys that were
+// never applied are populated by Azure and are therefore not considered.
+func patchSettings(desired map[string]string, last []string, observed map[string]*string, resets map[string]string) map[string]*string {
+	patch := map[string]*string{}
+	for k, v := range desired {
+		if azure.ToString(observed[k]) != v {
+			patch[k] = to.StringPtr(v)
 		}
 	}
-	if len(patch.TenantSettings) == 0 {
-		patch.TenantSettings = nil
+	for _, k := range last {
+		if _, ok := desired[k]; ok {
+			continue
+		}
+		if o := azure.ToString(observed[k]); o != "" && o != resets[k] {
+			patch[k] = to.StringPtr(resets[k])
+		}
 	}
-	if reflect.DeepEqual(patch.MinimumTLSVersion, state.MinimumTLSVersion) {
-		patch.MinimumTLSVersion = ""
+	if len(patch) == 0 {
+		return nil
 	}
 	return patch
 }
 
+// NewLastApplied returns the record of the settings that are applied to Azure
+// by the supplied spec, including the RedisConfiguration keys its persistence
+// settings are applied through.
+func NewLastApplied(spec v1beta1.RedisParameters) v1beta1.RedisLastApplied {
+	return v1beta1.RedisLastApplied{
+		RedisConfiguration: sortedKeys(WithPersistence(spec, PersistenceConnectionStrings{}).RedisConfiguration),
+		TenantSettings:     sortedKeys(spec.TenantSettings),
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // NewSKU returns a Redis resource SKU suitable for use with the Azure API.
 func NewSKU(s v1beta1.SKU) *redis.Sku {
 	return &redis.Sku{
@@ -132,11 +168,11 @@ func NewSKU(s v1beta1.SKU) *redis.Sku {
 // NeedsUpdate returns true if the supplied spec object differs from the
 // supplied Azure resource. It considers only fields that can be modified in
 // place without deleting and recreating the instance.
-func NeedsUpdate(spec v1beta1.RedisParameters, az redis.ResourceType) bool {
+func NeedsUpdate(spec v1beta1.RedisParameters, last v1beta1.RedisLastApplied, az redis.ResourceType) bool {
 	if az.Properties == nil {
 		return true
 	}
-	patch := NewUpdateParameters(spec, az)
+	patch := NewUpdateParameters(spec, last, az)
 	empty := redis.UpdateParameters{UpdateProperties: &redis.UpdateProperties{}}
 	return !reflect.DeepEqual(empty, patch)
 }
@@ -166,7 +202,9 @@ func GenerateObservation(az redis.ResourceType) v1beta1.RedisObservation {
 }
 
 // LateInitialize fills the spec values that user did not fill with their
-// corresponding value in the Azure, if there is any.
+// corresponding value in the Azure, if there is any. RedisConfiguration and
+// TenantSettings are not late initialized; the keys Azure populates itself are
+// ignored by NewUpdateParameters instead.
 func LateInitialize(spec *v1beta1.RedisParameters, az redis.ResourceType) {
 	spec.Zones = azure.LateInitializeStringValArrFromArrPtr(spec.Zones, az.Zones)
 	spec.Tags = azure.LateInitializeStringMap(spec.Tags, az.Tags)
@@ -175,10 +213,32 @@ func LateInitialize(spec *v1beta1.RedisParameters, az redis.ResourceType) {
 	}
 	spec.SubnetID = azure.LateInitializeStringPtrFromPtr(spec.SubnetID, az.Properties.SubnetID)
 	spec.StaticIP = azure.LateInitializeStringPtrFromPtr(spec.StaticIP, az.Properties.StaticIP)
-	spec.RedisConfiguration = azure.LateInitializeStringMap(spec.RedisConfiguration, az.Properties.RedisConfiguration)
+	spec.RedisConfiguration = lateInitializeRedisConfiguration(spec.RedisConfiguration, az.Properties.RedisConfiguration)
 	spec.EnableNonSSLPort = azure.LateInitializeBoolPtrFromPtr(spec.EnableNonSSLPort, az.Properties.EnableNonSslPort)
 	spec.TenantSettings = azure.LateInitializeStringMap(spec.TenantSettings, az.Properties.TenantSettings)
 	spec.ShardCount = azure.LateInitializeIntPtrFromInt32Ptr(spec.ShardCount, az.Properties.ShardCount)
 	minTLS := string(az.Properties.MinimumTLSVersion)
 	spec.MinimumTLSVersion = azure.LateInitializeStringPtrFromPtr(spec.MinimumTLSVersion, &minTLS)
 }
+
+// lateInitializeRedisConfiguration late-inits the RedisConfiguration of a
+// spec, leaving out the keys that are managed through its persistence
+// settings so that removing those still disables persistence.
+func lateInitializeRedisConfiguration(in map[string]string, from map[string]*string) map[string]string {
+	if in != nil || from == nil {
+		return in
+	}
+	cfg := to.StringMap(from)
+	for _, k := range []string{
+		ConfigRDBBackupEnabled,
+		ConfigRDBBackupFrequency,
+		ConfigRDBBackupMaxSnapshotCount,
+		ConfigRDBStorageConnectionString,
+		ConfigAOFBackupEnabled,
+		ConfigAOFStorageConnectionString0,
+		ConfigAOFStorageConnectionString1,
+	} {
+		delete(cfg, k)
+	}
+	return cfg
+}
@@ -122,6 +122,7 @@ func TestNewUpdateParameters(t *testing.T) {
 	cases := []struct {
 		name    string
 		spec    v1beta1.RedisParameters
+		last    v1beta1.RedisLastApplied
 		current redismgmt.ResourceType
 		want    redismgmt.UpdateParameters
 	}{
@@ -224,11 +225,73 @@ func TestNewUpdateParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ResetRemovedKeys",
+			spec: v1beta1.RedisParameters{
+				SKU: v1beta1.SKU{
+					Name:     skuName,
+					Family:   skuFamily,
+					Capacity: skuCapacity,
+				},
+				RedisConfiguration: redisConfiguration2,
+			},
+			last: v1beta1.RedisLastApplied{
+				RedisConfiguration: []string{"another", "removed"},
+				TenantSettings:     []string{"tenant"},
+			},
+			current: redismgmt.ResourceType{
+				Properties: &redismgmt.Properties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.SkuName(skuName),
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+					RedisConfiguration: azure.ToStringPtrMap(map[string]string{
+						"another":    "val",
+						"removed":    "val",
+						"maxclients": "1000",
+					}),
+					TenantSettings: azure.ToStringPtrMap(map[string]string{"tenant": "val"}),
+				},
+			},
+			want: redismgmt.UpdateParameters{
+				UpdateProperties: &redismgmt.UpdateProperties{
+					RedisConfiguration: azure.ToStringPtrMap(map[string]string{"removed": ""}),
+					TenantSettings:     azure.ToStringPtrMap(map[string]string{"tenant": ""}),
+				},
+			},
+		},
+		{
+			name: "RemovedKeysAlreadyReset",
+			spec: v1beta1.RedisParameters{
+				SKU: v1beta1.SKU{
+					Name:     skuName,
+					Family:   skuFamily,
+					Capacity: skuCapacity,
+				},
+			},
+			last: v1beta1.RedisLastApplied{
+				RedisConfiguration: []string{"removed"},
+			},
+			current: redismgmt.ResourceType{
+				Properties: &redismgmt.Properties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.SkuName(skuName),
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+					RedisConfiguration: azure.ToStringPtrMap(map[string]string{"maxclients": "1000"}),
+				},
+			},
+			want: redismgmt.UpdateParameters{
+				UpdateProperties: &redismgmt.UpdateProperties{},
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NewUpdateParameters(tc.spec, tc.current)
+			got := NewUpdateParameters(tc.spec, tc.last, tc.current)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("NewUpdateParameters(...): -want, +got\n%s", diff)
 			}
@@ -315,11 +378,81 @@ func TestNeedsUpdate(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "AzureDefaultsIgnored",
+			spec: v1beta1.RedisParameters{
+				SKU: v1beta1.SKU{
+					Name:     skuName,
+					Family:   skuFamily,
+					Capacity: skuCapacity,
+				},
+				RedisConfiguration: redisConfiguration,
+			},
+			az: redismgmt.ResourceType{
+				Properties: &redismgmt.Properties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.SkuName(skuName),
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+					RedisConfiguration: azure.ToStringPtrMap(map[string]string{
+						"cool":       "socool",
+						"maxclients": "1000",
+					}),
+					TenantSettings: azure.ToStringPtrMap(tenantSettings),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "PremiumWithoutPersistence",
+			spec: v1beta1.RedisParameters{
+				SKU: v1beta1.SKU{
+					Name:     string(redismgmt.Premium),
+					Family:   skuFamily,
+					Capacity: skuCapacity,
+				},
+				RedisConfiguration: redisConfiguration,
+			},
+			az: redismgmt.ResourceType{
+				Properties: &redismgmt.Properties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.Premium,
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+					RedisConfiguration: azure.ToStringPtrMap(redisConfiguration),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "EmptySettingNotReported",
+			spec: v1beta1.RedisParameters{
+				SKU: v1beta1.SKU{
+					Name:     skuName,
+					Family:   skuFamily,
+					Capacity: skuCapacity,
+				},
+				RedisConfiguration: map[string]string{"cool": "socool", "empty": ""},
+			},
+			az: redismgmt.ResourceType{
+				Properties: &redismgmt.Properties{
+					Sku: &redismgmt.Sku{
+						Name:     redismgmt.SkuName(skuName),
+						Family:   redismgmt.SkuFamily(skuFamily),
+						Capacity: azure.ToInt32Ptr(skuCapacity),
+					},
+					RedisConfiguration: azure.ToStringPtrMap(redisConfiguration),
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := NeedsUpdate(tc.spec, tc.az)
+			got := NeedsUpdate(tc.spec, v1beta1.RedisLastApplied{}, tc.az)
 			if got != tc.want {
 				t.Errorf("NeedsUpdate(...): want %t, got %t", tc.want, got)
 			}
@@ -431,14 +564,36 @@ func TestLateInitialize(t *testing.T) {
 					Tags:               tags,
 					SubnetID:           &subnetID,
 					StaticIP:           &staticIP,
-					EnableNonSSLPort:   &enableNonSSLPort,
 					RedisConfiguration: redisConfiguration,
+					EnableNonSSLPort:   &enableNonSSLPort,
 					TenantSettings:     tenantSettings,
 					ShardCount:         &shardCount,
 					MinimumTLSVersion:  &minTLSVersion,
 				},
 			},
 		},
+		"LateInitializeWithoutPersistence": {
+			args: args{
+				az: redismgmt.ResourceType{
+					Properties: &redismgmt.Properties{
+						MinimumTLSVersion: redismgmt.TLSVersion(minTLSVersion),
+						RedisConfiguration: map[string]*string{
+							"cool":                   azure.ToStringPtr("socool"),
+							ConfigRDBBackupEnabled:   azure.ToStringPtr("true"),
+							ConfigRDBBackupFrequency: azure.ToStringPtr("60"),
+							ConfigAOFBackupEnabled:   azure.ToStringPtr("false"),
+						},
+					},
+				},
+				spec: &v1beta1.RedisParameters{},
+			},
+			want: want{
+				spec: &v1beta1.RedisParameters{
+					RedisConfiguration: redisConfiguration,
+					MinimumTLSVersion:  &minTLSVersion,
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -449,3 +604,44 @@ func TestLateInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestNewLastApplied(t *testing.T) {
+	cases := map[string]struct {
+		spec v1beta1.RedisParameters
+		want v1beta1.RedisLastApplied
+	}{
+		"Empty": {
+			spec: v1beta1.RedisParameters{},
+			want: v1beta1.RedisLastApplied{},
+		},
+		"SortedKeys": {
+			spec: v1beta1.RedisParameters{
+				RedisConfiguration: map[string]string{"maxmemory-policy": "allkeys-lru", "maxmemory-delta": "10"},
+				TenantSettings:     tenantSettings,
+			},
+			want: v1beta1.RedisLastApplied{
+				RedisConfiguration: []string{"maxmemory-delta", "maxmemory-policy"},
+				TenantSettings:     []string{"tenant1"},
+			},
+		},
+		"Persistence": {
+			spec: v1beta1.RedisParameters{
+				RedisConfiguration: redisConfiguration,
+				Persistence: &v1beta1.RedisPersistence{
+					RDB: &v1beta1.RDBPersistence{BackupFrequency: 60},
+				},
+			},
+			want: v1beta1.RedisLastApplied{
+				RedisConfiguration: []string{ConfigAOFBackupEnabled, "cool", ConfigRDBBackupEnabled, ConfigRDBBackupFrequency},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewLastApplied(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewLastApplied(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	autorestazure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// ServiceNameRedis is the name Azure uses for the Redis service in subnet
+// delegations.
+const ServiceNameRedis = "Microsoft.Cache/redis"
+
+const (
+	errParseSubnetID       = "cannot parse subnet ID"
+	errFmtNotSubnetID      = "%s is not the ID of a virtual network subnet"
+	errFmtSubnetNotFound   = "subnet %s not found in virtual network %s"
+	errFmtLocationMismatch = "virtual network location %s does not match Redis location %s"
+	errFmtDelegatedSubnet  = "subnet %s is delegated to %s"
+)
+
+// ParseSubnetID returns the resource group, virtual network name and subnet
+// name of the supplied subnet ID.
+func ParseSubnetID(id string) (resourceGroup, virtualNetwork, subnet string, err error) {
+	r, err := autorestazure.ParseResourceID(id)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, errParseSubnetID)
+	}
+	// NOTE: ParseResourceID only returns the last segment of nested resource
+	// names, so we extract the virtual network name ourselves. A subnet ID
+	// looks like .../providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet
+	p := strings.Split(strings.Trim(id, "/"), "/")
+	if !strings.EqualFold(r.ResourceType, "virtualNetworks") || len(p) != 10 || !strings.EqualFold(p[8], "subnets") {
+		return "", "", "", errors.Errorf(errFmtNotSubnetID, id)
+	}
+	return r.ResourceGroup, p[7], r.ResourceName, nil
+}
+
+// ValidateSubnet returns an error if the named subnet of the supplied virtual
+// network cannot host a Redis cache in the supplied location, i.e. if the
+// virtual network is in another location or the subnet is delegated to a
+// service other than Redis.
+func ValidateSubnet(location string, vnet network.VirtualNetwork, subnet string) error {
+	if !EqualLocation(location, azure.ToString(vnet.Location)) {
+		return errors.Errorf(errFmtLocationMismatch, azure.ToString(vnet.Location), location)
+	}
+	var s *network.Subnet
+	if vnet.VirtualNetworkPropertiesFormat != nil && vnet.Subnets != nil {
+		for i := range *vnet.Subnets {
+			if strings.EqualFold(azure.ToString((*vnet.Subnets)[i].Name), subnet) {
+				s = &(*vnet.Subnets)[i]
+				break
+			}
+		}
+	}
+	if s == nil {
+		return errors.Errorf(errFmtSubnetNotFound, subnet, azure.ToString(vnet.Name))
+	}
+	if s.SubnetPropertiesFormat == nil || s.Delegations == nil {
+		return nil
+	}
+	for _, d := range *s.Delegations {
+		if d.ServiceDelegationPropertiesFormat == nil {
+			continue
+		}
+		if svc := azure.ToString(d.ServiceName); !strings.EqualFold(svc, ServiceNameRedis) {
+			return errors.Errorf(errFmtDelegatedSubnet, subnet, svc)
+		}
+	}
+	return nil
+}
+
+// EqualLocation returns true if the supplied Azure locations are the same.
+// Azure accepts both the display name (e.g. West US 2) and the name (e.g.
+// westus2) of a location but always reports the latter.
+func EqualLocation(a, b string) bool {
+	return normalizeLocation(a) == normalizeLocation(b)
+}
+
+func normalizeLocation(l string) string {
+	return strings.ToLower(strings.ReplaceAll(l, " ", ""))
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+const (
+	subnetGroup      = "network-rg"
+	vnetName         = "vnet"
+	subnetName       = "subnet"
+	subnetResourceID = "/subscriptions/sub/resourceGroups/" + subnetGroup + "/providers/Microsoft.Network/virtualNetworks/" + vnetName + "/subnets/" + subnetName
+)
+
+func TestParseSubnetID(t *testing.T) {
+	type want struct {
+		rg     string
+		vnet   string
+		subnet string
+		err    bool
+	}
+	cases := map[string]struct {
+		id   string
+		want want
+	}{
+		"Valid": {
+			id:   subnetResourceID,
+			want: want{rg: subnetGroup, vnet: vnetName, subnet: subnetName},
+		},
+		"Invalid": {
+			id:   subnetName,
+			want: want{err: true},
+		},
+		"NotASubnet": {
+			id:   "/subscriptions/sub/resourceGroups/" + subnetGroup + "/providers/Microsoft.Network/virtualNetworks/" + vnetName,
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rg, vnet, subnet, err := ParseSubnetID(tc.id)
+			got := want{rg: rg, vnet: vnet, subnet: subnet, err: err != nil}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ParseSubnetID(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func virtualNetwork(location string, delegations ...string) network.VirtualNetwork {
+	d := make([]network.Delegation, len(delegations))
+	for i, svc := range delegations {
+		d[i] = network.Delegation{ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{ServiceName: azure.ToStringPtr(svc)}}
+	}
+	return network.VirtualNetwork{
+		Name:     azure.ToStringPtr(vnetName),
+		Location: azure.ToStringPtr(location),
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			Subnets: &[]network.Subnet{
+				{Name: azure.ToStringPtr("other")},
+				{Name: azure.ToStringPtr(subnetName), SubnetPropertiesFormat: &network.SubnetPropertiesFormat{Delegations: &d}},
+			},
+		},
+	}
+}
+
+func TestValidateSubnet(t *testing.T) {
+	cases := map[string]struct {
+		location string
+		vnet     network.VirtualNetwork
+		subnet   string
+		err      bool
+	}{
+		"Valid": {
+			location: "West US 2",
+			vnet:     virtualNetwork("westus2"),
+			subnet:   subnetName,
+		},
+		"DelegatedToRedis": {
+			location: "westus2",
+			vnet:     virtualNetwork("westus2", ServiceNameRedis),
+			subnet:   subnetName,
+		},
+		"LocationMismatch": {
+			location: "eastus",
+			vnet:     virtualNetwork("westus2"),
+			subnet:   subnetName,
+			err:      true,
+		},
+		"SubnetNotFound": {
+			location: "westus2",
+			vnet:     virtualNetwork("westus2"),
+			subnet:   "missing",
+			err:      true,
+		},
+		"DelegatedToOtherService": {
+			location: "westus2",
+			vnet:     virtualNetwork("westus2", "Microsoft.Sql/managedInstances"),
+			subnet:   subnetName,
+			err:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateSubnet(tc.location, tc.vnet, tc.subnet)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("ValidateSubnet(...): -want error, +got error\n%s\n%v", diff, err)
+			}
+		})
+	}
+}
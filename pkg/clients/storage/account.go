@@ -20,12 +20,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
@@ -62,6 +65,7 @@ type AccountOperations interface {
 	Delete(ctx context.Context) error
 	IsAccountNameAvailable(context.Context, string) error
 	ListKeys(context.Context) ([]storage.AccountKey, error)
+	RegenerateKey(context.Context, string) ([]storage.AccountKey, error)
 }
 
 // AccountHandle implements AccountOperations interface
@@ -116,7 +120,7 @@ func (a *AccountHandle) Update(ctx context.Context, params storage.AccountUpdate
 
 // Get retrieves storage account resource
 func (a *AccountHandle) Get(ctx context.Context) (*storage.Account, error) {
-	acct, err := a.client.GetProperties(ctx, a.groupName, a.accountName)
+	acct, err := a.client.GetProperties(ctx, a.groupName, a.accountName, "")
 	if err != nil {
 		return nil, err
 	}
@@ -150,10 +154,221 @@ func (a *AccountHandle) IsAccountNameAvailable(ctx context.Context, name string)
 
 // ListKeys for this storage account
 func (a *AccountHandle) ListKeys(ctx context.Context) ([]storage.AccountKey, error) {
-	rs, err := a.client.ListKeys(ctx, a.groupName, a.accountName)
+	rs, err := a.client.ListKeys(ctx, a.groupName, a.accountName, "")
 	if err != nil {
 		return nil, err
 	}
 
 	return *rs.Keys, nil
 }
+
+// RegenerateKey regenerates the named access key of this storage account and
+// returns the account's resulting keys
+func (a *AccountHandle) RegenerateKey(ctx context.Context, keyName string) ([]storage.AccountKey, error) {
+	rs, err := a.client.RegenerateKey(ctx, a.groupName, a.accountName, storage.AccountRegenerateKeyParameters{KeyName: to.StringPtr(keyName)})
+	if err != nil {
+		return nil, err
+	}
+
+	return *rs.Keys, nil
+}
+
+// LateInitializeAccount fills the empty fields of the supplied spec with the
+// values observed in the supplied storage account. Fields the user has set
+// are never overwritten.
+func LateInitializeAccount(s *v1alpha3.StorageAccountSpec, a storage.Account) {
+	o := v1alpha3.NewStorageAccountSpec(&a)
+	if s == nil || o == nil {
+		return
+	}
+	if s.Kind == "" {
+		s.Kind = o.Kind
+	}
+	if s.Location == "" {
+		s.Location = o.Location
+	}
+	if s.Identity == nil {
+		s.Identity = o.Identity
+	}
+	if s.Sku == nil {
+		s.Sku = o.Sku
+	} else if o.Sku != nil && s.Sku.Tier == "" {
+		s.Sku.Tier = o.Sku.Tier
+	}
+	if s.Tags == nil && len(o.Tags) > 0 {
+		s.Tags = o.Tags
+	}
+	if o.StorageAccountSpecProperties == nil {
+		return
+	}
+	if s.StorageAccountSpecProperties == nil {
+		s.StorageAccountSpecProperties = &v1alpha3.StorageAccountSpecProperties{
+			EnableHTTPSTrafficOnly: o.EnableHTTPSTrafficOnly,
+		}
+	}
+	lateInitializeAccountProperties(s.StorageAccountSpecProperties, *o.StorageAccountSpecProperties)
+}
+
+func lateInitializeAccountProperties(p *v1alpha3.StorageAccountSpecProperties, o v1alpha3.StorageAccountSpecProperties) {
+	if p.AccessTier == "" {
+		p.AccessTier = o.AccessTier
+	}
+	if p.CustomDomain == nil {
+		p.CustomDomain = o.CustomDomain
+	}
+	if p.Encryption == nil {
+		p.Encryption = o.Encryption
+	}
+	if p.NetworkRuleSet == nil {
+		p.NetworkRuleSet = o.NetworkRuleSet
+	}
+	if p.MinimumTLSVersion == "" {
+		p.MinimumTLSVersion = o.MinimumTLSVersion
+	}
+	p.AllowBlobPublicAccess = azure.LateInitializeBoolPtrFromPtr(p.AllowBlobPublicAccess, o.AllowBlobPublicAccess)
+	p.IsHNSEnabled = azure.LateInitializeBoolPtrFromPtr(p.IsHNSEnabled, o.IsHNSEnabled)
+	if p.LargeFileSharesState == "" {
+		p.LargeFileSharesState = o.LargeFileSharesState
+	}
+}
+
+// IsAccountUpToDate returns true if the supplied storage account matches the
+// desired spec. Only the fields that can be updated are compared, and fields
+// the user has not set are ignored so that values defaulted by Azure do not
+// cause endless updates.
+func IsAccountUpToDate(s *v1alpha3.StorageAccountSpec, a storage.Account) bool { // nolint:gocyclo
+	o := v1alpha3.NewStorageAccountSpec(&a)
+	if s == nil || o == nil {
+		return true
+	}
+	switch {
+	case s.Kind != "" && s.Kind != o.Kind:
+		return false
+	case s.Identity != nil && (o.Identity == nil || !strings.EqualFold(s.Identity.Type, o.Identity.Type)):
+		return false
+	case s.Sku != nil && !isSkuUpToDate(*s.Sku, o.Sku):
+		return false
+	case len(s.Tags) != len(o.Tags):
+		return false
+	}
+	for k, v := range s.Tags {
+		if ov, ok := o.Tags[k]; !ok || ov != v {
+			return false
+		}
+	}
+	if s.StorageAccountSpecProperties == nil {
+		return true
+	}
+	if o.StorageAccountSpecProperties == nil {
+		return false
+	}
+	return isAccountPropertiesUpToDate(*s.StorageAccountSpecProperties, *o.StorageAccountSpecProperties)
+}
+
+func isSkuUpToDate(s v1alpha3.Sku, o *v1alpha3.Sku) bool {
+	if o == nil {
+		return false
+	}
+	return s.Name == o.Name && (s.Tier == "" || s.Tier == o.Tier)
+}
+
+func isAccountPropertiesUpToDate(p, o v1alpha3.StorageAccountSpecProperties) bool { // nolint:gocyclo
+	switch {
+	case p.AccessTier != "" && p.AccessTier != o.AccessTier:
+		return false
+	case p.EnableHTTPSTrafficOnly != o.EnableHTTPSTrafficOnly:
+		return false
+	case p.CustomDomain != nil && (o.CustomDomain == nil || p.CustomDomain.Name != o.CustomDomain.Name):
+		return false
+	case p.Encryption != nil && !isEncryptionUpToDate(*p.Encryption, o.Encryption):
+		return false
+	case p.NetworkRuleSet != nil && !isNetworkRuleSetUpToDate(*p.NetworkRuleSet, o.NetworkRuleSet):
+		return false
+	case p.MinimumTLSVersion != "" && p.MinimumTLSVersion != o.MinimumTLSVersion:
+		return false
+	case p.AllowBlobPublicAccess != nil && to.Bool(p.AllowBlobPublicAccess) != to.Bool(o.AllowBlobPublicAccess):
+		return false
+	case p.LargeFileSharesState != "" && p.LargeFileSharesState != o.LargeFileSharesState:
+		return false
+	}
+	return true
+}
+
+// isEncryptionUpToDate ignores services that are not explicitly enabled,
+// because Azure always encrypts some services and they cannot be disabled.
+func isEncryptionUpToDate(e v1alpha3.Encryption, o *v1alpha3.Encryption) bool {
+	if o == nil {
+		return false
+	}
+	if e.KeySource != "" && !strings.EqualFold(string(e.KeySource), string(o.KeySource)) {
+		return false
+	}
+	if e.KeyVaultProperties != nil && (o.KeyVaultProperties == nil || *e.KeyVaultProperties != *o.KeyVaultProperties) {
+		return false
+	}
+	if e.Services == nil {
+		return true
+	}
+	enabled := v1alpha3.EnabledEncryptionServices{}
+	if o.Services != nil {
+		enabled = *o.Services
+	}
+	return (!e.Services.Blob || enabled.Blob) &&
+		(!e.Services.File || enabled.File) &&
+		(!e.Services.Table || enabled.Table) &&
+		(!e.Services.Queue || enabled.Queue)
+}
+
+// isNetworkRuleSetUpToDate compares rules regardless of their order.
+func isNetworkRuleSetUpToDate(n v1alpha3.NetworkRuleSet, o *v1alpha3.NetworkRuleSet) bool {
+	if o == nil {
+		return false
+	}
+	if n.DefaultAction != "" && n.DefaultAction != o.DefaultAction {
+		return false
+	}
+	if n.Bypass != "" && !strings.EqualFold(normalizeList(string(n.Bypass)), normalizeList(string(o.Bypass))) {
+		return false
+	}
+	ipRules := func(rules []v1alpha3.IPRule) []string {
+		out := make([]string, len(rules))
+		for i, r := range rules {
+			out[i] = r.IPAddressOrRange
+		}
+		return out
+	}
+	vnetRules := func(rules []v1alpha3.VirtualNetworkRule) []string {
+		out := make([]string, len(rules))
+		for i, r := range rules {
+			out[i] = strings.ToLower(r.VirtualNetworkResourceID)
+		}
+		return out
+	}
+	return equalSets(ipRules(n.IPRules), ipRules(o.IPRules)) &&
+		equalSets(vnetRules(n.VirtualNetworkRules), vnetRules(o.VirtualNetworkRules))
+}
+
+// normalizeList sorts and trims a comma separated list such as "Logging, Metrics".
+func normalizeList(s string) string {
+	parts := strings.Split(s, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func equalSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa, sb := append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
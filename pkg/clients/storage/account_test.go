@@ -19,11 +19,14 @@ package storage
 import (
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 )
 
 func TestNewStorageAccountClient(t *testing.T) {
@@ -105,3 +108,164 @@ func TestNewAccountHandle(t *testing.T) {
 		})
 	}
 }
+
+func observedAccount() storage.Account {
+	return storage.Account{
+		Kind:     storage.StorageV2,
+		Location: to.StringPtr("westus2"),
+		Sku:      &storage.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+		Tags:     map[string]*string{"app": to.StringPtr("crossplane")},
+		AccountProperties: &storage.AccountProperties{
+			AccessTier:             storage.Hot,
+			EnableHTTPSTrafficOnly: to.BoolPtr(true),
+			MinimumTLSVersion:      storage.TLS10,
+			AllowBlobPublicAccess:  to.BoolPtr(true),
+			Encryption: &storage.Encryption{
+				KeySource: storage.KeySourceMicrosoftStorage,
+				Services: &storage.EncryptionServices{
+					Blob: &storage.EncryptionService{Enabled: to.BoolPtr(true)},
+					File: &storage.EncryptionService{Enabled: to.BoolPtr(true)},
+				},
+			},
+			NetworkRuleSet: &storage.NetworkRuleSet{
+				Bypass:        storage.Bypass("Logging, AzureServices"),
+				DefaultAction: storage.DefaultActionDeny,
+				IPRules: &[]storage.IPRule{
+					{IPAddressOrRange: to.StringPtr("10.0.0.1")},
+					{IPAddressOrRange: to.StringPtr("10.0.0.2")},
+				},
+			},
+		},
+	}
+}
+
+func TestLateInitializeAccount(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha3.StorageAccountSpec
+		want *v1alpha3.StorageAccountSpec
+	}{
+		"UnsetFieldsAreFilled": {
+			spec: &v1alpha3.StorageAccountSpec{
+				Kind:     storage.StorageV2,
+				Location: "West US 2",
+				Sku:      &v1alpha3.Sku{Name: storage.StandardLRS},
+			},
+			want: func() *v1alpha3.StorageAccountSpec {
+				a := observedAccount()
+				s := v1alpha3.NewStorageAccountSpec(&a)
+				s.Location = "West US 2"
+				return s
+			}(),
+		},
+		"SetFieldsAreKept": {
+			spec: &v1alpha3.StorageAccountSpec{
+				Kind:     storage.StorageV2,
+				Location: "West US 2",
+				Sku:      &v1alpha3.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+				Tags:     map[string]string{},
+				StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+					AccessTier:            storage.Cool,
+					MinimumTLSVersion:     storage.TLS12,
+					AllowBlobPublicAccess: to.BoolPtr(false),
+					Encryption:            &v1alpha3.Encryption{KeySource: storage.KeySourceMicrosoftStorage},
+					NetworkRuleSet:        &v1alpha3.NetworkRuleSet{DefaultAction: storage.DefaultActionAllow},
+				},
+			},
+			want: &v1alpha3.StorageAccountSpec{
+				Kind:     storage.StorageV2,
+				Location: "West US 2",
+				Sku:      &v1alpha3.Sku{Name: storage.StandardLRS, Tier: storage.Standard},
+				Tags:     map[string]string{},
+				StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+					AccessTier:            storage.Cool,
+					MinimumTLSVersion:     storage.TLS12,
+					AllowBlobPublicAccess: to.BoolPtr(false),
+					Encryption:            &v1alpha3.Encryption{KeySource: storage.KeySourceMicrosoftStorage},
+					NetworkRuleSet:        &v1alpha3.NetworkRuleSet{DefaultAction: storage.DefaultActionAllow},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccount(tc.spec, observedAccount())
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeAccount(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccountUpToDate(t *testing.T) {
+	observed := observedAccount()
+	upToDate := func() *v1alpha3.StorageAccountSpec {
+		return &v1alpha3.StorageAccountSpec{
+			Kind:     storage.StorageV2,
+			Location: "West US 2",
+			Sku:      &v1alpha3.Sku{Name: storage.StandardLRS},
+			Tags:     map[string]string{"app": "crossplane"},
+			StorageAccountSpecProperties: &v1alpha3.StorageAccountSpecProperties{
+				EnableHTTPSTrafficOnly: true,
+				Encryption: &v1alpha3.Encryption{
+					Services: &v1alpha3.EnabledEncryptionServices{Blob: true},
+				},
+				NetworkRuleSet: &v1alpha3.NetworkRuleSet{
+					Bypass:        storage.Bypass("AzureServices,Logging"),
+					DefaultAction: storage.DefaultActionDeny,
+					IPRules: []v1alpha3.IPRule{
+						{IPAddressOrRange: "10.0.0.2"},
+						{IPAddressOrRange: "10.0.0.1"},
+					},
+				},
+			},
+		}
+	}
+	cases := map[string]struct {
+		spec func(s *v1alpha3.StorageAccountSpec)
+		want bool
+	}{
+		"UpToDate": {
+			spec: func(s *v1alpha3.StorageAccountSpec) {},
+			want: true,
+		},
+		"SkuChanged": {
+			spec: func(s *v1alpha3.StorageAccountSpec) { s.Sku.Name = storage.StandardGRS },
+			want: false,
+		},
+		"TagsChanged": {
+			spec: func(s *v1alpha3.StorageAccountSpec) { s.Tags["app"] = "other" },
+			want: false,
+		},
+		"MinimumTLSVersionChanged": {
+			spec: func(s *v1alpha3.StorageAccountSpec) { s.MinimumTLSVersion = storage.TLS12 },
+			want: false,
+		},
+		"AllowBlobPublicAccessChanged": {
+			spec: func(s *v1alpha3.StorageAccountSpec) { s.AllowBlobPublicAccess = to.BoolPtr(false) },
+			want: false,
+		},
+		"EncryptionServiceNotEnabled": {
+			spec: func(s *v1alpha3.StorageAccountSpec) { s.Encryption.Services.Queue = true },
+			want: false,
+		},
+		"IPRuleAdded": {
+			spec: func(s *v1alpha3.StorageAccountSpec) {
+				s.NetworkRuleSet.IPRules = append(s.NetworkRuleSet.IPRules, v1alpha3.IPRule{IPAddressOrRange: "10.0.0.3"})
+			},
+			want: false,
+		},
+		"CreateOnlyFieldIgnored": {
+			spec: func(s *v1alpha3.StorageAccountSpec) { s.IsHNSEnabled = to.BoolPtr(true) },
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := upToDate()
+			tc.spec(s)
+			if got := IsAccountUpToDate(s, observed); got != tc.want {
+				t.Errorf("IsAccountUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+)
+
+// Connection secret keys published by storage resources in addition to the
+// common endpoint, username and password keys.
+const (
+	ConnectionSecretSecondaryKeyKey        = "secondaryKey"
+	ConnectionSecretConnectionStringKey    = "connectionString"
+	ConnectionSecretBlobEndpointKey        = "blobEndpoint"
+	ConnectionSecretQueueEndpointKey       = "queueEndpoint"
+	ConnectionSecretTableEndpointKey       = "tableEndpoint"
+	ConnectionSecretFileEndpointKey        = "fileEndpoint"
+	ConnectionSecretWebEndpointKey         = "webEndpoint"
+	ConnectionSecretSASTokenKey            = "sasToken"
+	ConnectionSecretSASConnectionStringKey = "sasConnectionString"
+	ConnectionSecretSASURLKey              = "sasUrl"
+	ConnectionSecretSASExpiryKey           = "sasExpiry"
+)
+
+const (
+	defaultSASServices      = "b"
+	defaultSASResourceTypes = "sco"
+
+	errParseSASPermissions   = "cannot parse shared access signature permissions"
+	errParseSASServices      = "cannot parse shared access signature services"
+	errParseSASResourceTypes = "cannot parse shared access signature resource types"
+	errNewSharedKey          = "cannot create shared key credential"
+	errSignSAS               = "cannot sign shared access signature"
+	errSASExpiryTooShort     = "shared access signature expiry %s is shorter than the minimum of %s"
+	errSASRenewBeforeExpiry  = "shared access signature renewBefore %s must be less than its expiry %s"
+)
+
+// minSASExpiry is the shortest expiry of a shared access signature. Shorter
+// signatures could expire between two reconciles before they are renewed.
+const minSASExpiry = 5 * time.Minute
+
+// NewConnectionString returns a storage connection string for the supplied
+// account name and key that includes every endpoint the account exposes.
+func NewConnectionString(accountName, accountKey string, ep *storage.Endpoints) string {
+	parts := []string{
+		"DefaultEndpointsProtocol=https",
+		"AccountName=" + accountName,
+		"AccountKey=" + accountKey,
+	}
+	return strings.Join(append(parts, endpointParts(ep)...), ";")
+}
+
+// NewSASConnectionString returns a storage connection string that
+// authenticates using the supplied shared access signature.
+func NewSASConnectionString(sas string, ep *storage.Endpoints) string {
+	return strings.Join(append(endpointParts(ep), "SharedAccessSignature="+sas), ";")
+}
+
+func endpointParts(ep *storage.Endpoints) []string {
+	if ep == nil {
+		return nil
+	}
+	parts := make([]string, 0, 4)
+	for _, e := range []struct {
+		name  string
+		value *string
+	}{
+		{name: "BlobEndpoint", value: ep.Blob},
+		{name: "QueueEndpoint", value: ep.Queue},
+		{name: "TableEndpoint", value: ep.Table},
+		{name: "FileEndpoint", value: ep.File},
+	} {
+		if to.String(e.value) != "" {
+			parts = append(parts, fmt.Sprintf("%s=%s", e.name, to.String(e.value)))
+		}
+	}
+	return parts
+}
+
+// NewAccountSAS returns an account shared access signature that is signed
+// using the supplied account key and expires at the supplied time.
+func NewAccountSAS(accountName, accountKey string, s v1alpha3.SharedAccessSignature, expiry time.Time) (string, error) {
+	p := azblob.AccountSASPermissions{}
+	if err := p.Parse(s.Permissions); err != nil {
+		return "", errors.Wrap(err, errParseSASPermissions)
+	}
+	svc := azblob.AccountSASServices{}
+	if err := svc.Parse(defaultString(s.Services, defaultSASServices)); err != nil {
+		return "", errors.Wrap(err, errParseSASServices)
+	}
+	rt := azblob.AccountSASResourceTypes{}
+	if err := rt.Parse(defaultString(s.ResourceTypes, defaultSASResourceTypes)); err != nil {
+		return "", errors.Wrap(err, errParseSASResourceTypes)
+	}
+	c, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil {
+		return "", errors.Wrap(err, errNewSharedKey)
+	}
+	q, err := azblob.AccountSASSignatureValues{
+		Protocol:      azblob.SASProtocolHTTPS,
+		ExpiryTime:    expiry.UTC(),
+		Permissions:   p.String(),
+		Services:      svc.String(),
+		ResourceTypes: rt.String(),
+	}.NewSASQueryParameters(c)
+	if err != nil {
+		return "", errors.Wrap(err, errSignSAS)
+	}
+	return q.Encode(), nil
+}
+
+// NewContainerSAS returns a container shared access signature that is signed
+// using the supplied account key and expires at the supplied time.
+func NewContainerSAS(accountName, accountKey, containerName string, s v1alpha3.SharedAccessSignature, expiry time.Time) (string, error) {
+	p := azblob.ContainerSASPermissions{}
+	if err := p.Parse(s.Permissions); err != nil {
+		return "", errors.Wrap(err, errParseSASPermissions)
+	}
+	c, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil {
+		return "", errors.Wrap(err, errNewSharedKey)
+	}
+	q, err := azblob.BlobSASSignatureValues{
+		Protocol:      azblob.SASProtocolHTTPS,
+		ExpiryTime:    expiry.UTC(),
+		Permissions:   p.String(),
+		ContainerName: containerName,
+	}.NewSASQueryParameters(c)
+	if err != nil {
+		return "", errors.Wrap(err, errSignSAS)
+	}
+	return q.Encode(), nil
+}
+
+// IsAccountSASUpToDate returns true if the supplied account shared access
+// signature grants exactly the desired permissions, services and resource
+// types, and is signed by the supplied account key.
+func IsAccountSASUpToDate(token, accountName, accountKey string, s v1alpha3.SharedAccessSignature) bool {
+	expiry, ok := sasExpiry(token)
+	if !ok {
+		return false
+	}
+	want, err := NewAccountSAS(accountName, accountKey, s, expiry)
+	return err == nil && want == token
+}
+
+// IsContainerSASUpToDate returns true if the supplied container shared access
+// signature grants exactly the desired permissions and is signed by the
+// supplied account key.
+func IsContainerSASUpToDate(token, accountName, accountKey, containerName string, s v1alpha3.SharedAccessSignature) bool {
+	expiry, ok := sasExpiry(token)
+	if !ok {
+		return false
+	}
+	want, err := NewContainerSAS(accountName, accountKey, containerName, s, expiry)
+	return err == nil && want == token
+}
+
+// sasExpiry returns the expiry time of the supplied shared access signature.
+// Signing the desired signature with the same expiry time produces an
+// identical token if neither the desired grants nor the signing key changed.
+func sasExpiry(token string) (time.Time, bool) {
+	q, err := url.ParseQuery(token)
+	if err != nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(azblob.SASTimeFormat, q.Get("se"))
+	return t, err == nil
+}
+
+// ValidateSAS returns an error if signatures generated with the supplied
+// settings would expire too soon, or would need renewal as soon as they were
+// generated.
+func ValidateSAS(s v1alpha3.SharedAccessSignature) error {
+	if s.Expiry.Duration < minSASExpiry {
+		return errors.Errorf(errSASExpiryTooShort, s.Expiry.Duration, minSASExpiry)
+	}
+	if s.RenewBefore != nil && s.RenewBefore.Duration >= s.Expiry.Duration {
+		return errors.Errorf(errSASRenewBeforeExpiry, s.RenewBefore.Duration, s.Expiry.Duration)
+	}
+	return nil
+}
+
+// SASNeedsRenewal returns true if a shared access signature that expires at
+// the supplied time should be regenerated.
+func SASNeedsRenewal(s v1alpha3.SharedAccessSignature, expiry *metav1.Time, now time.Time) bool {
+	if expiry == nil {
+		return true
+	}
+	renewBefore := s.Expiry.Duration / 3
+	if s.RenewBefore != nil {
+		renewBefore = s.RenewBefore.Duration
+	}
+	return !now.Add(renewBefore).Before(expiry.Time)
+}
+
+func defaultString(s, d string) string {
+	if s == "" {
+		return d
+	}
+	return s
+}
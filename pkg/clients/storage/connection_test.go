@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+)
+
+const (
+	testAccountName = "test-account"
+	testAccountKey  = "dGVzdC1rZXkK"
+)
+
+func TestNewConnectionString(t *testing.T) {
+	cases := map[string]struct {
+		ep   *storage.Endpoints
+		want string
+	}{
+		"NoEndpoints": {
+			want: "DefaultEndpointsProtocol=https;AccountName=test-account;AccountKey=dGVzdC1rZXkK",
+		},
+		"SomeEndpoints": {
+			ep: &storage.Endpoints{
+				Blob:  to.StringPtr("https://test-account.blob.core.windows.net/"),
+				Queue: to.StringPtr("https://test-account.queue.core.windows.net/"),
+			},
+			want: "DefaultEndpointsProtocol=https;AccountName=test-account;AccountKey=dGVzdC1rZXkK;" +
+				"BlobEndpoint=https://test-account.blob.core.windows.net/;QueueEndpoint=https://test-account.queue.core.windows.net/",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewConnectionString(testAccountName, testAccountKey, tc.ep)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewConnectionString(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSASConnectionString(t *testing.T) {
+	ep := &storage.Endpoints{Blob: to.StringPtr("https://test-account.blob.core.windows.net/")}
+	want := "BlobEndpoint=https://test-account.blob.core.windows.net/;SharedAccessSignature=sv=1&sig=abc"
+	if diff := cmp.Diff(want, NewSASConnectionString("sv=1&sig=abc", ep)); diff != "" {
+		t.Errorf("NewSASConnectionString(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestAccountSAS(t *testing.T) {
+	expiry := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		sas     v1alpha3.SharedAccessSignature
+		desired v1alpha3.SharedAccessSignature
+		key     string
+		want    bool
+	}{
+		"UpToDate": {
+			sas:     v1alpha3.SharedAccessSignature{Permissions: "lr"},
+			desired: v1alpha3.SharedAccessSignature{Permissions: "rl"},
+			want:    true,
+		},
+		"PermissionsChanged": {
+			sas:     v1alpha3.SharedAccessSignature{Permissions: "rl"},
+			desired: v1alpha3.SharedAccessSignature{Permissions: "rwl"},
+			want:    false,
+		},
+		"ServicesChanged": {
+			sas:     v1alpha3.SharedAccessSignature{Permissions: "rl"},
+			desired: v1alpha3.SharedAccessSignature{Permissions: "rl", Services: "bq"},
+			want:    false,
+		},
+		"KeyChanged": {
+			sas:     v1alpha3.SharedAccessSignature{Permissions: "rl"},
+			desired: v1alpha3.SharedAccessSignature{Permissions: "rl"},
+			key:     "bmV3LWtleQo=",
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			token, err := NewAccountSAS(testAccountName, testAccountKey, tc.sas, expiry)
+			if err != nil {
+				t.Fatalf("NewAccountSAS(...): unexpected error: %s", err)
+			}
+			key := testAccountKey
+			if tc.key != "" {
+				key = tc.key
+			}
+			if got := IsAccountSASUpToDate(token, testAccountName, key, tc.desired); got != tc.want {
+				t.Errorf("IsAccountSASUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewAccountSASInvalidPermissions(t *testing.T) {
+	if _, err := NewAccountSAS(testAccountName, testAccountKey, v1alpha3.SharedAccessSignature{Permissions: "z"}, time.Now()); err == nil {
+		t.Errorf("NewAccountSAS(...): expected error for invalid permissions")
+	}
+}
+
+func TestContainerSAS(t *testing.T) {
+	expiry := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	token, err := NewContainerSAS(testAccountName, testAccountKey, "test-container", v1alpha3.SharedAccessSignature{Permissions: "lr"}, expiry)
+	if err != nil {
+		t.Fatalf("NewContainerSAS(...): unexpected error: %s", err)
+	}
+	if !IsContainerSASUpToDate(token, testAccountName, testAccountKey, "test-container", v1alpha3.SharedAccessSignature{Permissions: "rl"}) {
+		t.Errorf("IsContainerSASUpToDate(...): want true, got false")
+	}
+	if IsContainerSASUpToDate(token, testAccountName, testAccountKey, "test-container", v1alpha3.SharedAccessSignature{Permissions: "rwl"}) {
+		t.Errorf("IsContainerSASUpToDate(...): want false, got true")
+	}
+	if IsContainerSASUpToDate(token, testAccountName, "bmV3LWtleQo=", "test-container", v1alpha3.SharedAccessSignature{Permissions: "rl"}) {
+		t.Errorf("IsContainerSASUpToDate(...) with rotated key: want false, got true")
+	}
+}
+
+func TestValidateSAS(t *testing.T) {
+	day := metav1.Duration{Duration: 24 * time.Hour}
+	cases := map[string]struct {
+		sas  v1alpha3.SharedAccessSignature
+		want error
+	}{
+		"Valid": {
+			sas: v1alpha3.SharedAccessSignature{Expiry: day, RenewBefore: &metav1.Duration{Duration: time.Hour}},
+		},
+		"ExpiryTooShort": {
+			sas:  v1alpha3.SharedAccessSignature{Expiry: metav1.Duration{Duration: time.Minute}},
+			want: errors.Errorf(errSASExpiryTooShort, time.Minute, minSASExpiry),
+		},
+		"RenewBeforeExpiry": {
+			sas:  v1alpha3.SharedAccessSignature{Expiry: day, RenewBefore: &day},
+			want: errors.Errorf(errSASRenewBeforeExpiry, day.Duration, day.Duration),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ValidateSAS(tc.sas), test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateSAS(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSASNeedsRenewal(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := metav1.Duration{Duration: 24 * time.Hour}
+	cases := map[string]struct {
+		sas    v1alpha3.SharedAccessSignature
+		expiry *metav1.Time
+		want   bool
+	}{
+		"NeverGenerated": {
+			sas:  v1alpha3.SharedAccessSignature{Expiry: day},
+			want: true,
+		},
+		"FarFromExpiry": {
+			sas:    v1alpha3.SharedAccessSignature{Expiry: day},
+			expiry: &metav1.Time{Time: now.Add(20 * time.Hour)},
+			want:   false,
+		},
+		"WithinDefaultRenewalWindow": {
+			sas:    v1alpha3.SharedAccessSignature{Expiry: day},
+			expiry: &metav1.Time{Time: now.Add(7 * time.Hour)},
+			want:   true,
+		},
+		"OutsideCustomRenewalWindow": {
+			sas:    v1alpha3.SharedAccessSignature{Expiry: day, RenewBefore: &metav1.Duration{Duration: time.Hour}},
+			expiry: &metav1.Time{Time: now.Add(7 * time.Hour)},
+			want:   false,
+		},
+		"Expired": {
+			sas:    v1alpha3.SharedAccessSignature{Expiry: day},
+			expiry: &metav1.Time{Time: now.Add(-time.Hour)},
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SASNeedsRenewal(tc.sas, tc.expiry, now); got != tc.want {
+				t.Errorf("SASNeedsRenewal(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -18,20 +18,32 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
 )
 
 // ContainerOperations interface to perform operations on Container resources
 type ContainerOperations interface {
 	Create(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error
-	Update(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error
-	Get(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error)
+	Update(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata, observed v1alpha3.ContainerObservation) error
+	Get(ctx context.Context) (*v1alpha3.ContainerObservation, error)
 	Delete(ctx context.Context) error
 }
 
@@ -45,13 +57,32 @@ var _ ContainerOperations = &ContainerHandle{}
 
 const blobFormatString = `https://%s.blob.core.windows.net`
 
+const (
+	// storageResourceID is the Azure AD resource of the storage data plane.
+	storageResourceID = "https://storage.azure.com/"
+
+	// tokenRefreshMargin is how long before expiry an Azure AD token is
+	// refreshed, and tokenRefreshRetry how long to wait after a failed
+	// refresh before trying again.
+	tokenRefreshMargin = 2 * time.Minute
+	tokenRefreshRetry  = 30 * time.Second
+
+	errKeyBasedAuthenticationNotPermitted = "KeyBasedAuthenticationNotPermitted"
+)
+
 // NewContainerHandle creates a new instance of ContainerHandle for given storage account and given container name
 func NewContainerHandle(accountName, accountKey, containerName string) (*ContainerHandle, error) {
 	c, err := azblob.NewSharedKeyCredential(accountName, accountKey)
 	if err != nil {
 		return nil, err
 	}
+	return NewContainerHandleWithCredential(accountName, c, containerName), nil
+}
 
+// NewContainerHandleWithCredential creates a new instance of ContainerHandle
+// for given storage account and given container name that authenticates using
+// the supplied credential.
+func NewContainerHandleWithCredential(accountName string, c azblob.Credential, containerName string) *ContainerHandle {
 	p := azblob.NewPipeline(c, azblob.PipelineOptions{
 		Telemetry: azblob.TelemetryOptions{Value: azure.UserAgent},
 	})
@@ -61,32 +92,168 @@ func NewContainerHandle(accountName, accountKey, containerName string) (*Contain
 
 	return &ContainerHandle{
 		ContainerURL: service.NewContainerURL(containerName),
-	}, nil
+	}
+}
+
+// A cachedTokenCredential is a token credential returned by
+// NewTokenCredential, together with the digest of the client secret it was
+// created with and a channel that stops its token refresher once closed.
+type cachedTokenCredential struct {
+	credential azblob.TokenCredential
+	secret     string
+	stop       chan struct{}
+}
+
+// tokenCredentials caches the token credentials returned by
+// NewTokenCredential, keyed by tokenCredentialKey. Each credential refreshes
+// its own token in the background, so it can be shared by every reconcile of
+// every Container that uses the same service principal.
+var tokenCredentials = struct {
+	sync.Mutex
+	m map[string]cachedTokenCredential
+}{m: map[string]cachedTokenCredential{}}
+
+// NewTokenCredential returns a storage data plane credential that uses an
+// Azure AD token for the service principal described by the supplied
+// credentials. The token is refreshed in the background before it expires.
+// Credentials are cached, so a token is only requested from Azure AD the
+// first time a service principal is used, or after its secret was rotated.
+func NewTokenCredential(creds map[string]string) (azblob.TokenCredential, error) {
+	key, secret := tokenCredentialKey(creds), clientSecretDigest(creds)
+	tokenCredentials.Lock()
+	c, ok := tokenCredentials.m[key]
+	tokenCredentials.Unlock()
+	if ok && c.secret == secret {
+		return c.credential, nil
+	}
+
+	cfg, err := adal.NewOAuthConfig(creds[azure.CredentialsKeyActiveDirectoryEndpointURL], creds[azure.CredentialsKeyTenantID])
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create OAuth configuration")
+	}
+
+	token, err := adal.NewServicePrincipalToken(*cfg,
+		creds[azure.CredentialsKeyClientID],
+		creds[azure.CredentialsKeyClientSecret],
+		storageResourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create service principal token")
+	}
+	if err := token.EnsureFresh(); err != nil {
+		return nil, errors.Wrap(err, "cannot refresh service principal token")
+	}
+
+	stop := make(chan struct{})
+	tc := azblob.NewTokenCredential(token.OAuthToken(), newTokenRefresher(token, stop))
+	return cacheTokenCredential(key, cachedTokenCredential{credential: tc, secret: secret, stop: stop}), nil
+}
+
+// cacheTokenCredential caches the supplied credential under the supplied key
+// and returns it. A credential cached for the same client secret in the
+// meantime is returned instead, and the supplied credential stopped. A
+// credential cached for another client secret is replaced and stopped.
+func cacheTokenCredential(key string, c cachedTokenCredential) azblob.TokenCredential {
+	tokenCredentials.Lock()
+	existing, ok := tokenCredentials.m[key]
+	if ok && existing.secret == c.secret {
+		tokenCredentials.Unlock()
+		close(c.stop)
+		return existing.credential
+	}
+	tokenCredentials.m[key] = c
+	tokenCredentials.Unlock()
+	if ok {
+		close(existing.stop)
+	}
+	return c.credential
+}
+
+// tokenCredentialKey identifies the service principal described by the
+// supplied credentials.
+func tokenCredentialKey(creds map[string]string) string {
+	return strings.Join([]string{
+		creds[azure.CredentialsKeyActiveDirectoryEndpointURL],
+		creds[azure.CredentialsKeyTenantID],
+		creds[azure.CredentialsKeyClientID],
+	}, "/")
+}
+
+// clientSecretDigest returns the digest of the client secret of the supplied
+// credentials, so that a rotated secret can be detected without the secret
+// itself being cached.
+func clientSecretDigest(creds map[string]string) string {
+	h := sha256.Sum256([]byte(creds[azure.CredentialsKeyClientSecret]))
+	return hex.EncodeToString(h[:])
+}
+
+// newTokenRefresher returns a refresher that keeps the supplied token fresh
+// until the supplied channel is closed.
+func newTokenRefresher(token *adal.ServicePrincipalToken, stop <-chan struct{}) azblob.TokenRefresher {
+	return func(c azblob.TokenCredential) time.Duration {
+		select {
+		case <-stop:
+			// A zero duration stops the credential from refreshing its token.
+			return 0
+		default:
+		}
+		if err := token.EnsureFresh(); err != nil {
+			return tokenRefreshRetry
+		}
+		c.SetToken(token.OAuthToken())
+		if d := time.Until(token.Token().Expires()) - tokenRefreshMargin; d > tokenRefreshRetry {
+			return d
+		}
+		return tokenRefreshRetry
+	}
 }
 
 // Create container resource
 func (a *ContainerHandle) Create(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error {
-	_, err := a.ContainerURL.Create(ctx, azblob.Metadata{}, publicAccessType)
+	_, err := a.ContainerURL.Create(ctx, metadata, publicAccessType)
 	return err
 }
 
-// Update container resource
-func (a *ContainerHandle) Update(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata) error {
-	if _, err := a.ContainerURL.SetMetadata(ctx, metadata, azblob.ContainerAccessConditions{}); err != nil {
-		return err
+// Update the public access type and metadata of the container where they
+// differ from the supplied observation. The access policy is only set if the
+// container was not modified since it was observed. The blob service does not
+// support conditional metadata writes, so metadata is always written.
+func (a *ContainerHandle) Update(ctx context.Context, publicAccessType azblob.PublicAccessType, metadata azblob.Metadata, observed v1alpha3.ContainerObservation) error {
+	if publicAccessType != observed.PublicAccessType {
+		ac := azblob.ContainerAccessConditions{}
+		if observed.LastModified != nil {
+			ac.ModifiedAccessConditions.IfUnmodifiedSince = observed.LastModified.Time
+		}
+		if _, err := a.ContainerURL.SetAccessPolicy(ctx, publicAccessType, nil, ac); err != nil {
+			return err
+		}
 	}
-	_, err := a.ContainerURL.SetAccessPolicy(ctx, publicAccessType, nil, azblob.ContainerAccessConditions{})
-	return err
+
+	if !IsMetadataUpToDate(metadata, observed.Metadata) {
+		// Setting metadata replaces all existing metadata, removing any keys
+		// that are not supplied.
+		if _, err := a.ContainerURL.SetMetadata(ctx, metadata, azblob.ContainerAccessConditions{}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Get resource information
-func (a *ContainerHandle) Get(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
+// Get the observed state of the container.
+func (a *ContainerHandle) Get(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
 	rs, err := a.ContainerURL.GetProperties(ctx, azblob.LeaseAccessConditions{})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	publicAccess := rs.BlobPublicAccess()
-	return &publicAccess, emtpyMetaToNil(rs.NewMetadata()), nil
+	lm := metav1.NewTime(rs.LastModified())
+	o := &v1alpha3.ContainerObservation{
+		PublicAccessType: rs.BlobPublicAccess(),
+		LastModified:     &lm,
+		ETag:             string(rs.ETag()),
+	}
+	if md := rs.NewMetadata(); len(md) > 0 {
+		o.Metadata = md
+	}
+	return o, nil
 }
 
 // Delete deletes the named container.
@@ -95,11 +262,30 @@ func (a *ContainerHandle) Delete(ctx context.Context) error {
 	return err
 }
 
-func emtpyMetaToNil(m azblob.Metadata) azblob.Metadata {
-	if len(m) == 0 {
-		return nil
+// IsContainerUpToDate returns true if the observed public access type and
+// metadata of a container match the desired ones.
+func IsContainerUpToDate(p v1alpha3.ContainerParameters, o v1alpha3.ContainerObservation) bool {
+	return p.PublicAccessType == o.PublicAccessType && IsMetadataUpToDate(p.Metadata, o.Metadata)
+}
+
+// IsMetadataUpToDate returns true if the observed container metadata matches
+// the desired metadata. Unset and empty metadata are equivalent, and keys are
+// compared case-insensitively because Azure returns them in lower case.
+func IsMetadataUpToDate(desired, observed azblob.Metadata) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	o := make(map[string]string, len(observed))
+	for k, v := range observed {
+		o[strings.ToLower(k)] = v
 	}
-	return m
+	for k, v := range desired {
+		ov, ok := o[strings.ToLower(k)]
+		if !ok || ov != v {
+			return false
+		}
+	}
+	return true
 }
 
 // IsNotFoundError tests for azblob not found error
@@ -111,3 +297,188 @@ func IsNotFoundError(err error) bool {
 
 	return storageErr.Response().StatusCode == http.StatusNotFound // nolint: bodyclose
 }
+
+// IsKeyBasedAuthenticationNotPermitted tests for the azblob error returned
+// when shared key access is disabled on a storage account.
+func IsKeyBasedAuthenticationNotPermitted(err error) bool {
+	storageErr, ok := err.(azblob.StorageError)
+	if !ok {
+		return false
+	}
+
+	return storageErr.ServiceCode() == errKeyBasedAuthenticationNotPermitted
+}
+
+// ContainerPolicyOperations manages the immutability policy and legal hold
+// of a container through the storage management API.
+type ContainerPolicyOperations interface {
+	GetProperties(ctx context.Context) (*storage.ContainerProperties, error)
+	CreateOrUpdateImmutabilityPolicy(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error)
+	ExtendImmutabilityPolicy(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error)
+	LockImmutabilityPolicy(ctx context.Context, etag string) error
+	DeleteImmutabilityPolicy(ctx context.Context, etag string) error
+	SetLegalHold(ctx context.Context, tags []string) error
+	ClearLegalHold(ctx context.Context, tags []string) error
+}
+
+// ContainerPolicyHandle implements ContainerPolicyOperations
+type ContainerPolicyHandle struct {
+	client        *storage.BlobContainersClient
+	groupName     string
+	accountName   string
+	containerName string
+}
+
+var _ ContainerPolicyOperations = &ContainerPolicyHandle{}
+
+// NewContainerPolicyHandle creates a new instance of ContainerPolicyHandle
+// for the named container of the given storage account.
+func NewContainerPolicyHandle(client *storage.BlobContainersClient, groupName, accountName, containerName string) *ContainerPolicyHandle {
+	return &ContainerPolicyHandle{
+		client:        client,
+		groupName:     groupName,
+		accountName:   accountName,
+		containerName: containerName,
+	}
+}
+
+// GetProperties returns the management properties of the container,
+// including its immutability policy and legal hold.
+func (h *ContainerPolicyHandle) GetProperties(ctx context.Context) (*storage.ContainerProperties, error) {
+	c, err := h.client.Get(ctx, h.groupName, h.accountName, h.containerName)
+	if err != nil {
+		return nil, err
+	}
+	if c.ContainerProperties == nil {
+		return &storage.ContainerProperties{}, nil
+	}
+	return c.ContainerProperties, nil
+}
+
+// CreateOrUpdateImmutabilityPolicy creates or updates the unlocked
+// immutability policy of the container.
+func (h *ContainerPolicyHandle) CreateOrUpdateImmutabilityPolicy(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error) {
+	rs, err := h.client.CreateOrUpdateImmutabilityPolicy(ctx, h.groupName, h.accountName, h.containerName, newImmutabilityPolicy(p), etag)
+	if err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// ExtendImmutabilityPolicy extends the retention period of the locked
+// immutability policy of the container.
+func (h *ContainerPolicyHandle) ExtendImmutabilityPolicy(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error) {
+	rs, err := h.client.ExtendImmutabilityPolicy(ctx, h.groupName, h.accountName, h.containerName, etag, &storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: to.Int32Ptr(p.ImmutabilityPeriodSinceCreationInDays),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// LockImmutabilityPolicy irreversibly locks the immutability policy of the
+// container.
+func (h *ContainerPolicyHandle) LockImmutabilityPolicy(ctx context.Context, etag string) error {
+	_, err := h.client.LockImmutabilityPolicy(ctx, h.groupName, h.accountName, h.containerName, etag)
+	return err
+}
+
+// DeleteImmutabilityPolicy deletes the unlocked immutability policy of the
+// container.
+func (h *ContainerPolicyHandle) DeleteImmutabilityPolicy(ctx context.Context, etag string) error {
+	_, err := h.client.DeleteImmutabilityPolicy(ctx, h.groupName, h.accountName, h.containerName, etag)
+	return err
+}
+
+// SetLegalHold adds the supplied legal hold tags to the container.
+func (h *ContainerPolicyHandle) SetLegalHold(ctx context.Context, tags []string) error {
+	_, err := h.client.SetLegalHold(ctx, h.groupName, h.accountName, h.containerName, storage.LegalHold{Tags: &tags})
+	return err
+}
+
+// ClearLegalHold removes the supplied legal hold tags from the container.
+func (h *ContainerPolicyHandle) ClearLegalHold(ctx context.Context, tags []string) error {
+	_, err := h.client.ClearLegalHold(ctx, h.groupName, h.accountName, h.containerName, storage.LegalHold{Tags: &tags})
+	return err
+}
+
+func newImmutabilityPolicy(p v1alpha3.ImmutabilityPolicy) *storage.ImmutabilityPolicy {
+	return &storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: to.Int32Ptr(p.ImmutabilityPeriodSinceCreationInDays),
+			AllowProtectedAppendWrites:            to.BoolPtr(p.AllowProtectedAppendWrites),
+		},
+	}
+}
+
+// NewImmutabilityPolicyObservation returns the observed state of the supplied
+// immutability policy, or nil if the container has no immutability policy.
+func NewImmutabilityPolicyObservation(p *storage.ImmutabilityPolicyProperties) *v1alpha3.ImmutabilityPolicyObservation {
+	if p == nil || p.ImmutabilityPolicyProperty == nil || p.ImmutabilityPeriodSinceCreationInDays == nil {
+		return nil
+	}
+	return &v1alpha3.ImmutabilityPolicyObservation{
+		State:                                 string(p.State),
+		ImmutabilityPeriodSinceCreationInDays: to.Int32(p.ImmutabilityPeriodSinceCreationInDays),
+		AllowProtectedAppendWrites:            to.Bool(p.AllowProtectedAppendWrites),
+	}
+}
+
+// IsImmutabilityPolicyLocked returns true if the supplied observed immutability
+// policy is locked.
+func IsImmutabilityPolicyLocked(o *v1alpha3.ImmutabilityPolicyObservation) bool {
+	return o != nil && o.State == string(storage.Locked)
+}
+
+// IsImmutabilityPolicyUpToDate returns true if the supplied observed
+// immutability policy has the desired retention period and append writes
+// setting.
+func IsImmutabilityPolicyUpToDate(p v1alpha3.ImmutabilityPolicy, o *v1alpha3.ImmutabilityPolicyObservation) bool {
+	return o != nil &&
+		p.ImmutabilityPeriodSinceCreationInDays == o.ImmutabilityPeriodSinceCreationInDays &&
+		p.AllowProtectedAppendWrites == o.AllowProtectedAppendWrites
+}
+
+// NewLegalHoldTags returns the sorted legal hold tags of the supplied legal
+// hold.
+func NewLegalHoldTags(lh *storage.LegalHoldProperties) []string {
+	if lh == nil || lh.Tags == nil {
+		return nil
+	}
+	tags := make([]string, 0, len(*lh.Tags))
+	for _, t := range *lh.Tags {
+		if t.Tag != nil {
+			tags = append(tags, *t.Tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// LegalHoldTagsDiff returns the desired legal hold tags that must be added to
+// and the observed legal hold tags that must be removed from a container.
+// Tags are compared case-insensitively because Azure normalizes them to lower
+// case.
+func LegalHoldTagsDiff(desired, observed []string) (add, remove []string) {
+	want := map[string]bool{}
+	for _, t := range desired {
+		want[strings.ToLower(t)] = true
+	}
+	have := map[string]bool{}
+	for _, t := range observed {
+		have[strings.ToLower(t)] = true
+		if !want[strings.ToLower(t)] {
+			remove = append(remove, t)
+		}
+	}
+	for _, t := range desired {
+		if !have[strings.ToLower(t)] {
+			add = append(add, t)
+			have[strings.ToLower(t)] = true
+		}
+	}
+	return add, remove
+}
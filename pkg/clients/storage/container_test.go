@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+func TestNewImmutabilityPolicyObservation(t *testing.T) {
+	cases := map[string]struct {
+		p    *storage.ImmutabilityPolicyProperties
+		want *v1alpha3.ImmutabilityPolicyObservation
+	}{
+		"NoPolicy": {
+			p:    &storage.ImmutabilityPolicyProperties{},
+			want: nil,
+		},
+		"LockedPolicy": {
+			p: &storage.ImmutabilityPolicyProperties{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: to.Int32Ptr(30),
+					AllowProtectedAppendWrites:            to.BoolPtr(true),
+					State:                                 storage.Locked,
+				},
+			},
+			want: &v1alpha3.ImmutabilityPolicyObservation{
+				State:                                 string(storage.Locked),
+				ImmutabilityPeriodSinceCreationInDays: 30,
+				AllowProtectedAppendWrites:            true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewImmutabilityPolicyObservation(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewImmutabilityPolicyObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLegalHoldTagsDiff(t *testing.T) {
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		add      []string
+		remove   []string
+	}{
+		"UpToDate": {
+			desired:  []string{"Audit", "legal"},
+			observed: []string{"audit", "legal"},
+		},
+		"AddAndRemove": {
+			desired:  []string{"audit", "case42"},
+			observed: []string{"audit", "legal"},
+			add:      []string{"case42"},
+			remove:   []string{"legal"},
+		},
+		"RemoveAll": {
+			observed: []string{"audit"},
+			remove:   []string{"audit"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := LegalHoldTagsDiff(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.add, add); diff != "" {
+				t.Errorf("LegalHoldTagsDiff(...): -want add, +got add:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.remove, remove); diff != "" {
+				t.Errorf("LegalHoldTagsDiff(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMetadataUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  azblob.Metadata
+		observed azblob.Metadata
+		want     bool
+	}{
+		"UnsetAndEmpty": {
+			desired:  azblob.Metadata{},
+			observed: nil,
+			want:     true,
+		},
+		"KeysDifferInCase": {
+			desired:  azblob.Metadata{"Owner": "team-a"},
+			observed: azblob.Metadata{"owner": "team-a"},
+			want:     true,
+		},
+		"ValueChanged": {
+			desired:  azblob.Metadata{"owner": "team-b"},
+			observed: azblob.Metadata{"owner": "team-a"},
+			want:     false,
+		},
+		"KeyRemoved": {
+			desired:  azblob.Metadata{"owner": "team-a"},
+			observed: azblob.Metadata{"owner": "team-a", "env": "dev"},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMetadataUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMetadataUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewTokenCredentialCached(t *testing.T) {
+	creds := map[string]string{
+		azure.CredentialsKeyActiveDirectoryEndpointURL: "https://login.microsoftonline.com/",
+		azure.CredentialsKeyTenantID:                   "cool-tenant",
+		azure.CredentialsKeyClientID:                   "cool-client",
+		azure.CredentialsKeyClientSecret:               "cool-secret",
+	}
+	cached := azblob.NewTokenCredential("cool-token", nil)
+	tokenCredentials.Lock()
+	tokenCredentials.m[tokenCredentialKey(creds)] = cachedTokenCredential{
+		credential: cached,
+		secret:     clientSecretDigest(creds),
+		stop:       make(chan struct{}),
+	}
+	tokenCredentials.Unlock()
+
+	// A cached credential is returned without requesting a token.
+	got, err := NewTokenCredential(creds)
+	if err != nil {
+		t.Fatalf("NewTokenCredential(...): %s", err)
+	}
+	if got != cached {
+		t.Errorf("NewTokenCredential(...): want cached credential, got %v", got)
+	}
+
+	// A rotated client secret identifies the same service principal, but
+	// not the same credential.
+	rotated := map[string]string{}
+	for k, v := range creds {
+		rotated[k] = v
+	}
+	rotated[azure.CredentialsKeyClientSecret] = "new-secret"
+	if diff := cmp.Diff(tokenCredentialKey(creds), tokenCredentialKey(rotated)); diff != "" {
+		t.Errorf("tokenCredentialKey(...): -want, +got:\n%s", diff)
+	}
+	if clientSecretDigest(creds) == clientSecretDigest(rotated) {
+		t.Errorf("clientSecretDigest(...): want different digests for different client secrets")
+	}
+}
+
+func TestCacheTokenCredential(t *testing.T) {
+	key := "cool-key"
+	credential := func(token, secret string) cachedTokenCredential {
+		return cachedTokenCredential{
+			credential: azblob.NewTokenCredential(token, nil),
+			secret:     secret,
+			stop:       make(chan struct{}),
+		}
+	}
+	stopped := func(c cachedTokenCredential) bool {
+		select {
+		case <-c.stop:
+			return true
+		default:
+			return false
+		}
+	}
+	defer func() {
+		tokenCredentials.Lock()
+		delete(tokenCredentials.m, key)
+		tokenCredentials.Unlock()
+	}()
+
+	// A credential is cached if there is none yet.
+	first := credential("first-token", "cool-secret")
+	if got := cacheTokenCredential(key, first); got != first.credential {
+		t.Errorf("cacheTokenCredential(...): want new credential, got %v", got)
+	}
+
+	// A credential for the same secret is stopped in favour of the cached one.
+	racing := credential("racing-token", "cool-secret")
+	if got := cacheTokenCredential(key, racing); got != first.credential {
+		t.Errorf("cacheTokenCredential(...): want cached credential, got %v", got)
+	}
+	if !stopped(racing) || stopped(first) {
+		t.Errorf("cacheTokenCredential(...): want racing credential stopped, cached credential running")
+	}
+
+	// A credential for a rotated secret replaces and stops the cached one.
+	rotated := credential("rotated-token", "new-secret")
+	if got := cacheTokenCredential(key, rotated); got != rotated.credential {
+		t.Errorf("cacheTokenCredential(...): want rotated credential, got %v", got)
+	}
+	if !stopped(first) || stopped(rotated) {
+		t.Errorf("cacheTokenCredential(...): want replaced credential stopped, rotated credential running")
+	}
+}
+
+func TestContainerHandleUpdate(t *testing.T) {
+	lastModified := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type request struct {
+		Comp              string
+		IfUnmodifiedSince string
+		PublicAccess      string
+		Metadata          string
+	}
+
+	cases := map[string]struct {
+		pat      azblob.PublicAccessType
+		metadata azblob.Metadata
+		observed v1alpha3.ContainerObservation
+		want     []request
+	}{
+		"UpToDate": {
+			pat:      azblob.PublicAccessContainer,
+			metadata: azblob.Metadata{"a": "1"},
+			observed: v1alpha3.ContainerObservation{PublicAccessType: azblob.PublicAccessContainer, Metadata: map[string]string{"a": "1"}},
+		},
+		"AccessPolicyIfUnmodified": {
+			pat:      azblob.PublicAccessContainer,
+			observed: v1alpha3.ContainerObservation{LastModified: &metav1.Time{Time: lastModified}},
+			want: []request{{
+				Comp:              "acl",
+				IfUnmodifiedSince: lastModified.Format(http.TimeFormat),
+				PublicAccess:      string(azblob.PublicAccessContainer),
+			}},
+		},
+		"Metadata": {
+			metadata: azblob.Metadata{"a": "1"},
+			observed: v1alpha3.ContainerObservation{LastModified: &metav1.Time{Time: lastModified}},
+			want:     []request{{Comp: "metadata", Metadata: "1"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []request
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = append(got, request{
+					Comp:              r.URL.Query().Get("comp"),
+					IfUnmodifiedSince: r.Header.Get("If-Unmodified-Since"),
+					PublicAccess:      r.Header.Get("x-ms-blob-public-access"),
+					Metadata:          r.Header.Get("x-ms-meta-a"),
+				})
+			}))
+			defer srv.Close()
+
+			u, _ := url.Parse(srv.URL + "/cool-container")
+			h := &ContainerHandle{ContainerURL: azblob.NewContainerURL(*u, azblob.NewPipeline(azblob.NewAnonymousCredential(), azblob.PipelineOptions{}))}
+			if err := h.Update(context.Background(), tc.pat, tc.metadata, tc.observed); err != nil {
+				t.Fatalf("Update(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Update(...): -want requests, +got requests:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ package fake
 import (
 	"context"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 
 	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
 )
@@ -32,6 +32,7 @@ type MockAccountOperations struct {
 	MockDelete                 func(ctx context.Context) error
 	MockIsAccountNameAvailable func(context.Context, string) error
 	MockListKeys               func(context.Context) ([]storage.AccountKey, error)
+	MockRegenerateKey          func(context.Context, string) ([]storage.AccountKey, error)
 }
 
 var _ azurestorage.AccountOperations = &MockAccountOperations{}
@@ -57,6 +58,9 @@ func NewMockAccountOperations() *MockAccountOperations {
 		MockListKeys: func(i context.Context) ([]storage.AccountKey, error) {
 			return nil, nil
 		},
+		MockRegenerateKey: func(i context.Context, s string) ([]storage.AccountKey, error) {
+			return nil, nil
+		},
 	}
 }
 
@@ -89,3 +93,8 @@ func (m *MockAccountOperations) IsAccountNameAvailable(ctx context.Context, name
 func (m *MockAccountOperations) ListKeys(ctx context.Context) ([]storage.AccountKey, error) {
 	return m.MockListKeys(ctx)
 }
+
+// RegenerateKey mock regenerate key
+func (m *MockAccountOperations) RegenerateKey(ctx context.Context, keyName string) ([]storage.AccountKey, error) {
+	return m.MockRegenerateKey(ctx, keyName)
+}
@@ -19,16 +19,18 @@ package fake
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
 )
 
 // MockContainerOperations mock implementation of ContainerOperations
 type MockContainerOperations struct {
 	MockCreate func(context.Context, azblob.PublicAccessType, azblob.Metadata) error
-	MockUpdate func(context.Context, azblob.PublicAccessType, azblob.Metadata) error
-	MockGet    func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error)
+	MockUpdate func(context.Context, azblob.PublicAccessType, azblob.Metadata, v1alpha3.ContainerObservation) error
+	MockGet    func(ctx context.Context) (*v1alpha3.ContainerObservation, error)
 	MockDelete func(ctx context.Context) error
 }
 
@@ -40,11 +42,11 @@ func NewMockContainerOperations() *MockContainerOperations {
 		MockCreate: func(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
 			return nil
 		},
-		MockUpdate: func(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
+		MockUpdate: func(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata, o v1alpha3.ContainerObservation) error {
 			return nil
 		},
-		MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-			return nil, nil, nil
+		MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+			return nil, nil
 		},
 		MockDelete: func(ctx context.Context) error {
 			return nil
@@ -58,12 +60,12 @@ func (m *MockContainerOperations) Create(ctx context.Context, pat azblob.PublicA
 }
 
 // Update mock update function
-func (m *MockContainerOperations) Update(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata) error {
-	return m.MockUpdate(ctx, pat, meta)
+func (m *MockContainerOperations) Update(ctx context.Context, pat azblob.PublicAccessType, meta azblob.Metadata, o v1alpha3.ContainerObservation) error {
+	return m.MockUpdate(ctx, pat, meta, o)
 }
 
 // Get mock get function
-func (m *MockContainerOperations) Get(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
+func (m *MockContainerOperations) Get(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
 	return m.MockGet(ctx)
 }
 
@@ -72,6 +74,55 @@ func (m *MockContainerOperations) Delete(ctx context.Context) error {
 	return m.MockDelete(ctx)
 }
 
+// MockContainerPolicyOperations mock implementation of
+// ContainerPolicyOperations
+type MockContainerPolicyOperations struct {
+	MockGetProperties                    func(ctx context.Context) (*storage.ContainerProperties, error)
+	MockCreateOrUpdateImmutabilityPolicy func(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error)
+	MockExtendImmutabilityPolicy         func(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error)
+	MockLockImmutabilityPolicy           func(ctx context.Context, etag string) error
+	MockDeleteImmutabilityPolicy         func(ctx context.Context, etag string) error
+	MockSetLegalHold                     func(ctx context.Context, tags []string) error
+	MockClearLegalHold                   func(ctx context.Context, tags []string) error
+}
+
+var _ azurestorage.ContainerPolicyOperations = &MockContainerPolicyOperations{}
+
+// GetProperties mock get properties function
+func (m *MockContainerPolicyOperations) GetProperties(ctx context.Context) (*storage.ContainerProperties, error) {
+	return m.MockGetProperties(ctx)
+}
+
+// CreateOrUpdateImmutabilityPolicy mock create or update immutability policy function
+func (m *MockContainerPolicyOperations) CreateOrUpdateImmutabilityPolicy(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error) {
+	return m.MockCreateOrUpdateImmutabilityPolicy(ctx, p, etag)
+}
+
+// ExtendImmutabilityPolicy mock extend immutability policy function
+func (m *MockContainerPolicyOperations) ExtendImmutabilityPolicy(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storage.ImmutabilityPolicy, error) {
+	return m.MockExtendImmutabilityPolicy(ctx, p, etag)
+}
+
+// LockImmutabilityPolicy mock lock immutability policy function
+func (m *MockContainerPolicyOperations) LockImmutabilityPolicy(ctx context.Context, etag string) error {
+	return m.MockLockImmutabilityPolicy(ctx, etag)
+}
+
+// DeleteImmutabilityPolicy mock delete immutability policy function
+func (m *MockContainerPolicyOperations) DeleteImmutabilityPolicy(ctx context.Context, etag string) error {
+	return m.MockDeleteImmutabilityPolicy(ctx, etag)
+}
+
+// SetLegalHold mock set legal hold function
+func (m *MockContainerPolicyOperations) SetLegalHold(ctx context.Context, tags []string) error {
+	return m.MockSetLegalHold(ctx, tags)
+}
+
+// ClearLegalHold mock clear legal hold function
+func (m *MockContainerPolicyOperations) ClearLegalHold(ctx context.Context, tags []string) error {
+	return m.MockClearLegalHold(ctx, tags)
+}
+
 // PublicAccessTypePtr returns pointer of the PublicAccessType value
 func PublicAccessTypePtr(pab azblob.PublicAccessType) *azblob.PublicAccessType {
 	return &pab
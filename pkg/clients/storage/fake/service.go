@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+
+	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
+)
+
+// MockServiceOperations mock implementation of ServiceOperations
+type MockServiceOperations struct {
+	MockGetStaticWebsite func(ctx context.Context) (*azblob.StaticWebsite, error)
+	MockSetStaticWebsite func(ctx context.Context, w azblob.StaticWebsite) error
+}
+
+var _ azurestorage.ServiceOperations = &MockServiceOperations{}
+
+// GetStaticWebsite mock get static website function
+func (m *MockServiceOperations) GetStaticWebsite(ctx context.Context) (*azblob.StaticWebsite, error) {
+	return m.MockGetStaticWebsite(ctx)
+}
+
+// SetStaticWebsite mock set static website function
+func (m *MockServiceOperations) SetStaticWebsite(ctx context.Context, w azblob.StaticWebsite) error {
+	return m.MockSetStaticWebsite(ctx, w)
+}
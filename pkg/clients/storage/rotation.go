@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+)
+
+// Names of the two access keys of a storage account.
+const (
+	KeyName1 = "key1"
+	KeyName2 = "key2"
+)
+
+const defaultKeyRotationGracePeriod = time.Hour
+
+// OtherKeyName returns the name of the access key that is not the supplied
+// one.
+func OtherKeyName(name string) string {
+	if strings.EqualFold(name, KeyName2) {
+		return KeyName1
+	}
+	return KeyName2
+}
+
+// ActiveKeys returns the value of the named access key followed by the value
+// of the other access key, if any. The first key is returned as active when
+// no key matches the supplied name.
+func ActiveKeys(keys []storage.AccountKey, name string) (active, other string) {
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k.Value == nil {
+			continue
+		}
+		if k.KeyName != nil && strings.EqualFold(*k.KeyName, name) {
+			values = append([]string{*k.Value}, values...)
+			continue
+		}
+		values = append(values, *k.Value)
+	}
+	switch len(values) {
+	case 0:
+		return "", ""
+	case 1:
+		return values[0], ""
+	default:
+		return values[0], values[1]
+	}
+}
+
+// KeyRotationDue returns true if the access keys of an account created at the
+// supplied time should be rotated, either because the supplied rotate-keys
+// annotation value has not yet been acted upon or because the rotation
+// interval has elapsed since the last rotation.
+func KeyRotationDue(p v1alpha3.KeyRotationPolicy, s v1alpha3.KeyRotationStatus, trigger string, created, now time.Time) bool {
+	if trigger != "" && trigger != s.LastRotationTrigger {
+		return true
+	}
+	if p.Interval == nil {
+		return false
+	}
+	last := created
+	if s.LastRotationTime != nil {
+		last = s.LastRotationTime.Time
+	}
+	return !now.Before(last.Add(p.Interval.Duration))
+}
+
+// GracePeriodElapsed returns true if the previously active access key may be
+// regenerated because the grace period since the last rotation has elapsed.
+func GracePeriodElapsed(p v1alpha3.KeyRotationPolicy, s v1alpha3.KeyRotationStatus, now time.Time) bool {
+	if s.LastRotationTime == nil {
+		return true
+	}
+	grace := defaultKeyRotationGracePeriod
+	if p.GracePeriod != nil {
+		grace = p.GracePeriod.Duration
+	}
+	return !now.Before(s.LastRotationTime.Add(grace))
+}
+
+// SetKeyRegenerationTime records the time at which the named access key was
+// regenerated.
+func SetKeyRegenerationTime(s *v1alpha3.KeyRotationStatus, name string, t metav1.Time) {
+	if strings.EqualFold(name, KeyName2) {
+		s.Key2RegenerationTime = &t
+		return
+	}
+	s.Key1RegenerationTime = &t
+}
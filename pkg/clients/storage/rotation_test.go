@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+)
+
+func TestActiveKeys(t *testing.T) {
+	keys := []storage.AccountKey{
+		{KeyName: to.StringPtr("key1"), Value: to.StringPtr("one")},
+		{KeyName: to.StringPtr("key2"), Value: to.StringPtr("two")},
+	}
+	cases := map[string]struct {
+		keys   []storage.AccountKey
+		name   string
+		active string
+		other  string
+	}{
+		"Key1": {keys: keys, name: KeyName1, active: "one", other: "two"},
+		"Key2": {keys: keys, name: KeyName2, active: "two", other: "one"},
+		"UnknownName": {
+			keys:   []storage.AccountKey{{KeyName: to.StringPtr("test-key"), Value: to.StringPtr("test-value")}},
+			name:   KeyName2,
+			active: "test-value",
+		},
+		"NoKeys": {name: KeyName1},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			active, other := ActiveKeys(tc.keys, tc.name)
+			if diff := cmp.Diff(tc.active, active); diff != "" {
+				t.Errorf("ActiveKeys(...): -want active, +got active:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.other, other); diff != "" {
+				t.Errorf("ActiveKeys(...): -want other, +got other:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyRotationDue(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := &metav1.Duration{Duration: 24 * time.Hour}
+	cases := map[string]struct {
+		p       v1alpha3.KeyRotationPolicy
+		s       v1alpha3.KeyRotationStatus
+		trigger string
+		created time.Time
+		want    bool
+	}{
+		"NoIntervalOrTrigger": {
+			created: now.Add(-48 * time.Hour),
+			want:    false,
+		},
+		"NewTrigger": {
+			s:       v1alpha3.KeyRotationStatus{LastRotationTrigger: "a"},
+			trigger: "b",
+			want:    true,
+		},
+		"HandledTrigger": {
+			s:       v1alpha3.KeyRotationStatus{LastRotationTrigger: "a"},
+			trigger: "a",
+			want:    false,
+		},
+		"IntervalElapsedSinceCreation": {
+			p:       v1alpha3.KeyRotationPolicy{Interval: day},
+			created: now.Add(-48 * time.Hour),
+			want:    true,
+		},
+		"IntervalNotElapsedSinceRotation": {
+			p:       v1alpha3.KeyRotationPolicy{Interval: day},
+			s:       v1alpha3.KeyRotationStatus{LastRotationTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+			created: now.Add(-48 * time.Hour),
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := KeyRotationDue(tc.p, tc.s, tc.trigger, tc.created, now); got != tc.want {
+				t.Errorf("KeyRotationDue(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGracePeriodElapsed(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		p    v1alpha3.KeyRotationPolicy
+		s    v1alpha3.KeyRotationStatus
+		want bool
+	}{
+		"NeverRotated": {
+			want: true,
+		},
+		"WithinDefaultGracePeriod": {
+			s:    v1alpha3.KeyRotationStatus{LastRotationTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+			want: false,
+		},
+		"CustomGracePeriodElapsed": {
+			p:    v1alpha3.KeyRotationPolicy{GracePeriod: &metav1.Duration{Duration: time.Minute}},
+			s:    v1alpha3.KeyRotationStatus{LastRotationTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := GracePeriodElapsed(tc.p, tc.s, now); got != tc.want {
+				t.Errorf("GracePeriodElapsed(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+)
+
+// ServiceOperations manages the blob service properties of a storage account
+// through the storage data plane.
+type ServiceOperations interface {
+	GetStaticWebsite(ctx context.Context) (*azblob.StaticWebsite, error)
+	SetStaticWebsite(ctx context.Context, w azblob.StaticWebsite) error
+}
+
+// ServiceHandle implements ServiceOperations
+type ServiceHandle struct {
+	azblob.ServiceURL
+}
+
+var _ ServiceOperations = &ServiceHandle{}
+
+// NewServiceHandle creates a new instance of ServiceHandle for the given
+// storage account. The blob endpoint of the account is used if supplied.
+func NewServiceHandle(accountName, accountKey, blobEndpoint string) (ServiceOperations, error) {
+	c, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	p := azblob.NewPipeline(c, azblob.PipelineOptions{
+		Telemetry: azblob.TelemetryOptions{Value: azure.UserAgent},
+	})
+
+	if blobEndpoint == "" {
+		blobEndpoint = fmt.Sprintf(blobFormatString, accountName)
+	}
+	u, err := url.Parse(blobEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceHandle{ServiceURL: azblob.NewServiceURL(*u, p)}, nil
+}
+
+// GetStaticWebsite returns the static website configuration of the blob
+// service.
+func (h *ServiceHandle) GetStaticWebsite(ctx context.Context) (*azblob.StaticWebsite, error) {
+	rs, err := h.ServiceURL.GetProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rs.StaticWebsite == nil {
+		return &azblob.StaticWebsite{}, nil
+	}
+	return rs.StaticWebsite, nil
+}
+
+// SetStaticWebsite configures static website hosting on the blob service.
+// Other blob service properties are left unchanged.
+func (h *ServiceHandle) SetStaticWebsite(ctx context.Context, w azblob.StaticWebsite) error {
+	_, err := h.ServiceURL.SetProperties(ctx, azblob.StorageServiceProperties{StaticWebsite: &w})
+	return err
+}
+
+// NewStaticWebsite returns the blob service static website configuration for
+// the supplied desired state. Static website hosting is disabled if the
+// supplied desired state is nil.
+func NewStaticWebsite(w *v1alpha3.StaticWebsite) azblob.StaticWebsite {
+	if w == nil {
+		return azblob.StaticWebsite{Enabled: false}
+	}
+	return azblob.StaticWebsite{
+		Enabled:              true,
+		IndexDocument:        azure.ToStringPtr(w.IndexDocument),
+		ErrorDocument404Path: azure.ToStringPtr(w.ErrorDocument404Path),
+	}
+}
+
+// NewStaticWebsiteObservation returns the observed static website
+// configuration, or nil if static website hosting is disabled.
+func NewStaticWebsiteObservation(w *azblob.StaticWebsite) *v1alpha3.StaticWebsite {
+	if w == nil || !w.Enabled {
+		return nil
+	}
+	return &v1alpha3.StaticWebsite{
+		IndexDocument:        to.String(w.IndexDocument),
+		ErrorDocument404Path: to.String(w.ErrorDocument404Path),
+	}
+}
+
+// IsStaticWebsiteUpToDate returns true if the observed static website
+// configuration matches the desired one. A nil desired configuration means
+// static website hosting should be disabled.
+func IsStaticWebsiteUpToDate(w *v1alpha3.StaticWebsite, o *azblob.StaticWebsite) bool {
+	observed := NewStaticWebsiteObservation(o)
+	if w == nil || observed == nil {
+		return w == nil && observed == nil
+	}
+	return *w == *observed
+}
@@ -22,15 +22,23 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/crossplane/provider-azure/pkg/controller/cache"
+	"github.com/crossplane/provider-azure/pkg/controller/cache/redisexport"
+	"github.com/crossplane/provider-azure/pkg/controller/cache/redisfirewallrule"
+	"github.com/crossplane/provider-azure/pkg/controller/cache/redislinkedserver"
+	"github.com/crossplane/provider-azure/pkg/controller/cache/redispatchschedule"
 	"github.com/crossplane/provider-azure/pkg/controller/compute"
 	"github.com/crossplane/provider-azure/pkg/controller/config"
 	"github.com/crossplane/provider-azure/pkg/controller/database/cosmosdb"
-	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlserver"
-	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlserverfirewallrule"
-	"github.com/crossplane/provider-azure/pkg/controller/database/mysqlservervirtualnetworkrule"
-	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlserver"
-	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlserverfirewallrule"
-	"github.com/crossplane/provider-azure/pkg/controller/database/postgresqlservervirtualnetworkrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqldatabase"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqlelasticpool"
+	"github.com/crossplane/provider-azure/pkg/controller/database/mssqlserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlserver"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlserveractivedirectoryadministrator"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlserverconfiguration"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlserverdatabase"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlserverfirewallrule"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlserverkey"
+	"github.com/crossplane/provider-azure/pkg/controller/database/sqlservervirtualnetworkrule"
 	"github.com/crossplane/provider-azure/pkg/controller/network/subnet"
 	"github.com/crossplane/provider-azure/pkg/controller/network/virtualnetwork"
 	"github.com/crossplane/provider-azure/pkg/controller/resourcegroup"
@@ -43,13 +51,32 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
 		config.Setup,
 		cache.SetupRedis,
+		redisfirewallrule.Setup,
+		redispatchschedule.Setup,
+		redislinkedserver.Setup,
+		redisexport.Setup,
 		compute.SetupAKSCluster,
-		mysqlserver.Setup,
-		mysqlserverfirewallrule.Setup,
-		mysqlservervirtualnetworkrule.Setup,
-		postgresqlserver.Setup,
-		postgresqlserverfirewallrule.Setup,
-		postgresqlservervirtualnetworkrule.Setup,
+		sqlserver.SetupMySQLServer,
+		sqlserverfirewallrule.SetupMySQLServerFirewallRule,
+		sqlservervirtualnetworkrule.SetupMySQLServerVirtualNetworkRule,
+		sqlserverconfiguration.SetupMySQLServerConfiguration,
+		sqlserverdatabase.SetupMySQLServerDatabase,
+		sqlserveractivedirectoryadministrator.SetupMySQLServerActiveDirectoryAdministrator,
+		sqlserverkey.SetupMySQLServerKey,
+		sqlserver.SetupPostgreSQLServer,
+		sqlserverfirewallrule.SetupPostgreSQLServerFirewallRule,
+		sqlservervirtualnetworkrule.SetupPostgreSQLServerVirtualNetworkRule,
+		sqlserverconfiguration.SetupPostgreSQLServerConfiguration,
+		sqlserverdatabase.SetupPostgreSQLServerDatabase,
+		sqlserveractivedirectoryadministrator.SetupPostgreSQLServerActiveDirectoryAdministrator,
+		sqlserverkey.SetupPostgreSQLServerKey,
+		sqlserver.SetupMariaDBServer,
+		sqlserverfirewallrule.SetupMariaDBServerFirewallRule,
+		sqlservervirtualnetworkrule.SetupMariaDBServerVirtualNetworkRule,
+		mssqlserver.Setup,
+		mssqldatabase.Setup,
+		mssqlelasticpool.Setup,
+		sqlserverfirewallrule.SetupMSSQLFirewallRule,
 		cosmosdb.Setup,
 		virtualnetwork.Setup,
 		subnet.Setup,
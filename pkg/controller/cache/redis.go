@@ -18,10 +18,11 @@ package cache
 
 import (
 	"context"
-	"strconv"
 
-	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis"
-	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis/redisapi"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,12 +43,16 @@ const (
 	errNotRedis            = "the custom resource is not a Redis instance"
 	errUpdateRedisCRFailed = "cannot update Redis custom resource instance"
 
-	errConnectFailed        = "cannot connect to Azure API"
-	errGetFailed            = "cannot get Redis instance from Azure API"
-	errListAccessKeysFailed = "cannot get access key list"
-	errCreateFailed         = "cannot create the Redis instance"
-	errUpdateFailed         = "cannot update the Redis instance"
-	errDeleteFailed         = "cannot delete the Redis instance"
+	errConnectFailed         = "cannot connect to Azure API"
+	errGetFailed             = "cannot get Redis instance from Azure API"
+	errListAccessKeysFailed  = "cannot get access key list"
+	errRegenerateKeyFailed   = "cannot regenerate access key"
+	errCreateFailed          = "cannot create the Redis instance"
+	errGetSubnetFailed       = "cannot get the virtual network of the Redis subnet"
+	errInvalidSubnet         = "cannot deploy the Redis instance in its subnet"
+	errGetPersistenceStorage = "cannot get the persistence storage account"
+	errUpdateFailed          = "cannot update the Redis instance"
+	errDeleteFailed          = "cannot delete the Redis instance"
 )
 
 // SetupRedis adds a controller that reconciles Redis resources.
@@ -76,12 +81,15 @@ func (c connector) Connect(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cl := redis.NewClient(creds[azure.CredentialsKeySubscriptionID])
 	cl.Authorizer = auth
-	return &external{kube: c.kube, client: cl}, nil
+	nw := network.NewVirtualNetworksClient(creds[azure.CredentialsKeySubscriptionID])
+	nw.Authorizer = auth
+	return &external{kube: c.kube, client: cl, networks: nw}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client redisapi.ClientAPI
+	kube     client.Client
+	client   redisapi.ClientAPI
+	networks networkapi.VirtualNetworksClientAPI
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -103,15 +111,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	var conn managed.ConnectionDetails
 	switch cr.Status.AtProvider.ProvisioningState {
 	case redisclients.ProvisioningStateSucceeded:
-		k, err := c.client.ListKeys(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+		k, err := c.accessKeys(ctx, cr)
 		if err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(err, errListAccessKeysFailed)
-		}
-		conn = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.HostName),
-			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(cr.Status.AtProvider.Port)),
-			xpv1.ResourceCredentialsSecretPasswordKey: []byte(azure.ToString(k.PrimaryKey)),
+			return managed.ExternalObservation{}, err
 		}
+		conn = redisclients.NewConnectionDetails(cache, k)
 		cr.Status.SetConditions(xpv1.Available())
 	case redisclients.ProvisioningStateCreating:
 		cr.Status.SetConditions(xpv1.Creating())
@@ -120,21 +124,92 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	upToDate := !redisclients.NeedsUpdate(redisclients.WithPersistence(cr.Spec.ForProvider, redisclients.PersistenceConnectionStrings{}), cr.Status.LastApplied, cache)
+	if upToDate {
+		// Everything in the spec is applied, so we record it as such. This
+		// keeps the record current for caches that were last applied by a
+		// version of this provider that did not record it.
+		cr.Status.LastApplied = redisclients.NewLastApplied(cr.Spec.ForProvider)
+	}
+	if upToDate && cr.Spec.ForProvider.Persistence != nil {
+		// NOTE: Azure does not report the storage connection strings, so we
+		// compare them with the ones we last sent. They change whenever the
+		// keys of their storage accounts are rotated.
+		cs, err := redisclients.GetPersistenceConnectionStrings(ctx, c.kube, cr.Spec.ForProvider.Persistence)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetPersistenceStorage)
+		}
+		upToDate = cs.Hash() == cr.Status.PersistenceStorageHash
+	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !redisclients.NeedsUpdate(cr.Spec.ForProvider, cache),
+		ResourceUpToDate:  upToDate,
 		ConnectionDetails: conn,
 	}, nil
 }
 
+// accessKeys returns the access keys of the supplied Redis. One of the keys is
+// regenerated first if the regenerate-key annotation changed since it was last
+// acted upon.
+func (c *external) accessKeys(ctx context.Context, cr *v1beta1.Redis) (redis.AccessKeys, error) {
+	trigger := cr.GetAnnotations()[v1beta1.AnnotationKeyRegenerateKey]
+	if trigger == "" || trigger == cr.Status.LastKeyRegenerationTrigger {
+		k, err := c.client.ListKeys(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+		return k, errors.Wrap(err, errListAccessKeysFailed)
+	}
+	kt, err := redisclients.ParseKeyRegenerationTrigger(trigger)
+	if err != nil {
+		return redis.AccessKeys{}, err
+	}
+	k, err := c.client.RegenerateKey(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), redis.RegenerateKeyParameters{KeyType: kt})
+	if err != nil {
+		return redis.AccessKeys{}, errors.Wrap(err, errRegenerateKeyFailed)
+	}
+	cr.Status.LastKeyRegenerationTrigger = trigger
+	return k, nil
+}
+
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.Redis)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRedis)
 	}
 	cr.Status.SetConditions(xpv1.Creating())
-	_, err := c.client.Create(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), redisclients.NewCreateParameters(cr))
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	if cr.Spec.ForProvider.SubnetID != nil {
+		if err := c.validateSubnet(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+	if err := redisclients.ValidatePersistence(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cs, err := redisclients.GetPersistenceConnectionStrings(ctx, c.kube, cr.Spec.ForProvider.Persistence)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPersistenceStorage)
+	}
+	desired := cr.DeepCopy()
+	desired.Spec.ForProvider = redisclients.WithPersistence(cr.Spec.ForProvider, cs)
+	if _, err := c.client.Create(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), redisclients.NewCreateParameters(desired)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	cr.Status.LastApplied = redisclients.NewLastApplied(cr.Spec.ForProvider)
+	cr.Status.PersistenceStorageHash = cs.Hash()
+	return managed.ExternalCreation{}, nil
+}
+
+// validateSubnet returns an error if the subnet of the supplied Redis cannot
+// host it. Azure only reports such problems after a long running creation
+// fails, so we check them upfront.
+func (c *external) validateSubnet(ctx context.Context, cr *v1beta1.Redis) error {
+	rg, vnet, subnet, err := redisclients.ParseSubnetID(azure.ToString(cr.Spec.ForProvider.SubnetID))
+	if err != nil {
+		return errors.Wrap(err, errInvalidSubnet)
+	}
+	nw, err := c.networks.Get(ctx, rg, vnet, "")
+	if err != nil {
+		return errors.Wrap(err, errGetSubnetFailed)
+	}
+	return errors.Wrap(redisclients.ValidateSubnet(cr.Spec.ForProvider.Location, nw, subnet), errInvalidSubnet)
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -151,12 +226,26 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
 	}
-	_, err = c.client.Update(
-		ctx,
-		cr.Spec.ForProvider.ResourceGroupName,
-		meta.GetExternalName(cr),
-		redisclients.NewUpdateParameters(cr.Spec.ForProvider, cache))
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	if err := redisclients.ValidatePersistence(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cs, err := redisclients.GetPersistenceConnectionStrings(ctx, c.kube, cr.Spec.ForProvider.Persistence)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPersistenceStorage)
+	}
+	patch := redisclients.NewUpdateParameters(redisclients.WithPersistence(cr.Spec.ForProvider, redisclients.PersistenceConnectionStrings{}), cr.Status.LastApplied, cache)
+	// NOTE: Azure does not report the storage connection strings, so we only
+	// send them along when a persistence setting or a connection string
+	// changes.
+	if redisclients.UpdatesPersistence(patch) || cs.Hash() != cr.Status.PersistenceStorageHash {
+		patch = redisclients.NewUpdateParameters(redisclients.WithPersistence(cr.Spec.ForProvider, cs), cr.Status.LastApplied, cache)
+	}
+	if _, err := c.client.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), patch); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	cr.Status.LastApplied = redisclients.NewLastApplied(cr.Spec.ForProvider)
+	cr.Status.PersistenceStorageHash = cs.Hash()
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -22,11 +22,15 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis/redisapi"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -35,9 +39,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
+	networkfake "github.com/crossplane/provider-azure/pkg/clients/network/fake"
 	redisclient "github.com/crossplane/provider-azure/pkg/clients/redis"
 	"github.com/crossplane/provider-azure/pkg/clients/redis/fake"
+	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
 )
 
 const (
@@ -45,11 +52,15 @@ const (
 	namespace = "cool-namespace"
 
 	connectionSecretName = "cool-connection-secret"
+
+	storageConnectionString = "DefaultEndpointsProtocol=https;AccountName=cool;AccountKey=secret"
 )
 
 var (
 	enableNonSSLPort = true
-	subnetID         = "coolsubnet"
+	subnetName       = "coolsubnet"
+	vnetName         = "coolnetwork"
+	subnetID         = "/subscriptions/sub/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/" + vnetName + "/subnets/" + subnetName
 	staticIP         = "172.16.0.1"
 	shardCount       = 3
 	location         = "coolplace"
@@ -57,7 +68,9 @@ var (
 	tenantSettings   = map[string]string{"tenant1": "is-crazy"}
 	hostName         = "108.8.8.1"
 	port             = 6374
+	sslPort          = 6380
 	primaryKey       = "secretpass"
+	secondaryKey     = "othersecretpass"
 	skuName          = "basic"
 	skuFamily        = "C"
 	skuCapacity      = 1
@@ -86,6 +99,114 @@ func withPort(p int) redisResourceModifier {
 	return func(r *v1beta1.Redis) { r.Status.AtProvider.Port = p }
 }
 
+func withSSLPort(p int) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Status.AtProvider.SSLPort = p }
+}
+
+func withRegenerateKeyAnnotation(v string) redisResourceModifier {
+	return func(r *v1beta1.Redis) {
+		meta.AddAnnotations(r, map[string]string{v1beta1.AnnotationKeyRegenerateKey: v})
+	}
+}
+
+func withLastKeyRegenerationTrigger(v string) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Status.LastKeyRegenerationTrigger = v }
+}
+
+func withLastApplied(l v1beta1.RedisLastApplied) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Status.LastApplied = l }
+}
+
+func withPersistenceStorageHash(h string) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Status.PersistenceStorageHash = h }
+}
+
+func withSKUName(n string) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Spec.ForProvider.SKU.Name = n }
+}
+
+func withPersistence(p *v1beta1.RedisPersistence) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Spec.ForProvider.Persistence = p }
+}
+
+func rdbPersistence() *v1beta1.RedisPersistence {
+	return &v1beta1.RedisPersistence{
+		RDB: &v1beta1.RDBPersistence{
+			BackupFrequency:   60,
+			StorageAccountRef: xpv1.Reference{Name: "cool-account"},
+		},
+	}
+}
+
+func storageAccountSecret() test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *storagev1alpha3.Account:
+			o.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Namespace: namespace, Name: "cool-account-secret"}
+		case *corev1.Secret:
+			o.Data = map[string][]byte{azurestorage.ConnectionSecretConnectionStringKey: []byte(storageConnectionString)}
+		}
+		return nil
+	}
+}
+
+func succeededCache() redis.ResourceType {
+	return redis.ResourceType{
+		Properties: &redis.Properties{
+			ProvisioningState: redis.Succeeded,
+			HostName:          &hostName,
+			Port:              azure.ToInt32(&port),
+			SslPort:           azure.ToInt32(&sslPort),
+		},
+	}
+}
+
+func upToDateCache() redis.ResourceType {
+	c := succeededCache()
+	c.Tags = map[string]*string{"key1": azure.ToStringPtr("val1")}
+	c.Properties.Sku = redisclient.NewSKU(instance().Spec.ForProvider.SKU)
+	c.Properties.RedisConfiguration = azure.ToStringPtrMap(redisConfiguration)
+	c.Properties.TenantSettings = azure.ToStringPtrMap(tenantSettings)
+	c.Properties.EnableNonSslPort = &enableNonSSLPort
+	c.Properties.ShardCount = azure.ToInt32(&shardCount)
+	c.Properties.MinimumTLSVersion = redis.TLSVersion(minTLSVersion)
+	return c
+}
+
+func persistedCache() redis.ResourceType {
+	c := upToDateCache()
+	c.Properties.RedisConfiguration[redisclient.ConfigRDBBackupEnabled] = azure.ToStringPtr("true")
+	c.Properties.RedisConfiguration[redisclient.ConfigRDBBackupFrequency] = azure.ToStringPtr("60")
+	c.Properties.RedisConfiguration[redisclient.ConfigAOFBackupEnabled] = azure.ToStringPtr("false")
+	return c
+}
+
+func persistenceStorageHash() string {
+	return redisclient.PersistenceConnectionStrings{RDB: storageConnectionString}.Hash()
+}
+
+func virtualNetwork(location string) network.VirtualNetwork {
+	return network.VirtualNetwork{
+		Name:     azure.ToStringPtr(vnetName),
+		Location: azure.ToStringPtr(location),
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			Subnets: &[]network.Subnet{{Name: azure.ToStringPtr(subnetName)}},
+		},
+	}
+}
+
+func connectionDetails(primary, secondary string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey:       []byte(hostName),
+		xpv1.ResourceCredentialsSecretPortKey:           []byte(strconv.Itoa(port)),
+		xpv1.ResourceCredentialsSecretPasswordKey:       []byte(primary),
+		redisclient.ConnectionSecretSSLPortKey:          []byte(strconv.Itoa(sslPort)),
+		redisclient.ConnectionSecretSecondaryKeyKey:     []byte(secondary),
+		redisclient.ConnectionSecretRedissURIKey:        []byte("rediss://:" + primary + "@" + hostName + ":" + strconv.Itoa(sslPort)),
+		redisclient.ConnectionSecretConnectionStringKey: []byte(hostName + ":" + strconv.Itoa(sslPort) + ",password=" + primary + ",ssl=True,abortConnect=False"),
+	}
+}
+
 func instance(rm ...redisResourceModifier) *v1beta1.Redis {
 	r := &v1beta1.Redis{
 		Spec: v1beta1.RedisSpec{
@@ -152,17 +273,12 @@ func TestObserve(t *testing.T) {
 				},
 				r: &fake.MockClient{
 					MockGet: func(_ context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error) {
-						return redis.ResourceType{
-							Properties: &redis.Properties{
-								ProvisioningState: redis.Succeeded,
-								HostName:          &hostName,
-								Port:              azure.ToInt32(&port),
-							},
-						}, nil
+						return succeededCache(), nil
 					},
 					MockListKeys: func(ctx context.Context, resourceGroupName string, name string) (result redis.AccessKeys, err error) {
 						return redis.AccessKeys{
-							PrimaryKey: azure.ToStringPtr(primaryKey),
+							PrimaryKey:   azure.ToStringPtr(primaryKey),
+							SecondaryKey: azure.ToStringPtr(secondaryKey),
 						}, nil
 					},
 				},
@@ -172,18 +288,240 @@ func TestObserve(t *testing.T) {
 					withProvisioningState(redisclient.ProvisioningStateSucceeded),
 					withHostName(hostName),
 					withPort(port),
+					withSSLPort(sslPort),
 					withConditions(xpv1.Available()),
 				),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(primaryKey, secondaryKey),
+				},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: instance(),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return upToDateCache(), nil
+					},
+					MockListKeys: func(_ context.Context, _ string, _ string) (result redis.AccessKeys, err error) {
+						return redis.AccessKeys{
+							PrimaryKey:   azure.ToStringPtr(primaryKey),
+							SecondaryKey: azure.ToStringPtr(secondaryKey),
+						}, nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withHostName(hostName),
+					withPort(port),
+					withSSLPort(sslPort),
+					withConditions(xpv1.Available()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{"cool"},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
 				o: managed.ExternalObservation{
 					ResourceExists:   true,
-					ResourceUpToDate: false,
-					ConnectionDetails: managed.ConnectionDetails{
-						xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostName),
-						xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
-						xpv1.ResourceCredentialsSecretPasswordKey: []byte(primaryKey),
+					ResourceUpToDate: true,
+					ConnectionDetails: redisclient.NewConnectionDetails(upToDateCache(), redis.AccessKeys{
+						PrimaryKey:   azure.ToStringPtr(primaryKey),
+						SecondaryKey: azure.ToStringPtr(secondaryKey),
+					}),
+				},
+			},
+		},
+		"PersistenceStorageUnchanged": {
+			args: args{
+				cr: instance(
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash(persistenceStorageHash()),
+				),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet:    storageAccountSecret(),
+				},
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return persistedCache(), nil
+					},
+					MockListKeys: func(_ context.Context, _ string, _ string) (result redis.AccessKeys, err error) {
+						return redis.AccessKeys{PrimaryKey: azure.ToStringPtr(primaryKey)}, nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash(persistenceStorageHash()),
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withHostName(hostName),
+					withPort(port),
+					withSSLPort(sslPort),
+					withConditions(xpv1.Available()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{redisclient.ConfigAOFBackupEnabled, "cool", redisclient.ConfigRDBBackupEnabled, redisclient.ConfigRDBBackupFrequency},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: redisclient.NewConnectionDetails(persistedCache(), redis.AccessKeys{PrimaryKey: azure.ToStringPtr(primaryKey)}),
+				},
+			},
+		},
+		"PersistenceStorageChanged": {
+			args: args{
+				cr: instance(
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash("stale"),
+				),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet:    storageAccountSecret(),
+				},
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return persistedCache(), nil
+					},
+					MockListKeys: func(_ context.Context, _ string, _ string) (result redis.AccessKeys, err error) {
+						return redis.AccessKeys{PrimaryKey: azure.ToStringPtr(primaryKey)}, nil
 					},
 				},
 			},
+			want: want{
+				cr: instance(
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash("stale"),
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withHostName(hostName),
+					withPort(port),
+					withSSLPort(sslPort),
+					withConditions(xpv1.Available()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{redisclient.ConfigAOFBackupEnabled, "cool", redisclient.ConfigRDBBackupEnabled, redisclient.ConfigRDBBackupFrequency},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: redisclient.NewConnectionDetails(persistedCache(), redis.AccessKeys{PrimaryKey: azure.ToStringPtr(primaryKey)}),
+				},
+			},
+		},
+		"KeyRegenerationAlreadyDone": {
+			args: args{
+				cr: instance(
+					withRegenerateKeyAnnotation("Secondary:1"),
+					withLastKeyRegenerationTrigger("Secondary:1"),
+				),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error) {
+						return succeededCache(), nil
+					},
+					MockListKeys: func(ctx context.Context, resourceGroupName string, name string) (result redis.AccessKeys, err error) {
+						return redis.AccessKeys{
+							PrimaryKey:   azure.ToStringPtr(primaryKey),
+							SecondaryKey: azure.ToStringPtr(secondaryKey),
+						}, nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withRegenerateKeyAnnotation("Secondary:1"),
+					withLastKeyRegenerationTrigger("Secondary:1"),
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withHostName(hostName),
+					withPort(port),
+					withSSLPort(sslPort),
+					withConditions(xpv1.Available()),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(primaryKey, secondaryKey),
+				},
+			},
+		},
+		"KeyRegenerated": {
+			args: args{
+				cr: instance(
+					withRegenerateKeyAnnotation("Secondary:2"),
+					withLastKeyRegenerationTrigger("Secondary:1"),
+				),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error) {
+						return succeededCache(), nil
+					},
+					MockRegenerateKey: func(_ context.Context, _ string, _ string, p redis.RegenerateKeyParameters) (result redis.AccessKeys, err error) {
+						if p.KeyType != redis.Secondary {
+							return redis.AccessKeys{}, errorBoom
+						}
+						return redis.AccessKeys{
+							PrimaryKey:   azure.ToStringPtr(primaryKey),
+							SecondaryKey: azure.ToStringPtr("new-secondary"),
+						}, nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withRegenerateKeyAnnotation("Secondary:2"),
+					withLastKeyRegenerationTrigger("Secondary:2"),
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withHostName(hostName),
+					withPort(port),
+					withSSLPort(sslPort),
+					withConditions(xpv1.Available()),
+				),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(primaryKey, "new-secondary"),
+				},
+			},
+		},
+		"RegenerateKeyFailed": {
+			args: args{
+				cr: instance(withRegenerateKeyAnnotation("Primary")),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error) {
+						return succeededCache(), nil
+					},
+					MockRegenerateKey: func(_ context.Context, _ string, _ string, _ redis.RegenerateKeyParameters) (result redis.AccessKeys, err error) {
+						return redis.AccessKeys{}, errorBoom
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withRegenerateKeyAnnotation("Primary"),
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withHostName(hostName),
+					withPort(port),
+					withSSLPort(sslPort),
+				),
+				err: errors.Wrap(errorBoom, errRegenerateKeyFailed),
+			},
 		},
 		"GetFailed": {
 			args: args{
@@ -340,8 +678,10 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	type args struct {
-		cr *v1beta1.Redis
-		r  redisapi.ClientAPI
+		cr   *v1beta1.Redis
+		r    redisapi.ClientAPI
+		n    networkapi.VirtualNetworksClientAPI
+		kube client.Client
 	}
 	type want struct {
 		cr  *v1beta1.Redis
@@ -360,10 +700,19 @@ func TestCreate(t *testing.T) {
 						return redis.CreateFuture{}, nil
 					},
 				},
+				n: &networkfake.MockVirtualNetworksClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.VirtualNetwork, error) {
+						return virtualNetwork(location), nil
+					},
+				},
 			},
 			want: want{
 				cr: instance(
 					withConditions(xpv1.Creating()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{"cool"},
+						TenantSettings:     []string{"tenant1"},
+					}),
 				),
 			},
 		},
@@ -375,6 +724,11 @@ func TestCreate(t *testing.T) {
 						return redis.CreateFuture{}, errorBoom
 					},
 				},
+				n: &networkfake.MockVirtualNetworksClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.VirtualNetwork, error) {
+						return virtualNetwork(location), nil
+					},
+				},
 			},
 			want: want{
 				cr: instance(
@@ -383,11 +737,91 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errorBoom, errCreateFailed),
 			},
 		},
+		"PersistenceRequiresPremium": {
+			args: args{
+				cr: instance(withPersistence(&v1beta1.RedisPersistence{})),
+				n: &networkfake.MockVirtualNetworksClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.VirtualNetwork, error) {
+						return virtualNetwork(location), nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withPersistence(&v1beta1.RedisPersistence{}),
+					withConditions(xpv1.Creating()),
+				),
+				err: redisclient.ValidatePersistence(instance(withPersistence(&v1beta1.RedisPersistence{})).Spec.ForProvider),
+			},
+		},
+		"SuccessfulWithPersistence": {
+			args: args{
+				cr: instance(withSKUName("Premium"), withPersistence(rdbPersistence())),
+				r: &fake.MockClient{
+					MockCreate: func(_ context.Context, _ string, _ string, parameters redis.CreateParameters) (result redis.CreateFuture, err error) {
+						if diff := cmp.Diff(storageConnectionString, azure.ToString(parameters.RedisConfiguration[redisclient.ConfigRDBStorageConnectionString])); diff != "" {
+							t.Errorf("Create(...): -want, +got\n%s", diff)
+						}
+						return redis.CreateFuture{}, nil
+					},
+				},
+				n: &networkfake.MockVirtualNetworksClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.VirtualNetwork, error) {
+						return virtualNetwork(location), nil
+					},
+				},
+				kube: &test.MockClient{MockGet: storageAccountSecret()},
+			},
+			want: want{
+				cr: instance(
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+					withConditions(xpv1.Creating()),
+					withPersistenceStorageHash(persistenceStorageHash()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{redisclient.ConfigAOFBackupEnabled, "cool", redisclient.ConfigRDBBackupEnabled, redisclient.ConfigRDBBackupFrequency},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+			},
+		},
+		"GetSubnetFailed": {
+			args: args{
+				cr: instance(),
+				n: &networkfake.MockVirtualNetworksClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.VirtualNetwork, error) {
+						return network.VirtualNetwork{}, errorBoom
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errorBoom, errGetSubnetFailed),
+			},
+		},
+		"InvalidSubnet": {
+			args: args{
+				cr: instance(),
+				n: &networkfake.MockVirtualNetworksClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.VirtualNetwork, error) {
+						return virtualNetwork("otherplace"), nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(redisclient.ValidateSubnet(location, virtualNetwork("otherplace"), subnetName), errInvalidSubnet),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.r}
+			e := external{kube: tc.kube, client: tc.r, networks: tc.n}
 
 			c, err := e.Create(context.Background(), tc.args.cr)
 			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
@@ -405,8 +839,9 @@ func TestCreate(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	type args struct {
-		cr *v1beta1.Redis
-		r  redisapi.ClientAPI
+		cr   *v1beta1.Redis
+		r    redisapi.ClientAPI
+		kube client.Client
 	}
 	type want struct {
 		cr  *v1beta1.Redis
@@ -430,7 +865,148 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 			want: want{
-				cr: instance(withProvisioningState(redisclient.ProvisioningStateSucceeded)),
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{"cool"},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+			},
+		},
+		"SuccessfulWithPersistence": {
+			args: args{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+				),
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return redis.ResourceType{}, nil
+					},
+					MockUpdate: func(_ context.Context, _ string, _ string, parameters redis.UpdateParameters) (result redis.ResourceType, err error) {
+						if diff := cmp.Diff(storageConnectionString, azure.ToString(parameters.RedisConfiguration[redisclient.ConfigRDBStorageConnectionString])); diff != "" {
+							t.Errorf("Update(...): -want, +got\n%s", diff)
+						}
+						return redis.ResourceType{}, nil
+					},
+				},
+				kube: &test.MockClient{MockGet: storageAccountSecret()},
+			},
+			want: want{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash(persistenceStorageHash()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{redisclient.ConfigAOFBackupEnabled, "cool", redisclient.ConfigRDBBackupEnabled, redisclient.ConfigRDBBackupFrequency},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+			},
+		},
+		"RotatedPersistenceStorage": {
+			args: args{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash("stale"),
+				),
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return persistedCache(), nil
+					},
+					MockUpdate: func(_ context.Context, _ string, _ string, parameters redis.UpdateParameters) (result redis.ResourceType, err error) {
+						want := map[string]*string{
+							redisclient.ConfigRDBStorageConnectionString: azure.ToStringPtr(storageConnectionString),
+						}
+						if diff := cmp.Diff(want, parameters.RedisConfiguration); diff != "" {
+							t.Errorf("Update(...): -want, +got\n%s", diff)
+						}
+						return redis.ResourceType{}, nil
+					},
+				},
+				kube: &test.MockClient{MockGet: storageAccountSecret()},
+			},
+			want: want{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+					withPersistenceStorageHash(persistenceStorageHash()),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{redisclient.ConfigAOFBackupEnabled, "cool", redisclient.ConfigRDBBackupEnabled, redisclient.ConfigRDBBackupFrequency},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+			},
+		},
+		"RemovedPersistence": {
+			args: args{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{redisclient.ConfigAOFBackupEnabled, "cool", redisclient.ConfigRDBBackupEnabled, redisclient.ConfigRDBBackupFrequency},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return redis.ResourceType{Properties: &redis.Properties{RedisConfiguration: map[string]*string{
+							"cool":                               azure.ToStringPtr("socool"),
+							redisclient.ConfigRDBBackupEnabled:   azure.ToStringPtr("true"),
+							redisclient.ConfigRDBBackupFrequency: azure.ToStringPtr("60"),
+							redisclient.ConfigAOFBackupEnabled:   azure.ToStringPtr("false"),
+						}}}, nil
+					},
+					MockUpdate: func(_ context.Context, _ string, _ string, parameters redis.UpdateParameters) (result redis.ResourceType, err error) {
+						want := map[string]*string{
+							redisclient.ConfigRDBBackupEnabled:   azure.ToStringPtr("false"),
+							redisclient.ConfigRDBBackupFrequency: to.StringPtr(""),
+						}
+						if diff := cmp.Diff(want, parameters.RedisConfiguration); diff != "" {
+							t.Errorf("Update(...): -want, +got\n%s", diff)
+						}
+						return redis.ResourceType{}, nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withLastApplied(v1beta1.RedisLastApplied{
+						RedisConfiguration: []string{"cool"},
+						TenantSettings:     []string{"tenant1"},
+					}),
+				),
+			},
+		},
+		"GetPersistenceStorageFailed": {
+			args: args{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+				),
+				r: &fake.MockClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.ResourceType, err error) {
+						return redis.ResourceType{}, nil
+					},
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errorBoom)},
+			},
+			want: want{
+				cr: instance(
+					withProvisioningState(redisclient.ProvisioningStateSucceeded),
+					withSKUName("Premium"),
+					withPersistence(rdbPersistence()),
+				),
+				err: errors.Wrap(errors.Wrap(errorBoom, "cannot get storage account"), errGetPersistenceStorage),
 			},
 		},
 		"NotReady": {
@@ -476,7 +1052,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.r}
+			e := external{kube: tc.kube, client: tc.r}
 
 			c, err := e.Update(context.Background(), tc.args.cr)
 			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
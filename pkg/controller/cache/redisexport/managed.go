@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisexport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	redisclients "github.com/crossplane/provider-azure/pkg/clients/redis"
+)
+
+// Error strings.
+const (
+	errNotRedisExport      = "managed resource is not a RedisExport"
+	errExportRedis         = "cannot export Redis cache"
+	errFetchLastOperation  = "cannot fetch last operation"
+	errGetContainer        = "cannot get storage container"
+	errContainerAccountNil = "storage container does not reference a storage account"
+	errGetContainerAccount = "cannot get the storage account of the storage container"
+	errFmtExportOperation  = "export operation %s"
+)
+
+// sasExpiry is how long Azure may write to the storage container. Exporting
+// a cache can take a long time.
+const sasExpiry = 24 * time.Hour
+
+// Setup adds a controller that reconciles RedisExports.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.RedisExportGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.RedisExport{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.RedisExportGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := redis.NewClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl, sender: cl, now: time.Now}, nil
+}
+
+// external exports a Redis cache once. The export is observed through its
+// long running operation, since Azure does not represent it as a resource.
+type external struct {
+	kube   client.Client
+	client redisapi.ClientAPI
+	sender autorest.Sender
+	now    func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.RedisExport)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisExport)
+	}
+
+	// NOTE: There is nothing to delete in Azure, so a deleted export no
+	// longer exists.
+	op := &cr.Status.AtProvider.LastOperation
+	if op.Method == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// NOTE: There is no need to poll an operation that has completed.
+	if op.Status == "" || op.Status == azure.AsyncOperationStatusInProgress {
+		if err := azure.FetchAsyncOperation(ctx, e.sender, op); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+	}
+
+	switch op.Status {
+	case azure.AsyncOperationStatusSucceeded:
+		cr.SetConditions(xpv1.Available())
+	case "", azure.AsyncOperationStatusInProgress:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(exportFailure(*op)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.RedisExport)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisExport)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	url, err := e.containerURL(ctx, cr.Spec.ForProvider.ContainerRef)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	p := redisclients.NewExportParameters(cr.Spec.ForProvider, url)
+	op, err := e.client.ExportData(ctx, cr.Spec.ForProvider.ResourceGroupName, cr.Spec.ForProvider.RedisName, p)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errExportRedis)
+	}
+	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPost,
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+// containerURL returns a URL, including a shared access signature, that
+// allows Azure to write to the referenced storage container.
+func (e *external) containerURL(ctx context.Context, ref xpv1.Reference) (string, error) {
+	c := &storagev1alpha3.Container{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, c); err != nil {
+		return "", errors.Wrap(err, errGetContainer)
+	}
+	// NOTE: Storage containers use a storage account as their 'provider'.
+	var acct string
+	switch {
+	case c.GetProviderConfigReference() != nil && c.GetProviderConfigReference().Name != "":
+		acct = c.GetProviderConfigReference().Name
+	case c.GetProviderReference() != nil && c.GetProviderReference().Name != "":
+		acct = c.GetProviderReference().Name
+	default:
+		return "", errors.New(errContainerAccountNil)
+	}
+	creds, err := redisclients.GetStorageAccountCredentials(ctx, e.kube, acct)
+	if err != nil {
+		return "", errors.Wrap(err, errGetContainerAccount)
+	}
+	return redisclients.NewContainerSASURL(creds, meta.GetExternalName(c), e.now().Add(sasExpiry))
+}
+
+// Update is a no-op; an export cannot be changed once it was started.
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op; deleting an export does not delete the exported files.
+func (e *external) Delete(_ context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.RedisExport)
+	if !ok {
+		return errors.New(errNotRedisExport)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+func exportFailure(op v1alpha3.AsyncOperation) string {
+	if op.ErrorMessage != "" {
+		return op.ErrorMessage
+	}
+	return fmt.Sprintf(errFmtExportOperation, op.Status)
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisexport
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/redis/fake"
+	azurestorage "github.com/crossplane/provider-azure/pkg/clients/storage"
+)
+
+const (
+	name              = "coolExport"
+	resourceGroupName = "coolRG"
+	redisName         = "coolRedis"
+	containerName     = "cool-container"
+	containerExtName  = "exports"
+	accountName       = "cool-account"
+	storageName       = "coolstorage"
+	prefix            = "backup"
+	pollingURL        = "https://management.azure.com/operations/cool"
+
+	inProgressResponse = `{"status": "InProgress"}`
+	succeededResponse  = `{"status": "Succeeded"}`
+)
+
+type exportModifier func(*v1beta1.RedisExport)
+
+func withConditions(c ...xpv1.Condition) exportModifier {
+	return func(r *v1beta1.RedisExport) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withLastOperation(op v1alpha3.AsyncOperation) exportModifier {
+	return func(r *v1beta1.RedisExport) { r.Status.AtProvider.LastOperation = op }
+}
+
+func withDeletionTimestamp(t time.Time) exportModifier {
+	return func(r *v1beta1.RedisExport) { r.SetDeletionTimestamp(&metav1.Time{Time: t}) }
+}
+
+func export(m ...exportModifier) *v1beta1.RedisExport {
+	r := &v1beta1.RedisExport{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.RedisExportSpec{
+			ForProvider: v1beta1.RedisExportParameters{
+				ResourceGroupName: resourceGroupName,
+				RedisName:         redisName,
+				ContainerRef:      xpv1.Reference{Name: containerName},
+				Prefix:            prefix,
+			},
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func respondWith(status int, body string) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Request:       req,
+			StatusCode:    status,
+			Body:          ioutil.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+		}, nil
+	})
+}
+
+// storage returns a MockGetFn for a Container whose Account publishes the
+// supplied connection secret.
+func storage(container ...func(*storagev1alpha3.Container)) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *storagev1alpha3.Container:
+			meta.SetExternalName(o, containerExtName)
+			o.SetProviderReference(&xpv1.Reference{Name: accountName})
+			for _, f := range container {
+				f(o)
+			}
+		case *storagev1alpha3.Account:
+			o.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Namespace: "ns", Name: "secret"}
+		case *corev1.Secret:
+			o.Data = map[string][]byte{
+				xpv1.ResourceCredentialsSecretUserKey:            []byte(storageName),
+				xpv1.ResourceCredentialsSecretPasswordKey:        []byte("c2VjcmV0"),
+				azurestorage.ConnectionSecretConnectionStringKey: []byte("AccountName=" + storageName),
+			}
+		}
+		return nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotRedisExport": {
+			e:    &external{},
+			want: want{err: errors.New(errNotRedisExport)},
+		},
+		"NotStarted": {
+			e:    &external{},
+			mg:   export(),
+			want: want{mg: export()},
+		},
+		"Deleted": {
+			e:  &external{},
+			mg: export(withDeletionTimestamp(time.Unix(0, 0)), withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, Status: azure.AsyncOperationStatusSucceeded})),
+			want: want{
+				mg: export(withDeletionTimestamp(time.Unix(0, 0)), withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, Status: azure.AsyncOperationStatusSucceeded})),
+			},
+		},
+		"InProgress": {
+			e:  &external{sender: respondWith(http.StatusAccepted, inProgressResponse)},
+			mg: export(withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL})),
+			want: want{
+				mg: export(
+					withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: azure.AsyncOperationStatusInProgress}),
+					withConditions(xpv1.Creating()),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Succeeded": {
+			e:  &external{sender: respondWith(http.StatusOK, succeededResponse)},
+			mg: export(withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: azure.AsyncOperationStatusInProgress})),
+			want: want{
+				mg: export(
+					withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: azure.AsyncOperationStatusSucceeded}),
+					withConditions(xpv1.Available()),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AlreadySucceeded": {
+			e:  &external{},
+			mg: export(withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: azure.AsyncOperationStatusSucceeded})),
+			want: want{
+				mg: export(
+					withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: azure.AsyncOperationStatusSucceeded}),
+					withConditions(xpv1.Available()),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			e:  &external{},
+			mg: export(withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: "Failed", ErrorMessage: "boom"})),
+			want: want{
+				mg: export(
+					withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL, Status: "Failed", ErrorMessage: "boom"}),
+					withConditions(xpv1.Unavailable().WithMessage("boom")),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotRedisExport": {
+			e:    &external{},
+			want: want{err: errors.New(errNotRedisExport)},
+		},
+		"GetContainerFailed": {
+			e:  &external{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+			mg: export(),
+			want: want{
+				mg:  export(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetContainer),
+			},
+		},
+		"ContainerAccountNil": {
+			e: &external{kube: &test.MockClient{MockGet: storage(func(c *storagev1alpha3.Container) {
+				c.SetProviderReference(nil)
+			})}},
+			mg: export(),
+			want: want{
+				mg:  export(withConditions(xpv1.Creating())),
+				err: errors.New(errContainerAccountNil),
+			},
+		},
+		"ExportFailed": {
+			e: &external{
+				kube: &test.MockClient{MockGet: storage()},
+				client: &fake.MockClient{
+					MockExportData: func(_ context.Context, _, _ string, _ redis.ExportRDBParameters) (redis.ExportDataFuture, error) {
+						return redis.ExportDataFuture{}, errBoom
+					},
+				},
+				now: now,
+			},
+			mg: export(),
+			want: want{
+				mg:  export(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errExportRedis),
+			},
+		},
+		"Successful": {
+			e: &external{
+				kube: &test.MockClient{MockGet: storage()},
+				client: &fake.MockClient{
+					MockExportData: func(_ context.Context, rg, n string, p redis.ExportRDBParameters) (redis.ExportDataFuture, error) {
+						if diff := cmp.Diff([]string{resourceGroupName, redisName, prefix}, []string{rg, n, azure.ToString(p.Prefix)}); diff != "" {
+							t.Errorf("ExportData(...): -want, +got:\n%s", diff)
+						}
+						u, err := url.Parse(azure.ToString(p.Container))
+						if err != nil {
+							t.Errorf("ExportData(...): cannot parse container URL: %v", err)
+							return redis.ExportDataFuture{}, nil
+						}
+						if diff := cmp.Diff(storageName+".blob.core.windows.net/"+containerExtName, u.Host+u.Path); diff != "" {
+							t.Errorf("ExportData(...): -want, +got:\n%s", diff)
+						}
+						return redis.ExportDataFuture{}, nil
+					},
+				},
+				now: now,
+			},
+			mg: export(),
+			want: want{
+				mg: export(
+					withConditions(xpv1.Creating()),
+					withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPost}),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotRedisExport": {
+			e:    &external{},
+			want: want{err: errors.New(errNotRedisExport)},
+		},
+		"Successful": {
+			e:    &external{},
+			mg:   export(),
+			want: want{mg: export(withConditions(xpv1.Deleting()))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisfirewallrule
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	redisclients "github.com/crossplane/provider-azure/pkg/clients/redis"
+)
+
+// Error strings.
+const (
+	errNotRedisFirewallRule    = "managed resource is not a RedisFirewallRule"
+	errCreateRedisFirewallRule = "cannot create RedisFirewallRule"
+	errUpdateRedisFirewallRule = "cannot update RedisFirewallRule"
+	errGetRedisFirewallRule    = "cannot get RedisFirewallRule"
+	errDeleteRedisFirewallRule = "cannot delete RedisFirewallRule"
+)
+
+// Setup adds a controller that reconciles RedisFirewallRules.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.RedisFirewallRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.RedisFirewallRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.RedisFirewallRuleGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := redis.NewFirewallRulesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client redisapi.FirewallRulesClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, ok := mg.(*v1beta1.RedisFirewallRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisFirewallRule)
+	}
+
+	az, err := e.client.Get(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, meta.GetExternalName(r))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRedisFirewallRule)
+	}
+
+	r.Status.AtProvider.ID = azure.ToString(az.ID)
+	r.Status.AtProvider.Type = azure.ToString(az.Type)
+	r.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: redisclients.FirewallRuleIsUpToDate(r, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, ok := mg.(*v1beta1.RedisFirewallRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisFirewallRule)
+	}
+
+	r.SetConditions(xpv1.Creating())
+	p := redisclients.NewFirewallRuleParameters(r)
+	_, err := e.client.CreateOrUpdate(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, meta.GetExternalName(r), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRedisFirewallRule)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, ok := mg.(*v1beta1.RedisFirewallRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRedisFirewallRule)
+	}
+
+	p := redisclients.NewFirewallRuleParameters(r)
+	_, err := e.client.CreateOrUpdate(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, meta.GetExternalName(r), p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRedisFirewallRule)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	r, ok := mg.(*v1beta1.RedisFirewallRule)
+	if !ok {
+		return errors.New(errNotRedisFirewallRule)
+	}
+
+	r.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, meta.GetExternalName(r))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteRedisFirewallRule)
+}
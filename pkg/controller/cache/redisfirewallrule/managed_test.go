@@ -1,5 +1,5 @@
 /*
-Copyright 2019 The Crossplane Authors.
+Copyright 2020 The Crossplane Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,14 +14,14 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package mysqlserverfirewallrule
+package redisfirewallrule
 
 import (
 	"context"
 	"net/http"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/go-autorest/autorest"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -33,52 +33,52 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
-	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
 	azure "github.com/crossplane/provider-azure/pkg/clients"
-	"github.com/crossplane/provider-azure/pkg/clients/fake"
+	"github.com/crossplane/provider-azure/pkg/clients/redis/fake"
 )
 
 const (
 	name              = "coolSubnet"
 	uid               = types.UID("definitely-a-uuid")
-	serverName        = "coolSrv"
+	redisName         = "coolRedis"
 	resourceGroupName = "coolRG"
 	resourceID        = "a-very-cool-id"
 	resourceType      = "cooltype"
 )
 
-type firewallRuleModifier func(*v1alpha3.MySQLServerFirewallRule)
+type firewallRuleModifier func(*v1beta1.RedisFirewallRule)
 
 func withConditions(c ...xpv1.Condition) firewallRuleModifier {
-	return func(r *v1alpha3.MySQLServerFirewallRule) { r.Status.ConditionedStatus.Conditions = c }
+	return func(r *v1beta1.RedisFirewallRule) { r.Status.ConditionedStatus.Conditions = c }
 }
 
 func withType(s string) firewallRuleModifier {
-	return func(r *v1alpha3.MySQLServerFirewallRule) { r.Status.AtProvider.Type = s }
+	return func(r *v1beta1.RedisFirewallRule) { r.Status.AtProvider.Type = s }
 }
 
 func withID(s string) firewallRuleModifier {
-	return func(r *v1alpha3.MySQLServerFirewallRule) { r.Status.AtProvider.ID = s }
+	return func(r *v1beta1.RedisFirewallRule) { r.Status.AtProvider.ID = s }
 }
 
-func firewallRule(sm ...firewallRuleModifier) *v1alpha3.MySQLServerFirewallRule {
-	r := &v1alpha3.MySQLServerFirewallRule{
+func firewallRule(sm ...firewallRuleModifier) *v1beta1.RedisFirewallRule {
+	r := &v1beta1.RedisFirewallRule{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:       name,
 			UID:        uid,
 			Finalizers: []string{},
 		},
-		Spec: v1alpha3.FirewallRuleSpec{
-			ForProvider: v1alpha3.FirewallRuleParameters{
-				ServerName:        serverName,
+		Spec: v1beta1.RedisFirewallRuleSpec{
+			ForProvider: v1beta1.RedisFirewallRuleParameters{
+				RedisName:         redisName,
 				ResourceGroupName: resourceGroupName,
-				FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
-					StartIPAddress: "127.0.0.1",
-					EndIPAddress:   "127.0.0.1",
+				RedisFirewallRuleProperties: v1beta1.RedisFirewallRuleProperties{
+					StartIP: "127.0.0.1",
+					EndIP:   "127.0.0.1",
 				},
 			},
 		},
-		Status: v1alpha3.FirewallRuleStatus{},
+		Status: v1beta1.RedisFirewallRuleStatus{},
 	}
 
 	meta.SetExternalName(r, name)
@@ -112,16 +112,16 @@ func TestObserve(t *testing.T) {
 		args args
 		want want
 	}{
-		"NotMySQLServerFirewallRule": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{}},
+		"NotRedisFirewallRule": {
+			ec: &external{client: &fake.MockFirewallRulesClient{}},
 			want: want{
-				err: errors.New(errNotMySQLServerFirewallRule),
+				err: errors.New(errNotRedisFirewallRule),
 			},
 		},
 		"SuccessfulObserveNotExist": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockGet: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRule, err error) {
-					return mysql.FirewallRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (result redis.FirewallRule, err error) {
+					return redis.FirewallRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 				},
 			}},
 			args: args{
@@ -132,12 +132,12 @@ func TestObserve(t *testing.T) {
 			},
 		},
 		"SuccessfulObserveExists": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockGet: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRule, err error) {
-					return mysql.FirewallRule{
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (result redis.FirewallRule, err error) {
+					return redis.FirewallRule{
 						ID:                     azure.ToStringPtr(resourceID),
 						Type:                   azure.ToStringPtr(resourceType),
-						FirewallRuleProperties: &mysql.FirewallRuleProperties{},
+						FirewallRuleProperties: &redis.FirewallRuleProperties{},
 					}, nil
 				},
 			}},
@@ -153,9 +153,9 @@ func TestObserve(t *testing.T) {
 			},
 		},
 		"FailedObserve": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockGet: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRule, err error) {
-					return mysql.FirewallRule{}, errBoom
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (result redis.FirewallRule, err error) {
+					return redis.FirewallRule{}, errBoom
 				},
 			}},
 			args: args{
@@ -163,7 +163,7 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				mg:  firewallRule(),
-				err: errors.Wrap(errBoom, errGetMySQLServerFirewallRule),
+				err: errors.Wrap(errBoom, errGetRedisFirewallRule),
 			},
 		},
 	}
@@ -201,16 +201,16 @@ func TestCreate(t *testing.T) {
 		args args
 		want want
 	}{
-		"NotMySQLServerFirewallRule": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{}},
+		"NotRedisFirewallRule": {
+			ec: &external{client: &fake.MockFirewallRulesClient{}},
 			want: want{
-				err: errors.New(errNotMySQLServerFirewallRule),
+				err: errors.New(errNotRedisFirewallRule),
 			},
 		},
 		"ErrorCreate": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ mysql.FirewallRule) (mysql.FirewallRulesCreateOrUpdateFuture, error) {
-					return mysql.FirewallRulesCreateOrUpdateFuture{}, errBoom
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ redis.FirewallRuleCreateParameters) (redis.FirewallRule, error) {
+					return redis.FirewallRule{}, errBoom
 				},
 			}},
 			args: args{
@@ -220,13 +220,13 @@ func TestCreate(t *testing.T) {
 				mg: firewallRule(
 					withConditions(xpv1.Creating()),
 				),
-				err: errors.Wrap(errBoom, errCreateMySQLServerFirewallRule),
+				err: errors.Wrap(errBoom, errCreateRedisFirewallRule),
 			},
 		},
 		"Successful": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ mysql.FirewallRule) (mysql.FirewallRulesCreateOrUpdateFuture, error) {
-					return mysql.FirewallRulesCreateOrUpdateFuture{}, nil
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ redis.FirewallRuleCreateParameters) (redis.FirewallRule, error) {
+					return redis.FirewallRule{}, nil
 				},
 			}},
 			args: args{
@@ -273,21 +273,21 @@ func TestUpdate(t *testing.T) {
 		args args
 		want want
 	}{
-		"NotMySQLServerFirewallRule": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{}},
+		"NotRedisFirewallRule": {
+			ec: &external{client: &fake.MockFirewallRulesClient{}},
 			want: want{
-				err: errors.New(errNotMySQLServerFirewallRule),
+				err: errors.New(errNotRedisFirewallRule),
 			},
 		},
 		"UpdateError": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockGet: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRule, err error) {
-					return mysql.FirewallRule{
-						FirewallRuleProperties: &mysql.FirewallRuleProperties{},
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (result redis.FirewallRule, err error) {
+					return redis.FirewallRule{
+						FirewallRuleProperties: &redis.FirewallRuleProperties{},
 					}, nil
 				},
-				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ mysql.FirewallRule) (mysql.FirewallRulesCreateOrUpdateFuture, error) {
-					return mysql.FirewallRulesCreateOrUpdateFuture{}, errBoom
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ redis.FirewallRuleCreateParameters) (redis.FirewallRule, error) {
+					return redis.FirewallRule{}, errBoom
 				},
 			}},
 			args: args{
@@ -295,18 +295,18 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				mg:  firewallRule(),
-				err: errors.Wrap(errBoom, errUpdateMySQLServerFirewallRule),
+				err: errors.Wrap(errBoom, errUpdateRedisFirewallRule),
 			},
 		},
 		"Successful": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockGet: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRule, err error) {
-					return mysql.FirewallRule{
-						FirewallRuleProperties: &mysql.FirewallRuleProperties{},
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (result redis.FirewallRule, err error) {
+					return redis.FirewallRule{
+						FirewallRuleProperties: &redis.FirewallRuleProperties{},
 					}, nil
 				},
-				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ mysql.FirewallRule) (mysql.FirewallRulesCreateOrUpdateFuture, error) {
-					return mysql.FirewallRulesCreateOrUpdateFuture{}, nil
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ redis.FirewallRuleCreateParameters) (redis.FirewallRule, error) {
+					return redis.FirewallRule{}, nil
 				},
 			}},
 
@@ -352,16 +352,16 @@ func TestDelete(t *testing.T) {
 		args args
 		want want
 	}{
-		"NotMySQLServerFirewallRule": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{}},
+		"NotRedisFirewallRule": {
+			ec: &external{client: &fake.MockFirewallRulesClient{}},
 			want: want{
-				err: errors.New(errNotMySQLServerFirewallRule),
+				err: errors.New(errNotRedisFirewallRule),
 			},
 		},
 		"Successful": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockDelete: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRulesDeleteFuture, err error) {
-					return mysql.FirewallRulesDeleteFuture{}, nil
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (result autorest.Response, err error) {
+					return autorest.Response{}, nil
 				},
 			}},
 			args: args{
@@ -374,9 +374,9 @@ func TestDelete(t *testing.T) {
 			},
 		},
 		"SuccessfulNotFound": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockDelete: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRulesDeleteFuture, err error) {
-					return mysql.FirewallRulesDeleteFuture{}, autorest.DetailedError{
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (result autorest.Response, err error) {
+					return autorest.Response{}, autorest.DetailedError{
 						StatusCode: http.StatusNotFound,
 					}
 				},
@@ -391,9 +391,9 @@ func TestDelete(t *testing.T) {
 			},
 		},
 		"Failed": {
-			ec: &external{client: &fake.MockMySQLFirewallRulesClient{
-				MockDelete: func(_ context.Context, _ string, _ string, _ string) (result mysql.FirewallRulesDeleteFuture, err error) {
-					return mysql.FirewallRulesDeleteFuture{}, errBoom
+			ec: &external{client: &fake.MockFirewallRulesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (result autorest.Response, err error) {
+					return autorest.Response{}, errBoom
 				},
 			}},
 			args: args{
@@ -403,7 +403,7 @@ func TestDelete(t *testing.T) {
 				mg: firewallRule(
 					withConditions(xpv1.Deleting()),
 				),
-				err: errors.Wrap(errBoom, errDeleteMySQLServerFirewallRule),
+				err: errors.Wrap(errBoom, errDeleteRedisFirewallRule),
 			},
 		},
 	}
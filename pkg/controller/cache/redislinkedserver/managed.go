@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redislinkedserver
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	redisclients "github.com/crossplane/provider-azure/pkg/clients/redis"
+)
+
+// Error strings.
+const (
+	errNotRedisLinkedServer    = "managed resource is not a RedisLinkedServer"
+	errCreateRedisLinkedServer = "cannot create RedisLinkedServer"
+	errGetRedisLinkedServer    = "cannot get RedisLinkedServer"
+	errDeleteRedisLinkedServer = "cannot delete RedisLinkedServer"
+	errUpdateCR                = "cannot update RedisLinkedServer custom resource"
+	errGetPrimary              = "cannot get primary Redis cache"
+	errGetSecondary            = "cannot get linked Redis cache"
+	errPrimaryNotReady         = "primary Redis cache is not yet provisioned"
+	errSecondaryNotReady       = "linked Redis cache is not yet provisioned"
+)
+
+// Setup adds a controller that reconciles RedisLinkedServers.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.RedisLinkedServerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.RedisLinkedServer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.RedisLinkedServerGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	ls := redis.NewLinkedServerClient(creds[azure.CredentialsKeySubscriptionID])
+	ls.Authorizer = auth
+	caches := redis.NewClient(creds[azure.CredentialsKeySubscriptionID])
+	caches.Authorizer = auth
+	return &external{kube: c.client, client: ls, caches: caches}, nil
+}
+
+// external reconciles the link between a primary Redis cache and a linked
+// Redis cache. Azure requires the linked server to be named after the linked
+// Redis cache, so the API is not addressed by external name.
+type external struct {
+	kube   client.Client
+	client redisapi.LinkedServerClientAPI
+	caches redisapi.ClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, ok := mg.(*v1beta1.RedisLinkedServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisLinkedServer)
+	}
+
+	_, name, err := redisclients.ParseLinkedRedisCacheID(r.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	az, err := e.client.Get(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, name)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRedisLinkedServer)
+	}
+
+	spec := r.Spec.ForProvider.DeepCopy()
+	redisclients.LateInitializeLinkedServer(&r.Spec.ForProvider, az)
+	if !reflect.DeepEqual(*spec, r.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, r); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+	r.Status.AtProvider = redisclients.GenerateLinkedServerObservation(az)
+
+	switch r.Status.AtProvider.ProvisioningState {
+	case redisclients.ProvisioningStateSucceeded:
+		r.SetConditions(xpv1.Available())
+	case redisclients.ProvisioningStateCreating, redisclients.ProvisioningStateLinking:
+		r.SetConditions(xpv1.Creating())
+	case redisclients.ProvisioningStateDeleting, redisclients.ProvisioningStateUnlinking:
+		r.SetConditions(xpv1.Deleting())
+	default:
+		r.SetConditions(xpv1.Unavailable())
+	}
+
+	// NOTE: All properties of a linked server are immutable; a link has to be
+	// deleted and recreated in order to change them.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, ok := mg.(*v1beta1.RedisLinkedServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisLinkedServer)
+	}
+
+	r.SetConditions(xpv1.Creating())
+
+	// Azure rejects links between caches that are still being provisioned.
+	primary, err := e.caches.Get(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPrimary)
+	}
+	if !succeeded(primary) {
+		return managed.ExternalCreation{}, errors.New(errPrimaryNotReady)
+	}
+	rg, name, err := redisclients.ParseLinkedRedisCacheID(r.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	secondary, err := e.caches.Get(ctx, rg, name)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetSecondary)
+	}
+	if !succeeded(secondary) {
+		return managed.ExternalCreation{}, errors.New(errSecondaryNotReady)
+	}
+
+	p := redisclients.NewLinkedServerCreateParameters(r.Spec.ForProvider, secondary)
+	_, err = e.client.Create(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, name, p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRedisLinkedServer)
+}
+
+func (e *external) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1beta1.RedisLinkedServer); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRedisLinkedServer)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	r, ok := mg.(*v1beta1.RedisLinkedServer)
+	if !ok {
+		return errors.New(errNotRedisLinkedServer)
+	}
+
+	r.SetConditions(xpv1.Deleting())
+	if r.Status.AtProvider.ProvisioningState == redisclients.ProvisioningStateUnlinking {
+		return nil
+	}
+	_, name, err := redisclients.ParseLinkedRedisCacheID(r.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	_, err = e.client.Delete(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, name)
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteRedisLinkedServer)
+}
+
+func succeeded(r redis.ResourceType) bool {
+	return r.Properties != nil && string(r.Properties.ProvisioningState) == redisclients.ProvisioningStateSucceeded
+}
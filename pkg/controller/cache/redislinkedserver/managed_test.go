@@ -0,0 +1,382 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redislinkedserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/redis/fake"
+)
+
+const (
+	name              = "coolLink"
+	resourceGroupName = "coolRG"
+	primaryName       = "coolPrimary"
+	secondaryGroup    = "coolSecondaryRG"
+	secondaryName     = "coolSecondary"
+	secondaryID       = "/subscriptions/sub/resourceGroups/" + secondaryGroup + "/providers/Microsoft.Cache/Redis/" + secondaryName
+	location          = "westeurope"
+	resourceID        = "a-very-cool-id"
+)
+
+type linkedServerModifier func(*v1beta1.RedisLinkedServer)
+
+func withConditions(c ...xpv1.Condition) linkedServerModifier {
+	return func(r *v1beta1.RedisLinkedServer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withLocation(l *string) linkedServerModifier {
+	return func(r *v1beta1.RedisLinkedServer) { r.Spec.ForProvider.LinkedRedisCacheLocation = l }
+}
+
+func withLinkedRedisCacheID(id string) linkedServerModifier {
+	return func(r *v1beta1.RedisLinkedServer) { r.Spec.ForProvider.LinkedRedisCacheID = id }
+}
+
+func withAtProvider(o v1beta1.RedisLinkedServerObservation) linkedServerModifier {
+	return func(r *v1beta1.RedisLinkedServer) { r.Status.AtProvider = o }
+}
+
+func linkedServer(m ...linkedServerModifier) *v1beta1.RedisLinkedServer {
+	r := &v1beta1.RedisLinkedServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.RedisLinkedServerSpec{
+			ForProvider: v1beta1.RedisLinkedServerParameters{
+				ResourceGroupName:        resourceGroupName,
+				RedisName:                primaryName,
+				LinkedRedisCacheID:       secondaryID,
+				LinkedRedisCacheLocation: azure.ToStringPtr(location),
+				ServerRole:               v1beta1.ReplicationRoleSecondary,
+			},
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func linkedServerWithProperties(state string) redis.LinkedServerWithProperties {
+	return redis.LinkedServerWithProperties{
+		ID:   azure.ToStringPtr(resourceID),
+		Name: azure.ToStringPtr(secondaryName),
+		LinkedServerProperties: &redis.LinkedServerProperties{
+			ProvisioningState:        azure.ToStringPtr(state),
+			LinkedRedisCacheID:       azure.ToStringPtr(secondaryID),
+			LinkedRedisCacheLocation: azure.ToStringPtr(location),
+			ServerRole:               redis.ReplicationRoleSecondary,
+		},
+	}
+}
+
+func observation(state string) v1beta1.RedisLinkedServerObservation {
+	return v1beta1.RedisLinkedServerObservation{
+		ID:                       resourceID,
+		Name:                     secondaryName,
+		ProvisioningState:        state,
+		LinkedRedisCacheID:       secondaryID,
+		LinkedRedisCacheLocation: location,
+		ServerRole:               v1beta1.ReplicationRoleSecondary,
+	}
+}
+
+func cache(state redis.ProvisioningState) redis.ResourceType {
+	return redis.ResourceType{
+		Location:   azure.ToStringPtr(location),
+		Properties: &redis.Properties{ProvisioningState: state},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotRedisLinkedServer": {
+			e:    &external{},
+			want: want{err: errors.New(errNotRedisLinkedServer)},
+		},
+		"InvalidLinkedRedisCacheID": {
+			e:  &external{},
+			mg: linkedServer(withLinkedRedisCacheID("not-an-id")),
+			want: want{
+				mg:  linkedServer(withLinkedRedisCacheID("not-an-id")),
+				err: errors.Wrap(errors.New("parsing failed for not-an-id. Invalid resource Id format"), "cannot parse linked Redis cache ID"),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockLinkedServerClient{
+				MockGet: func(_ context.Context, _, _, _ string) (redis.LinkedServerWithProperties, error) {
+					return redis.LinkedServerWithProperties{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg:   linkedServer(),
+			want: want{mg: linkedServer()},
+		},
+		"GetFailed": {
+			e: &external{client: &fake.MockLinkedServerClient{
+				MockGet: func(_ context.Context, _, _, _ string) (redis.LinkedServerWithProperties, error) {
+					return redis.LinkedServerWithProperties{}, errBoom
+				},
+			}},
+			mg: linkedServer(),
+			want: want{
+				mg:  linkedServer(),
+				err: errors.Wrap(errBoom, errGetRedisLinkedServer),
+			},
+		},
+		"UpdateCRFailed": {
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockLinkedServerClient{
+					MockGet: func(_ context.Context, _, _, _ string) (redis.LinkedServerWithProperties, error) {
+						return linkedServerWithProperties(string(redis.Succeeded)), nil
+					},
+				},
+			},
+			mg: linkedServer(withLocation(nil)),
+			want: want{
+				mg:  linkedServer(),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"Linking": {
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockLinkedServerClient{
+					MockGet: func(_ context.Context, _, _, _ string) (redis.LinkedServerWithProperties, error) {
+						return linkedServerWithProperties(string(redis.Linking)), nil
+					},
+				},
+			},
+			mg: linkedServer(withLocation(nil)),
+			want: want{
+				mg: linkedServer(
+					withConditions(xpv1.Creating()),
+					withAtProvider(observation(string(redis.Linking))),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Available": {
+			e: &external{
+				// The spec is already initialized, so it must not be updated.
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockLinkedServerClient{
+					MockGet: func(_ context.Context, rg, primary, ls string) (redis.LinkedServerWithProperties, error) {
+						if rg != resourceGroupName || primary != primaryName || ls != secondaryName {
+							return redis.LinkedServerWithProperties{}, errBoom
+						}
+						return linkedServerWithProperties(string(redis.Succeeded)), nil
+					},
+				},
+			},
+			mg: linkedServer(),
+			want: want{
+				mg: linkedServer(
+					withConditions(xpv1.Available()),
+					withAtProvider(observation(string(redis.Succeeded))),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotRedisLinkedServer": {
+			e:    &external{},
+			want: errors.New(errNotRedisLinkedServer),
+		},
+		"GetPrimaryFailed": {
+			e: &external{caches: &fake.MockClient{
+				MockGet: func(_ context.Context, _, _ string) (redis.ResourceType, error) {
+					return redis.ResourceType{}, errBoom
+				},
+			}},
+			mg:   linkedServer(),
+			want: errors.Wrap(errBoom, errGetPrimary),
+		},
+		"PrimaryNotReady": {
+			e: &external{caches: &fake.MockClient{
+				MockGet: func(_ context.Context, _, _ string) (redis.ResourceType, error) {
+					return cache(redis.Creating), nil
+				},
+			}},
+			mg:   linkedServer(),
+			want: errors.New(errPrimaryNotReady),
+		},
+		"SecondaryNotReady": {
+			e: &external{caches: &fake.MockClient{
+				MockGet: func(_ context.Context, _, name string) (redis.ResourceType, error) {
+					if name == secondaryName {
+						return cache(redis.Scaling), nil
+					}
+					return cache(redis.Succeeded), nil
+				},
+			}},
+			mg:   linkedServer(),
+			want: errors.New(errSecondaryNotReady),
+		},
+		"CreateFailed": {
+			e: &external{
+				caches: &fake.MockClient{
+					MockGet: func(_ context.Context, _, _ string) (redis.ResourceType, error) {
+						return cache(redis.Succeeded), nil
+					},
+				},
+				client: &fake.MockLinkedServerClient{
+					MockCreate: func(_ context.Context, _, _, _ string, _ redis.LinkedServerCreateParameters) (redis.LinkedServerCreateFuture, error) {
+						return redis.LinkedServerCreateFuture{}, errBoom
+					},
+				},
+			},
+			mg:   linkedServer(),
+			want: errors.Wrap(errBoom, errCreateRedisLinkedServer),
+		},
+		"Successful": {
+			e: &external{
+				caches: &fake.MockClient{
+					MockGet: func(_ context.Context, rg, name string) (redis.ResourceType, error) {
+						if name == secondaryName && rg != secondaryGroup {
+							return redis.ResourceType{}, errBoom
+						}
+						return cache(redis.Succeeded), nil
+					},
+				},
+				client: &fake.MockLinkedServerClient{
+					MockCreate: func(_ context.Context, rg, primary, ls string, p redis.LinkedServerCreateParameters) (redis.LinkedServerCreateFuture, error) {
+						want := redis.LinkedServerCreateParameters{
+							LinkedServerCreateProperties: &redis.LinkedServerCreateProperties{
+								LinkedRedisCacheID:       azure.ToStringPtr(secondaryID),
+								LinkedRedisCacheLocation: azure.ToStringPtr(location),
+								ServerRole:               redis.ReplicationRoleSecondary,
+							},
+						}
+						if rg != resourceGroupName || primary != primaryName || ls != secondaryName || !cmp.Equal(want, p) {
+							return redis.LinkedServerCreateFuture{}, errBoom
+						}
+						return redis.LinkedServerCreateFuture{}, nil
+					},
+				},
+			},
+			mg: linkedServer(withLocation(nil)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotRedisLinkedServer": {
+			e:    &external{},
+			want: errors.New(errNotRedisLinkedServer),
+		},
+		"AlreadyUnlinking": {
+			e:  &external{},
+			mg: linkedServer(withAtProvider(observation(string(redis.Unlinking)))),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockLinkedServerClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: linkedServer(),
+		},
+		"DeleteFailed": {
+			e: &external{client: &fake.MockLinkedServerClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   linkedServer(),
+			want: errors.Wrap(errBoom, errDeleteRedisLinkedServer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redispatchschedule
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	redisclients "github.com/crossplane/provider-azure/pkg/clients/redis"
+)
+
+// Error strings.
+const (
+	errNotRedisPatchSchedule    = "managed resource is not a RedisPatchSchedule"
+	errCreateRedisPatchSchedule = "cannot create RedisPatchSchedule"
+	errUpdateRedisPatchSchedule = "cannot update RedisPatchSchedule"
+	errGetRedisPatchSchedule    = "cannot get RedisPatchSchedule"
+	errDeleteRedisPatchSchedule = "cannot delete RedisPatchSchedule"
+	errUpdateCR                 = "cannot update RedisPatchSchedule custom resource"
+)
+
+// Setup adds a controller that reconciles RedisPatchSchedules.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.RedisPatchScheduleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.RedisPatchSchedule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.RedisPatchScheduleGroupVersionKind),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := redis.NewPatchSchedulesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl}, nil
+}
+
+// external reconciles the patch schedule of a Redis cache. A cache has at most
+// one patch schedule, which Azure always names "default", so the API is
+// addressed by the name of the cache rather than by external name.
+type external struct {
+	kube   client.Client
+	client redisapi.PatchSchedulesClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, ok := mg.(*v1beta1.RedisPatchSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisPatchSchedule)
+	}
+
+	az, err := e.client.Get(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRedisPatchSchedule)
+	}
+
+	redisclients.LateInitializePatchSchedule(&r.Spec.ForProvider, az)
+	if err := e.kube.Update(ctx, r); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	r.Status.AtProvider.ID = azure.ToString(az.ID)
+	r.Status.AtProvider.Type = azure.ToString(az.Type)
+	r.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: redisclients.PatchScheduleIsUpToDate(r.Spec.ForProvider, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, ok := mg.(*v1beta1.RedisPatchSchedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisPatchSchedule)
+	}
+
+	r.SetConditions(xpv1.Creating())
+	p := redisclients.NewPatchSchedule(r.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRedisPatchSchedule)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, ok := mg.(*v1beta1.RedisPatchSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRedisPatchSchedule)
+	}
+
+	p := redisclients.NewPatchSchedule(r.Spec.ForProvider)
+	_, err := e.client.CreateOrUpdate(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName, p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRedisPatchSchedule)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	r, ok := mg.(*v1beta1.RedisPatchSchedule)
+	if !ok {
+		return errors.New(errNotRedisPatchSchedule)
+	}
+
+	r.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, r.Spec.ForProvider.ResourceGroupName, r.Spec.ForProvider.RedisName)
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteRedisPatchSchedule)
+}
@@ -0,0 +1,518 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redispatchschedule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-azure/apis/cache/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/redis/fake"
+)
+
+const (
+	name              = "coolSchedule"
+	uid               = types.UID("definitely-a-uuid")
+	redisName         = "coolRedis"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+)
+
+var (
+	startHour         = 2
+	maintenanceWindow = "PT5H"
+)
+
+type patchScheduleModifier func(*v1beta1.RedisPatchSchedule)
+
+func withConditions(c ...xpv1.Condition) patchScheduleModifier {
+	return func(r *v1beta1.RedisPatchSchedule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withType(s string) patchScheduleModifier {
+	return func(r *v1beta1.RedisPatchSchedule) { r.Status.AtProvider.Type = s }
+}
+
+func withID(s string) patchScheduleModifier {
+	return func(r *v1beta1.RedisPatchSchedule) { r.Status.AtProvider.ID = s }
+}
+
+func withScheduleEntries(e []v1beta1.ScheduleEntry) patchScheduleModifier {
+	return func(r *v1beta1.RedisPatchSchedule) { r.Spec.ForProvider.ScheduleEntries = e }
+}
+
+func scheduleEntries(d redis.DayOfWeek) *redis.ScheduleEntries {
+	return &redis.ScheduleEntries{ScheduleEntries: &[]redis.ScheduleEntry{{
+		DayOfWeek:         d,
+		StartHourUtc:      azure.ToInt32Ptr(startHour),
+		MaintenanceWindow: azure.ToStringPtr(maintenanceWindow),
+	}}}
+}
+
+func patchSchedule(sm ...patchScheduleModifier) *v1beta1.RedisPatchSchedule {
+	r := &v1beta1.RedisPatchSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1beta1.RedisPatchScheduleSpec{
+			ForProvider: v1beta1.RedisPatchScheduleParameters{
+				RedisName:         redisName,
+				ResourceGroupName: resourceGroupName,
+				ScheduleEntries: []v1beta1.ScheduleEntry{{
+					DayOfWeek:         string(redis.Saturday),
+					StartHourUTC:      &startHour,
+					MaintenanceWindow: azure.ToStringPtr(maintenanceWindow),
+				}},
+			},
+		},
+		Status: v1beta1.RedisPatchScheduleStatus{},
+	}
+
+	meta.SetExternalName(r, name)
+
+	for _, m := range sm {
+		m(r)
+	}
+
+	return r
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotRedisPatchSchedule": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{}},
+			want: want{
+				err: errors.New(errNotRedisPatchSchedule),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+					return redis.PatchSchedule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(),
+			},
+		},
+		"SuccessfulObserveUpToDate": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPatchSchedulesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+						return redis.PatchSchedule{
+							ID:              azure.ToStringPtr(resourceID),
+							Type:            azure.ToStringPtr(resourceType),
+							ScheduleEntries: scheduleEntries(redis.Saturday),
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Available()),
+					withType(resourceType),
+					withID(resourceID),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SuccessfulObserveNeedsUpdate": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPatchSchedulesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+						return redis.PatchSchedule{
+							ID:              azure.ToStringPtr(resourceID),
+							Type:            azure.ToStringPtr(resourceType),
+							ScheduleEntries: scheduleEntries(redis.Sunday),
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Available()),
+					withType(resourceType),
+					withID(resourceID),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SuccessfulLateInitialize": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPatchSchedulesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+						return redis.PatchSchedule{
+							ID:              azure.ToStringPtr(resourceID),
+							Type:            azure.ToStringPtr(resourceType),
+							ScheduleEntries: scheduleEntries(redis.Saturday),
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: patchSchedule(withScheduleEntries(nil)),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Available()),
+					withType(resourceType),
+					withID(resourceID),
+				),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedUpdateCR": {
+			ec: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				client: &fake.MockPatchSchedulesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+						return redis.PatchSchedule{ScheduleEntries: scheduleEntries(redis.Saturday)}, nil
+					},
+				},
+			},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg:  patchSchedule(),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+					return redis.PatchSchedule{}, errBoom
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg:  patchSchedule(),
+				err: errors.Wrap(errBoom, errGetRedisPatchSchedule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotRedisPatchSchedule": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{}},
+			want: want{
+				err: errors.New(errNotRedisPatchSchedule),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ redis.PatchSchedule) (redis.PatchSchedule, error) {
+					return redis.PatchSchedule{}, errBoom
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(errBoom, errCreateRedisPatchSchedule),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ redis.PatchSchedule) (redis.PatchSchedule, error) {
+					return redis.PatchSchedule{}, nil
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotRedisPatchSchedule": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{}},
+			want: want{
+				err: errors.New(errNotRedisPatchSchedule),
+			},
+		},
+		"UpdateError": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+					return redis.PatchSchedule{
+						ScheduleEntries: &redis.ScheduleEntries{},
+					}, nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ redis.PatchSchedule) (redis.PatchSchedule, error) {
+					return redis.PatchSchedule{}, errBoom
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg:  patchSchedule(),
+				err: errors.Wrap(errBoom, errUpdateRedisPatchSchedule),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (result redis.PatchSchedule, err error) {
+					return redis.PatchSchedule{
+						ScheduleEntries: &redis.ScheduleEntries{},
+					}, nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ redis.PatchSchedule) (redis.PatchSchedule, error) {
+					return redis.PatchSchedule{}, nil
+				},
+			}},
+
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}{
+		"NotRedisPatchSchedule": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{}},
+			want: want{
+				err: errors.New(errNotRedisPatchSchedule),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (result autorest.Response, err error) {
+					return autorest.Response{}, nil
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (result autorest.Response, err error) {
+					return autorest.Response{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					}
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockPatchSchedulesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (result autorest.Response, err error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			args: args{
+				mg: patchSchedule(),
+			},
+			want: want{
+				mg: patchSchedule(
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteRedisPatchSchedule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
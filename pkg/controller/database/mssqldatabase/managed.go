@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqldatabase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotMSSQLDatabase    = "managed resource is not an MSSQLDatabase"
+	errUpdateCR            = "cannot update MSSQLDatabase custom resource"
+	errCreateMSSQLDatabase = "cannot create MSSQLDatabase"
+	errUpdateMSSQLDatabase = "cannot update MSSQLDatabase"
+	errGetMSSQLDatabase    = "cannot get MSSQLDatabase"
+	errDeleteMSSQLDatabase = "cannot delete MSSQLDatabase"
+	errGetMSSQLServer      = "cannot get referenced MSSQLServer"
+	errFetchLastOperation  = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles MSSQLDatabases.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.MSSQLDatabaseGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.MSSQLDatabase{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLDatabaseGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := sql.NewDatabasesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl, sender: cl}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client sqlapi.DatabasesClientAPI
+	sender autorest.Sender
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLDatabase)
+	}
+
+	p := &cr.Spec.ForProvider
+	az, err := e.client.Get(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		if err := azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed
+		// successfully, so we check whether a creation is in motion.
+		creating := cr.Status.AtProvider.LastOperation.Method == http.MethodPut &&
+			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLDatabase)
+	}
+	database.LateInitializeMSSQLDatabase(p, az)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+	database.UpdateMSSQLDatabaseObservation(&cr.Status.AtProvider, az)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done.
+	if err := azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	switch cr.Status.AtProvider.Status {
+	case v1alpha3.MSSQLDatabaseStatusOnline:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	cd, err := e.connectionDetails(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  database.IsMSSQLDatabaseUpToDate(*p, az),
+		ConnectionDetails: cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLDatabase)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	op, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr), database.NewMSSQLDatabase(p))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLDatabase)
+	}
+	cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return managed.ExternalCreation{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLDatabase)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	p := cr.Spec.ForProvider
+	op, err := e.client.Update(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr), database.NewMSSQLDatabaseUpdate(p))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLDatabase)
+	}
+	cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.MSSQLDatabase)
+	if !ok {
+		return errors.New(errNotMSSQLDatabase)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if op := cr.Status.AtProvider.LastOperation; op.Method == http.MethodDelete && op.Status == azure.AsyncOperationStatusInProgress {
+		return nil
+	}
+	p := cr.Spec.ForProvider
+	op, err := e.client.Delete(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr))
+	if resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMSSQLDatabase)
+	}
+	if err == nil {
+		cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+			PollingURL: op.PollingURL(),
+			Method:     http.MethodDelete,
+		}
+	}
+	return errors.Wrap(azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+// connectionDetails returns the name and port of the supplied database along
+// with the endpoint, user and password of its server when the server is
+// referenced. An ADO.NET connection string for the database is returned as
+// well once the server has published all of them.
+func (e *external) connectionDetails(ctx context.Context, cr *v1alpha3.MSSQLDatabase) (managed.ConnectionDetails, error) {
+	name := meta.GetExternalName(cr)
+	cd := managed.ConnectionDetails{
+		database.ConnectionSecretDatabaseKey:  []byte(name),
+		xpv1.ResourceCredentialsSecretPortKey: []byte(database.MSSQLPort),
+	}
+	ref := cr.Spec.ForProvider.ServerNameRef
+	if ref == nil {
+		return cd, nil
+	}
+	s := &v1alpha3.MSSQLServer{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetMSSQLServer)
+	}
+	sd, err := database.GetServerConnectionDetails(ctx, e.kube, s)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range sd {
+		cd[k] = v
+	}
+	endpoint, eok := sd[xpv1.ResourceCredentialsSecretEndpointKey]
+	user, uok := sd[xpv1.ResourceCredentialsSecretUserKey]
+	pw, pok := sd[xpv1.ResourceCredentialsSecretPasswordKey]
+	if eok && uok && pok {
+		cd[database.ConnectionSecretConnectionStringKey] = []byte(database.MSSQLConnectionString(string(endpoint), name, string(user), string(pw)))
+	}
+	return cd, nil
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqldatabase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "cooldb"
+	serverName        = "coolserver"
+	resourceGroupName = "coolRG"
+	admin             = "cooladmin"
+	password          = "verysecure"
+	endpoint          = "coolserver.database.windows.net"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+)
+
+type modifier func(*v1alpha3.MSSQLDatabase)
+
+func withLastOperation(op apisv1alpha3.AsyncOperation) modifier {
+	return func(d *v1alpha3.MSSQLDatabase) { d.Status.AtProvider.LastOperation = op }
+}
+
+func withServerNameRef() modifier {
+	return func(d *v1alpha3.MSSQLDatabase) { d.Spec.ForProvider.ServerNameRef = &xpv1.Reference{Name: serverName} }
+}
+
+func mssqlDatabase(m ...modifier) *v1alpha3.MSSQLDatabase {
+	d := &v1alpha3.MSSQLDatabase{
+		Spec: v1alpha3.MSSQLDatabaseSpec{
+			ForProvider: v1alpha3.MSSQLDatabaseParameters{
+				ResourceGroupName: resourceGroupName,
+				ServerName:        serverName,
+				Location:          "westeurope",
+				SKU:               &v1alpha3.MSSQLSKU{Name: "S0"},
+			},
+		},
+	}
+	meta.SetExternalName(d, name)
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+// serverSecret returns a MockGetFn for an MSSQLServer that publishes the
+// supplied connection secret.
+func serverSecret(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1alpha3.MSSQLServer:
+			o.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "coolns", Name: "conn"})
+		case *corev1.Secret:
+			o.Data = data
+		}
+		return nil
+	}
+}
+
+func onlineDatabase(_ context.Context, _, _, _ string) (sql.Database, error) {
+	return sql.Database{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("S0")},
+		DatabaseProperties: &sql.DatabaseProperties{
+			Status: sql.DatabaseStatusOnline,
+		},
+	}, nil
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"ErrNotAnMSSQLDatabase": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotMSSQLDatabase),
+			},
+		},
+		"ErrGetDatabase": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (sql.Database, error) {
+					return sql.Database{}, errBoom
+				},
+			}},
+			mg: mssqlDatabase(),
+			want: want{
+				err: errors.Wrap(errBoom, errGetMSSQLDatabase),
+			},
+		},
+		"DatabaseNotFound": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockGet: func(_ context.Context, _, _, _ string) (sql.Database, error) {
+					return sql.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: mssqlDatabase(),
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DatabaseAvailable": {
+			e: &external{
+				kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLDatabasesClient{MockGet: onlineDatabase},
+			},
+			mg: mssqlDatabase(),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:  []byte(name),
+						xpv1.ResourceCredentialsSecretPortKey: []byte(database.MSSQLPort),
+					},
+				},
+			},
+		},
+		"SKUChanged": {
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLDatabasesClient{
+					MockGet: func(_ context.Context, _, _, _ string) (sql.Database, error) {
+						return sql.Database{
+							Sku:                &sql.Sku{Name: azure.ToStringPtr("Basic")},
+							DatabaseProperties: &sql.DatabaseProperties{Status: sql.DatabaseStatusOnline},
+						}, nil
+					},
+				},
+			},
+			mg: mssqlDatabase(),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:  []byte(name),
+						xpv1.ResourceCredentialsSecretPortKey: []byte(database.MSSQLPort),
+					},
+				},
+			},
+		},
+		"ErrGetServer": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet:    test.NewMockGetFn(errBoom),
+				},
+				client: &fake.MockMSSQLDatabasesClient{MockGet: onlineDatabase},
+			},
+			mg: mssqlDatabase(withServerNameRef()),
+			want: want{
+				err: errors.Wrap(errBoom, errGetMSSQLServer),
+			},
+		},
+		"ServerConnectionDetails": {
+			e: &external{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet: serverSecret(map[string][]byte{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+					}),
+				},
+				client: &fake.MockMSSQLDatabasesClient{MockGet: onlineDatabase},
+			},
+			mg: mssqlDatabase(withServerNameRef()),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						database.ConnectionSecretDatabaseKey:         []byte(name),
+						xpv1.ResourceCredentialsSecretPortKey:        []byte(database.MSSQLPort),
+						xpv1.ResourceCredentialsSecretEndpointKey:    []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:        []byte(admin),
+						xpv1.ResourceCredentialsSecretPasswordKey:    []byte(password),
+						database.ConnectionSecretConnectionStringKey: []byte(database.MSSQLConnectionString(endpoint, name, admin, password)),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   *v1alpha3.MSSQLDatabase
+		want *v1alpha3.MSSQLDatabase
+		err  error
+	}{
+		"ErrCreateDatabase": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   mssqlDatabase(),
+			want: mssqlDatabase(),
+			err:  errors.Wrap(errBoom, errCreateMSSQLDatabase),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					return sql.DatabasesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg:   mssqlDatabase(),
+			want: mssqlDatabase(withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodPut})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			tc.want.SetConditions(xpv1.Creating())
+			if diff := cmp.Diff(tc.want, tc.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"OperationInProgress": {
+			e:  &external{},
+			mg: mssqlDatabase(withLastOperation(apisv1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress})),
+		},
+		"ErrUpdateDatabase": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, _ sql.DatabaseUpdate) (sql.DatabasesUpdateFuture, error) {
+					return sql.DatabasesUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   mssqlDatabase(),
+			want: errors.Wrap(errBoom, errUpdateMSSQLDatabase),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, _ sql.DatabaseUpdate) (sql.DatabasesUpdateFuture, error) {
+					return sql.DatabasesUpdateFuture{}, nil
+				},
+			}},
+			mg: mssqlDatabase(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"ErrDeleteDatabase": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   mssqlDatabase(),
+			want: errors.Wrap(errBoom, errDeleteMSSQLDatabase),
+		},
+		"DatabaseNotFound": {
+			e: &external{client: &fake.MockMSSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: mssqlDatabase(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlelasticpool
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotMSSQLElasticPool    = "managed resource is not an MSSQLElasticPool"
+	errUpdateCR               = "cannot update MSSQLElasticPool custom resource"
+	errCreateMSSQLElasticPool = "cannot create MSSQLElasticPool"
+	errUpdateMSSQLElasticPool = "cannot update MSSQLElasticPool"
+	errGetMSSQLElasticPool    = "cannot get MSSQLElasticPool"
+	errDeleteMSSQLElasticPool = "cannot delete MSSQLElasticPool"
+	errFetchLastOperation     = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles MSSQLElasticPools.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.MSSQLElasticPoolGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.MSSQLElasticPool{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLElasticPoolGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := sql.NewElasticPoolsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl, sender: cl}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client sqlapi.ElasticPoolsClientAPI
+	sender autorest.Sender
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLElasticPool)
+	}
+
+	p := &cr.Spec.ForProvider
+	az, err := e.client.Get(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		if err := azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed
+		// successfully, so we check whether a creation is in motion.
+		creating := cr.Status.AtProvider.LastOperation.Method == http.MethodPut &&
+			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLElasticPool)
+	}
+	database.LateInitializeMSSQLElasticPool(p, az)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+	database.UpdateMSSQLElasticPoolObservation(&cr.Status.AtProvider, az)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done.
+	if err := azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	switch cr.Status.AtProvider.State {
+	case v1alpha3.MSSQLElasticPoolStateReady:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, err := database.IsMSSQLElasticPoolUpToDate(*p, az)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLElasticPool)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	pool, err := database.NewMSSQLElasticPool(p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	op, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr), pool)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLElasticPool)
+	}
+	cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	return managed.ExternalCreation{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLElasticPool)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	p := cr.Spec.ForProvider
+	u, err := database.NewMSSQLElasticPoolUpdate(p)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	op, err := e.client.Update(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr), u)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLElasticPool)
+	}
+	cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.MSSQLElasticPool)
+	if !ok {
+		return errors.New(errNotMSSQLElasticPool)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if op := cr.Status.AtProvider.LastOperation; op.Method == http.MethodDelete && op.Status == azure.AsyncOperationStatusInProgress {
+		return nil
+	}
+	p := cr.Spec.ForProvider
+	op, err := e.client.Delete(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(cr))
+	if resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMSSQLElasticPool)
+	}
+	if err == nil {
+		cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+			PollingURL: op.PollingURL(),
+			Method:     http.MethodDelete,
+		}
+	}
+	return errors.Wrap(azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
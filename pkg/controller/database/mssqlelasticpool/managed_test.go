@@ -0,0 +1,303 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlelasticpool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolpool"
+	serverName        = "coolserver"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+)
+
+type modifier func(*v1alpha3.MSSQLElasticPool)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(p *v1alpha3.MSSQLElasticPool) { p.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha3.MSSQLElasticPoolObservation) modifier {
+	return func(p *v1alpha3.MSSQLElasticPool) { p.Status.AtProvider = o }
+}
+
+func withMaxCapacity(c string) modifier {
+	return func(p *v1alpha3.MSSQLElasticPool) {
+		p.Spec.ForProvider.PerDatabaseSettings = &v1alpha3.MSSQLElasticPoolPerDatabaseSettings{MaxCapacity: &c}
+	}
+}
+
+func elasticPool(m ...modifier) *v1alpha3.MSSQLElasticPool {
+	p := &v1alpha3.MSSQLElasticPool{
+		Spec: v1alpha3.MSSQLElasticPoolSpec{
+			ForProvider: v1alpha3.MSSQLElasticPoolParameters{
+				ResourceGroupName: resourceGroupName,
+				ServerName:        serverName,
+				Location:          "westeurope",
+				SKU:               v1alpha3.MSSQLSKU{Name: "StandardPool", Capacity: to.IntPtr(50)},
+			},
+		},
+	}
+	meta.SetExternalName(p, name)
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		mg  resource.Managed
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"ErrGetElasticPool": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (sql.ElasticPool, error) {
+					return sql.ElasticPool{}, errBoom
+				},
+			}},
+			mg: elasticPool(),
+			want: want{
+				mg:  elasticPool(),
+				err: errors.Wrap(errBoom, errGetMSSQLElasticPool),
+			},
+		},
+		"ElasticPoolNotFound": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (sql.ElasticPool, error) {
+					return sql.ElasticPool{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: elasticPool(),
+			want: want{
+				mg: elasticPool(),
+				eo: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ElasticPoolAvailable": {
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLElasticPoolsClient{
+					MockGet: func(_ context.Context, _, _, _ string) (sql.ElasticPool, error) {
+						return sql.ElasticPool{
+							ID:  azure.ToStringPtr(resourceID),
+							Sku: &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Capacity: azure.ToInt32Ptr(50)},
+							ElasticPoolProperties: &sql.ElasticPoolProperties{
+								State: sql.ElasticPoolStateReady,
+							},
+						}, nil
+					},
+				},
+			},
+			mg: elasticPool(),
+			want: want{
+				mg: elasticPool(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha3.MSSQLElasticPoolObservation{ID: resourceID, State: v1alpha3.MSSQLElasticPoolStateReady}),
+				),
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CapacityChanged": {
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockMSSQLElasticPoolsClient{
+					MockGet: func(_ context.Context, _, _, _ string) (sql.ElasticPool, error) {
+						return sql.ElasticPool{
+							Sku: &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Capacity: azure.ToInt32Ptr(100)},
+							ElasticPoolProperties: &sql.ElasticPoolProperties{
+								State: sql.ElasticPoolStateCreating,
+							},
+						}, nil
+					},
+				},
+			},
+			mg: elasticPool(),
+			want: want{
+				mg: elasticPool(
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha3.MSSQLElasticPoolObservation{State: string(sql.ElasticPoolStateCreating)}),
+				),
+				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"ErrInvalidCapacity": {
+			e:    &external{},
+			mg:   elasticPool(withMaxCapacity("lots")),
+			want: errors.Wrap(errors.New(`strconv.ParseFloat: parsing "lots": invalid syntax`), "cannot parse perDatabaseSettings.maxCapacity"),
+		},
+		"ErrCreateElasticPool": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   elasticPool(),
+			want: errors.Wrap(errBoom, errCreateMSSQLElasticPool),
+		},
+		"Successful": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: elasticPool(withMaxCapacity("0.5")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"OperationInProgress": {
+			e: &external{},
+			mg: elasticPool(withObservation(v1alpha3.MSSQLElasticPoolObservation{
+				LastOperation: apisv1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress},
+			})),
+		},
+		"ErrUpdateElasticPool": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, _ sql.ElasticPoolUpdate) (sql.ElasticPoolsUpdateFuture, error) {
+					return sql.ElasticPoolsUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   elasticPool(),
+			want: errors.Wrap(errBoom, errUpdateMSSQLElasticPool),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"ErrDeleteElasticPool": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   elasticPool(),
+			want: errors.Wrap(errBoom, errDeleteMSSQLElasticPool),
+		},
+		"ElasticPoolNotFound": {
+			e: &external{client: &fake.MockMSSQLElasticPoolsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: elasticPool(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql/sqlapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotMSSQLServer      = "managed resource is not an MSSQLServer"
+	errUpdateCR            = "cannot update MSSQLServer custom resource"
+	errGenPassword         = "cannot generate admin password"
+	errCreateMSSQLServer   = "cannot create MSSQLServer"
+	errUpdateMSSQLServer   = "cannot update MSSQLServer"
+	errGetMSSQLServer      = "cannot get MSSQLServer"
+	errDeleteMSSQLServer   = "cannot delete MSSQLServer"
+	errFetchLastOperation  = "cannot fetch last operation"
+	errGetConnectionSecret = "cannot get connection secret"
+)
+
+// Setup adds a controller that reconciles MSSQLServers.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha3.MSSQLServerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha3.MSSQLServer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MSSQLServerGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := sql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl, sender: cl, newPasswordFn: password.Generate, fetchOperationFn: azure.FetchAsyncOperation}, nil
+}
+
+type external struct {
+	kube             client.Client
+	client           sqlapi.ServersClientAPI
+	sender           autorest.Sender
+	newPasswordFn    func() (password string, err error)
+	fetchOperationFn func(ctx context.Context, client autorest.Sender, op *apisv1alpha3.AsyncOperation) error
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMSSQLServer)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		if err := e.fetchOperationFn(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed
+		// successfully, so we check whether a creation is in motion.
+		creating := cr.Status.AtProvider.LastOperation.Method == http.MethodPut &&
+			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMSSQLServer)
+	}
+	database.LateInitializeMSSQLServer(&cr.Spec.ForProvider, az)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+	database.UpdateMSSQLServerObservation(&cr.Status.AtProvider, az)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done.
+	if err := e.fetchOperationFn(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	switch cr.Status.AtProvider.State {
+	case v1alpha3.MSSQLServerStateReady:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	pw, err := e.changedAdminPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cd, err := e.connectionDetails(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  pw == "" && database.IsMSSQLServerUpToDate(cr.Spec.ForProvider, az),
+		ConnectionDetails: cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMSSQLServer)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	pw, err := e.adminPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := ensurePasswordSalt(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	op, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), database.NewMSSQLServer(cr.Spec.ForProvider, pw))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMSSQLServer)
+	}
+	cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPut,
+	}
+	cr.Status.AdministratorLoginPasswordHash = database.HashPassword(pw, cr.Status.AdministratorLoginPasswordSalt)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, errors.Wrap(e.fetchOperationFn(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMSSQLServer)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	pw, err := e.changedAdminPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if pw != "" {
+		if err := ensurePasswordSalt(cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	op, err := e.client.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), database.NewMSSQLServerUpdate(cr.Spec.ForProvider, pw))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMSSQLServer)
+	}
+	cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+		PollingURL: op.PollingURL(),
+		Method:     http.MethodPatch,
+	}
+	if err := e.fetchOperationFn(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+		// We don't record the password as applied, so that it is sent and
+		// published again once the operation is no longer in progress.
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	if pw == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	cr.Status.AdministratorLoginPasswordHash = database.HashPassword(pw, cr.Status.AdministratorLoginPasswordSalt)
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.MSSQLServer)
+	if !ok {
+		return errors.New(errNotMSSQLServer)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if op := cr.Status.AtProvider.LastOperation; op.Method == http.MethodDelete && op.Status == azure.AsyncOperationStatusInProgress {
+		return nil
+	}
+	op, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrap(err, errDeleteMSSQLServer)
+	}
+	if err == nil {
+		cr.Status.AtProvider.LastOperation = apisv1alpha3.AsyncOperation{
+			PollingURL: op.PollingURL(),
+			Method:     http.MethodDelete,
+		}
+	}
+	return errors.Wrap(e.fetchOperationFn(ctx, e.sender, &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+// adminPassword returns the administrator login password the server should be
+// created with. It is read from the referenced secret if there is one and
+// generated otherwise.
+func (e *external) adminPassword(ctx context.Context, cr *v1alpha3.MSSQLServer) (string, error) {
+	if ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef; ref != nil {
+		return database.GetAdminPassword(ctx, e.kube, *ref)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+// changedAdminPassword returns the administrator login password stored in the
+// referenced secret if it differs from the one last pushed to the server, and
+// an empty string otherwise.
+func (e *external) changedAdminPassword(ctx context.Context, cr *v1alpha3.MSSQLServer) (string, error) {
+	ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef
+	if ref == nil {
+		return "", nil
+	}
+	pw, err := database.GetAdminPassword(ctx, e.kube, *ref)
+	if err != nil || database.HashPassword(pw, cr.Status.AdministratorLoginPasswordSalt) == cr.Status.AdministratorLoginPasswordHash {
+		return "", err
+	}
+	return pw, nil
+}
+
+// ensurePasswordSalt generates the salt with which the administrator login
+// password of the supplied server is hashed, unless it already has one.
+func ensurePasswordSalt(cr *v1alpha3.MSSQLServer) error {
+	if cr.Status.AdministratorLoginPasswordSalt != "" {
+		return nil
+	}
+	salt, err := database.NewPasswordSalt()
+	cr.Status.AdministratorLoginPasswordSalt = salt
+	return err
+}
+
+// connectionDetails returns the endpoint, user and port of the supplied
+// server. An ADO.NET connection string is returned as well once the password
+// of the server has been published to its connection secret.
+func (e *external) connectionDetails(ctx context.Context, cr *v1alpha3.MSSQLServer) (managed.ConnectionDetails, error) {
+	endpoint := cr.Status.AtProvider.FullyQualifiedDomainName
+	user := cr.Spec.ForProvider.AdministratorLogin
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(database.MSSQLPort),
+	}
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil || endpoint == "" {
+		return cd, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); resource.IgnoreNotFound(err) != nil {
+		return nil, errors.Wrap(err, errGetConnectionSecret)
+	}
+	if pw, ok := s.Data[xpv1.ResourceCredentialsSecretPasswordKey]; ok {
+		cd[database.ConnectionSecretConnectionStringKey] = []byte(database.MSSQLConnectionString(endpoint, "", user, string(pw)))
+	}
+	return cd, nil
+}
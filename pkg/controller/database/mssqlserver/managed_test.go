@@ -0,0 +1,513 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mssqlserver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	apisv1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+	"github.com/crossplane/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolserver"
+	resourceGroupName = "coolRG"
+	admin             = "cooladmin"
+	endpoint          = "coolserver.database.windows.net"
+
+	inProgressResponse = `{"status": "InProgress"}`
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+)
+
+const passwordSalt = "coolsalt"
+
+var passwordSecretRef = xpv1.SecretKeySelector{
+	SecretReference: xpv1.SecretReference{Namespace: "coolns", Name: "coolsecret"},
+	Key:             "password",
+}
+
+type modifier func(*v1alpha3.MSSQLServer)
+
+func withLastOperation(op apisv1alpha3.AsyncOperation) modifier {
+	return func(s *v1alpha3.MSSQLServer) { s.Status.AtProvider.LastOperation = op }
+}
+
+func withPasswordSecretRef(ref xpv1.SecretKeySelector) modifier {
+	return func(s *v1alpha3.MSSQLServer) { s.Spec.ForProvider.AdministratorLoginPasswordSecretRef = &ref }
+}
+
+func withPassword(pw string) modifier {
+	return func(s *v1alpha3.MSSQLServer) {
+		s.Status.AdministratorLoginPasswordSalt = passwordSalt
+		s.Status.AdministratorLoginPasswordHash = database.HashPassword(pw, passwordSalt)
+	}
+}
+
+func withConnectionSecret() modifier {
+	return func(s *v1alpha3.MSSQLServer) {
+		s.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "coolns", Name: "conn"})
+	}
+}
+
+func server(m ...modifier) *v1alpha3.MSSQLServer {
+	s := &v1alpha3.MSSQLServer{
+		Spec: v1alpha3.MSSQLServerSpec{
+			ForProvider: v1alpha3.MSSQLServerParameters{
+				ResourceGroupName:  resourceGroupName,
+				Location:           "westeurope",
+				AdministratorLogin: admin,
+			},
+		},
+	}
+	meta.SetExternalName(s, name)
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func secret(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func inProgress() autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Request:       req,
+			StatusCode:    http.StatusAccepted,
+			Body:          ioutil.NopCloser(strings.NewReader(inProgressResponse)),
+			ContentLength: int64(len(inProgressResponse)),
+		}, nil
+	})
+}
+
+func readyServer(_ context.Context, _, _ string) (sql.Server, error) {
+	return sql.Server{
+		ServerProperties: &sql.ServerProperties{
+			State:                    azure.ToStringPtr(v1alpha3.MSSQLServerStateReady),
+			FullyQualifiedDomainName: azure.ToStringPtr(endpoint),
+		},
+	}, nil
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"ErrNotAnMSSQLServer": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotMSSQLServer),
+			},
+		},
+		"ErrGetServer": {
+			e: &external{fetchOperationFn: azure.FetchAsyncOperation, client: &fake.MockMSSQLServersClient{
+				MockGet: func(_ context.Context, _, _ string) (sql.Server, error) {
+					return sql.Server{}, errBoom
+				},
+			}},
+			mg: server(),
+			want: want{
+				err: errors.Wrap(errBoom, errGetMSSQLServer),
+			},
+		},
+		"ServerNotFound": {
+			e: &external{fetchOperationFn: azure.FetchAsyncOperation, client: &fake.MockMSSQLServersClient{
+				MockGet: func(_ context.Context, _, _ string) (sql.Server, error) {
+					return sql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: server(),
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ServerCreating": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				client: &fake.MockMSSQLServersClient{
+					MockGet: func(_ context.Context, _, _ string) (sql.Server, error) {
+						return sql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+				sender: inProgress(),
+			},
+			mg: server(withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io"})),
+			want: want{
+				eo: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ServerAvailable": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				kube:             &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client:           &fake.MockMSSQLServersClient{MockGet: readyServer},
+			},
+			mg: server(),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(database.MSSQLPort),
+					},
+				},
+			},
+		},
+		"ConnectionSecretNotYetWritten": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "conn")),
+				},
+				client: &fake.MockMSSQLServersClient{MockGet: readyServer},
+			},
+			mg: server(withConnectionSecret()),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(database.MSSQLPort),
+					},
+				},
+			},
+		},
+		"ConnectionString": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet:    secret(map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte("verysecure")}),
+				},
+				client: &fake.MockMSSQLServersClient{MockGet: readyServer},
+			},
+			mg: server(withConnectionSecret()),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:    []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:        []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:        []byte(database.MSSQLPort),
+						database.ConnectionSecretConnectionStringKey: []byte(database.MSSQLConnectionString(endpoint, "", admin, "verysecure")),
+					},
+				},
+			},
+		},
+		"PasswordChanged": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockGet:    secret(map[string][]byte{passwordSecretRef.Key: []byte("newpassword")}),
+				},
+				client: &fake.MockMSSQLServersClient{MockGet: readyServer},
+			},
+			mg: server(withPasswordSecretRef(passwordSecretRef), withPassword("oldpassword")),
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(admin),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(database.MSSQLPort),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eo, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		ec       managed.ExternalCreation
+		password string
+		err      error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   *v1alpha3.MSSQLServer
+		want want
+	}{
+		"ErrGeneratePassword": {
+			e:  &external{newPasswordFn: func() (string, error) { return "", errBoom }},
+			mg: server(),
+			want: want{
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"ErrCreateServer": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				newPasswordFn:    func() (string, error) { return "verysecure", nil },
+				client: &fake.MockMSSQLServersClient{
+					MockCreateOrUpdate: func(_ context.Context, _, _ string, _ sql.Server) (sql.ServersCreateOrUpdateFuture, error) {
+						return sql.ServersCreateOrUpdateFuture{}, errBoom
+					},
+				},
+			},
+			mg: server(),
+			want: want{
+				err: errors.Wrap(errBoom, errCreateMSSQLServer),
+			},
+		},
+		"GeneratedPassword": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				newPasswordFn:    func() (string, error) { return "verysecure", nil },
+				client: &fake.MockMSSQLServersClient{
+					MockCreateOrUpdate: func(_ context.Context, _, _ string, s sql.Server) (sql.ServersCreateOrUpdateFuture, error) {
+						if diff := cmp.Diff("verysecure", azure.ToString(s.AdministratorLoginPassword)); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want password, +got password:\n%s", diff)
+						}
+						return sql.ServersCreateOrUpdateFuture{}, nil
+					},
+				},
+			},
+			mg: server(),
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("verysecure"),
+					},
+				},
+				password: "verysecure",
+			},
+		},
+		"ReferencedPassword": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				kube:             &test.MockClient{MockGet: secret(map[string][]byte{passwordSecretRef.Key: []byte("fromsecret")})},
+				client: &fake.MockMSSQLServersClient{
+					MockCreateOrUpdate: func(_ context.Context, _, _ string, _ sql.Server) (sql.ServersCreateOrUpdateFuture, error) {
+						return sql.ServersCreateOrUpdateFuture{}, nil
+					},
+				},
+			},
+			mg: server(withPasswordSecretRef(passwordSecretRef)),
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("fromsecret"),
+					},
+				},
+				password: "fromsecret",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ec, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.password == "" {
+				return
+			}
+			if tc.mg.Status.AdministratorLoginPasswordSalt == "" {
+				t.Errorf("Create(...): want password salt, got none")
+			}
+			hash := database.HashPassword(tc.want.password, tc.mg.Status.AdministratorLoginPasswordSalt)
+			if diff := cmp.Diff(hash, tc.mg.Status.AdministratorLoginPasswordHash); diff != "" {
+				t.Errorf("Create(...): -want password hash, +got password hash:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		eu  managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"OperationInProgress": {
+			e:  &external{},
+			mg: server(withLastOperation(apisv1alpha3.AsyncOperation{Status: azure.AsyncOperationStatusInProgress})),
+		},
+		"ErrUpdateServer": {
+			e: &external{fetchOperationFn: azure.FetchAsyncOperation, client: &fake.MockMSSQLServersClient{
+				MockUpdate: func(_ context.Context, _, _ string, _ sql.ServerUpdate) (sql.ServersUpdateFuture, error) {
+					return sql.ServersUpdateFuture{}, errBoom
+				},
+			}},
+			mg: server(),
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateMSSQLServer),
+			},
+		},
+		"PasswordChanged": {
+			e: &external{
+				fetchOperationFn: azure.FetchAsyncOperation,
+				kube:             &test.MockClient{MockGet: secret(map[string][]byte{passwordSecretRef.Key: []byte("newpassword")})},
+				client: &fake.MockMSSQLServersClient{
+					MockUpdate: func(_ context.Context, _, _ string, _ sql.ServerUpdate) (sql.ServersUpdateFuture, error) {
+						return sql.ServersUpdateFuture{}, nil
+					},
+				},
+			},
+			mg: server(withPasswordSecretRef(passwordSecretRef), withPassword("oldpassword")),
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("newpassword"),
+					},
+				},
+			},
+		},
+		"PasswordChangedErrFetchLastOperation": {
+			e: &external{
+				fetchOperationFn: func(_ context.Context, _ autorest.Sender, _ *apisv1alpha3.AsyncOperation) error { return errBoom },
+				kube:             &test.MockClient{MockGet: secret(map[string][]byte{passwordSecretRef.Key: []byte("newpassword")})},
+				client: &fake.MockMSSQLServersClient{
+					MockUpdate: func(_ context.Context, _, _ string, _ sql.ServerUpdate) (sql.ServersUpdateFuture, error) {
+						return sql.ServersUpdateFuture{}, nil
+					},
+				},
+			},
+			mg: server(withPasswordSecretRef(passwordSecretRef), withPassword("oldpassword")),
+			want: want{
+				err: errors.Wrap(errBoom, errFetchLastOperation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"ErrDeleteServer": {
+			e: &external{fetchOperationFn: azure.FetchAsyncOperation, client: &fake.MockMSSQLServersClient{
+				MockDelete: func(_ context.Context, _, _ string) (sql.ServersDeleteFuture, error) {
+					return sql.ServersDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   server(),
+			want: errors.Wrap(errBoom, errDeleteMSSQLServer),
+		},
+		"ServerNotFound": {
+			e: &external{fetchOperationFn: azure.FetchAsyncOperation, client: &fake.MockMSSQLServersClient{
+				MockDelete: func(_ context.Context, _, _ string) (sql.ServersDeleteFuture, error) {
+					return sql.ServersDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: server(),
+		},
+		"DeletionInProgress": {
+			e:  &external{},
+			mg: server(withLastOperation(apisv1alpha3.AsyncOperation{Method: http.MethodDelete, Status: azure.AsyncOperationStatusInProgress})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
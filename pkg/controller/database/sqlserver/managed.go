@@ -0,0 +1,391 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errUpdateCR           = "cannot update %s custom resource"
+	errGenPassword        = "cannot generate admin password"
+	errNotServer          = "managed resource is not a %s"
+	errCreateServer       = "cannot create %s"
+	errUpdateServer       = "cannot update %s"
+	errGetServer          = "cannot get %s"
+	errDeleteServer       = "cannot delete %s"
+	errFetchLastOperation = "cannot fetch last operation"
+)
+
+// An engine adapts the engine agnostic server controller to the managed
+// resource kind and the Azure API of a single database engine.
+type engine struct {
+	gvk       schema.GroupVersionKind
+	object    resource.Managed
+	newClient func(subscriptionID string, auth autorest.Authorizer) database.SQLServerAPI
+	server    func(mg resource.Managed) (*v1beta1.SQLServerParameters, *v1beta1.SQLServerStatus, bool)
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1beta1.MySQLServerGroupVersionKind,
+	object: &v1beta1.MySQLServer{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.SQLServerAPI {
+		cl := mysql.NewServersClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLServersClient(cl)
+	},
+	server: func(mg resource.Managed) (*v1beta1.SQLServerParameters, *v1beta1.SQLServerStatus, bool) {
+		cr, ok := mg.(*v1beta1.MySQLServer)
+		if !ok {
+			return nil, nil, false
+		}
+		return &cr.Spec.ForProvider, &cr.Status, true
+	},
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1beta1.PostgreSQLServerGroupVersionKind,
+	object: &v1beta1.PostgreSQLServer{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.SQLServerAPI {
+		cl := postgresql.NewServersClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLServersClient(cl)
+	},
+	server: func(mg resource.Managed) (*v1beta1.SQLServerParameters, *v1beta1.SQLServerStatus, bool) {
+		cr, ok := mg.(*v1beta1.PostgreSQLServer)
+		if !ok {
+			return nil, nil, false
+		}
+		return &cr.Spec.ForProvider, &cr.Status, true
+	},
+}
+
+var mariadbEngine = &engine{
+	gvk:    v1beta1.MariaDBServerGroupVersionKind,
+	object: &v1beta1.MariaDBServer{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.SQLServerAPI {
+		cl := mariadb.NewServersClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMariaDBServerClient(cl)
+	},
+	server: func(mg resource.Managed) (*v1beta1.SQLServerParameters, *v1beta1.SQLServerStatus, bool) {
+		cr, ok := mg.(*v1beta1.MariaDBServer)
+		if !ok {
+			return nil, nil, false
+		}
+		return &cr.Spec.ForProvider, &cr.Status, true
+	},
+}
+
+// SetupMySQLServer adds a controller that reconciles MySQLServers.
+func SetupMySQLServer(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServer adds a controller that reconciles PostgreSQLServers.
+func SetupPostgreSQLServer(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+// SetupMariaDBServer adds a controller that reconciles MariaDBServers.
+func SetupMariaDBServer(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mariadbEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)
+	return &external{kube: c.client, engine: c.engine, client: cl, newPasswordFn: password.Generate, fetchOperationFn: azure.FetchAsyncOperation}, nil
+}
+
+type external struct {
+	kube             client.Client
+	engine           *engine
+	client           database.SQLServerAPI
+	newPasswordFn    func() (password string, err error)
+	fetchOperationFn func(ctx context.Context, client autorest.Sender, op *v1alpha3.AsyncOperation) error
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, s, ok := e.engine.server(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotServer, e.engine.gvk.Kind)
+	}
+
+	server, err := e.client.GetServer(ctx, p.ResourceGroupName, meta.GetExternalName(mg))
+	if azure.IsNotFound(err) {
+		if err := e.fetchOperationFn(ctx, e.client.GetRESTClient(), &s.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound for GET calls until creation is completed
+		// successfully and we cannot return `ResourceExists: false` during creation
+		// since this will cause `Create` to be called again and it's not idempotent.
+		// So, we check whether a creation operation in fact is in motion.
+		creating := s.AtProvider.LastOperation.Method == http.MethodPut &&
+			s.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetServer, e.engine.gvk.Kind)
+	}
+	database.LateInitializeServer(p, server)
+	if err := e.kube.Update(ctx, mg); err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errUpdateCR, e.engine.gvk.Kind)
+	}
+	database.UpdateServerObservation(&s.AtProvider, server)
+	// We make this call after kube.Update since it doesn't update the
+	// status subresource but fetches the the whole object after it's done. So,
+	// changes to status has to be done after kube.Update in order not to get them
+	// lost.
+	if err := e.fetchOperationFn(ctx, e.client.GetRESTClient(), &s.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	switch s.AtProvider.UserVisibleState {
+	case v1beta1.StateReady:
+		mg.SetConditions(xpv1.Available())
+	default:
+		mg.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, err := e.isUpToDate(ctx, *p, *s, server)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(s.AtProvider.FullyQualifiedDomainName),
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", p.AdministratorLogin, meta.GetExternalName(mg))),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, s, ok := e.engine.server(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotServer, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+	// Servers that are restored or replicated retain the administrator login
+	// password of their source server. The password in the referenced secret,
+	// if any, is pushed to them with an update once they are created.
+	if !database.IsDefaultCreateMode(*p) {
+		if err := e.createServer(ctx, mg, *p, s, ""); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		return managed.ExternalCreation{}, errors.Wrap(
+			e.fetchOperationFn(ctx, e.client.GetRESTClient(), &s.AtProvider.LastOperation),
+			errFetchLastOperation)
+	}
+	pw, err := e.adminPassword(ctx, *p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := ensurePasswordSalt(s); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.createServer(ctx, mg, *p, s, pw); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	s.AdministratorLoginPasswordHash = database.HashPassword(pw, s.AdministratorLoginPasswordSalt)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, errors.Wrap(
+		e.fetchOperationFn(ctx, e.client.GetRESTClient(), &s.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	p, s, ok := e.engine.server(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotServer, e.engine.gvk.Kind)
+	}
+	if s.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	pw, err := e.changedAdminPassword(ctx, *p, *s)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if pw != "" {
+		if err := ensurePasswordSalt(s); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	url, err := e.client.UpdateServer(ctx, meta.GetExternalName(mg), *p, s.AtProvider.ReplicationRole, pw)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateServer, e.engine.gvk.Kind)
+	}
+	s.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: url,
+		Method:     http.MethodPatch,
+	}
+	if err := e.fetchOperationFn(ctx, e.client.GetRESTClient(), &s.AtProvider.LastOperation); err != nil {
+		// We don't record the password as applied, so that it is sent and
+		// published again once the operation is no longer in progress.
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFetchLastOperation)
+	}
+	if pw == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	s.AdministratorLoginPasswordHash = database.HashPassword(pw, s.AdministratorLoginPasswordSalt)
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, s, ok := e.engine.server(mg)
+	if !ok {
+		return errors.Errorf(errNotServer, e.engine.gvk.Kind)
+	}
+	mg.SetConditions(xpv1.Deleting())
+	if s.AtProvider.UserVisibleState == v1beta1.StateDropping {
+		return nil
+	}
+	url, err := e.client.DeleteServer(ctx, p.ResourceGroupName, meta.GetExternalName(mg))
+	if resource.Ignore(azure.IsNotFound, err) != nil {
+		return errors.Wrapf(err, errDeleteServer, e.engine.gvk.Kind)
+	}
+	if err == nil {
+		s.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+			PollingURL: url,
+			Method:     http.MethodDelete,
+		}
+	}
+
+	return errors.Wrap(
+		e.fetchOperationFn(ctx, e.client.GetRESTClient(), &s.AtProvider.LastOperation),
+		errFetchLastOperation)
+}
+
+// createServer creates the server and records the creation as the last
+// operation of the supplied status.
+func (e *external) createServer(ctx context.Context, mg resource.Managed, p v1beta1.SQLServerParameters, s *v1beta1.SQLServerStatus, adminPassword string) error {
+	url, err := e.client.CreateServer(ctx, meta.GetExternalName(mg), p, adminPassword)
+	if err != nil {
+		return errors.Wrapf(err, errCreateServer, e.engine.gvk.Kind)
+	}
+	s.AtProvider.LastOperation = v1alpha3.AsyncOperation{
+		PollingURL: url,
+		Method:     http.MethodPut,
+	}
+	return nil
+}
+
+// isUpToDate returns true if the supplied server is in sync with the desired
+// parameters and the administrator login password has not been changed.
+func (e *external) isUpToDate(ctx context.Context, p v1beta1.SQLServerParameters, s v1beta1.SQLServerStatus, server database.Server) (bool, error) {
+	if !database.IsServerUpToDate(p, server) {
+		return false, nil
+	}
+	pw, err := e.changedAdminPassword(ctx, p, s)
+	return pw == "", err
+}
+
+// adminPassword returns the administrator login password the server should be
+// created with. It is read from the referenced secret if there is one and
+// generated otherwise.
+func (e *external) adminPassword(ctx context.Context, p v1beta1.SQLServerParameters) (string, error) {
+	if ref := p.AdministratorLoginPasswordSecretRef; ref != nil {
+		return database.GetAdminPassword(ctx, e.kube, *ref)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+// changedAdminPassword returns the administrator login password stored in the
+// referenced secret if it differs from the one last pushed to the server, and
+// an empty string otherwise. Replicas share the password of their master
+// server, so the password of a replica is never changed.
+func (e *external) changedAdminPassword(ctx context.Context, p v1beta1.SQLServerParameters, s v1beta1.SQLServerStatus) (string, error) {
+	ref := p.AdministratorLoginPasswordSecretRef
+	if ref == nil || s.AtProvider.ReplicationRole == v1beta1.ReplicationRoleReplica {
+		return "", nil
+	}
+	pw, err := database.GetAdminPassword(ctx, e.kube, *ref)
+	if err != nil || database.HashPassword(pw, s.AdministratorLoginPasswordSalt) == s.AdministratorLoginPasswordHash {
+		return "", err
+	}
+	return pw, nil
+}
+
+// ensurePasswordSalt generates the salt with which the administrator login
+// password of the supplied server is hashed, unless it already has one.
+func ensurePasswordSalt(s *v1beta1.SQLServerStatus) error {
+	if s.AdministratorLoginPasswordSalt != "" {
+		return nil
+	}
+	salt, err := database.NewPasswordSalt()
+	s.AdministratorLoginPasswordSalt = salt
+	return err
+}
@@ -0,0 +1,841 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azurev1alpha3 "github.com/crossplane/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.SQLServerAPI     = &MockSQLServerAPI{}
+)
+
+type MockSQLServerAPI struct {
+	MockGetServer     func(ctx context.Context, resourceGroupName, serverName string) (database.Server, error)
+	MockCreateServer  func(ctx context.Context, serverName string, s v1beta1.SQLServerParameters, adminPassword string) (string, error)
+	MockUpdateServer  func(ctx context.Context, serverName string, s v1beta1.SQLServerParameters, replicationRole, adminPassword string) (string, error)
+	MockDeleteServer  func(ctx context.Context, resourceGroupName, serverName string) (string, error)
+	MockGetRESTClient func() autorest.Sender
+}
+
+func (m *MockSQLServerAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+func (m *MockSQLServerAPI) GetServer(ctx context.Context, resourceGroupName, serverName string) (database.Server, error) {
+	return m.MockGetServer(ctx, resourceGroupName, serverName)
+}
+
+func (m *MockSQLServerAPI) CreateServer(ctx context.Context, serverName string, s v1beta1.SQLServerParameters, adminPassword string) (string, error) {
+	return m.MockCreateServer(ctx, serverName, s, adminPassword)
+}
+
+func (m *MockSQLServerAPI) UpdateServer(ctx context.Context, serverName string, s v1beta1.SQLServerParameters, replicationRole, adminPassword string) (string, error) {
+	return m.MockUpdateServer(ctx, serverName, s, replicationRole, adminPassword)
+}
+
+func (m *MockSQLServerAPI) DeleteServer(ctx context.Context, resourceGroupName, serverName string) (string, error) {
+	return m.MockDeleteServer(ctx, resourceGroupName, serverName)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine, mariadbEngine}
+
+type modifier func(resource.Managed, *v1beta1.SQLServerParameters, *v1beta1.SQLServerStatus)
+
+func withExternalName(name string) modifier {
+	return func(mg resource.Managed, _ *v1beta1.SQLServerParameters, _ *v1beta1.SQLServerStatus) {
+		meta.SetExternalName(mg, name)
+	}
+}
+
+func withAdminName(name string) modifier {
+	return func(_ resource.Managed, p *v1beta1.SQLServerParameters, _ *v1beta1.SQLServerStatus) {
+		p.AdministratorLogin = name
+	}
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(_ resource.Managed, _ *v1beta1.SQLServerParameters, s *v1beta1.SQLServerStatus) {
+		s.AtProvider.LastOperation = op
+	}
+}
+
+func withPasswordSecretRef(ref xpv1.SecretKeySelector) modifier {
+	return func(_ resource.Managed, p *v1beta1.SQLServerParameters, _ *v1beta1.SQLServerStatus) {
+		p.AdministratorLoginPasswordSecretRef = &ref
+	}
+}
+
+func withPassword(pw string) modifier {
+	return func(_ resource.Managed, _ *v1beta1.SQLServerParameters, s *v1beta1.SQLServerStatus) {
+		s.AdministratorLoginPasswordSalt = passwordSalt
+		s.AdministratorLoginPasswordHash = database.HashPassword(pw, passwordSalt)
+	}
+}
+
+func withCreateMode(mode, sourceID string) modifier {
+	return func(_ resource.Managed, p *v1beta1.SQLServerParameters, _ *v1beta1.SQLServerStatus) {
+		p.CreateMode = &mode
+		p.SourceServerID = &sourceID
+	}
+}
+
+func withReplicationRole(role string) modifier {
+	return func(_ resource.Managed, p *v1beta1.SQLServerParameters, _ *v1beta1.SQLServerStatus) {
+		p.ReplicationRole = &role
+	}
+}
+
+func withObservedReplicationRole(role string) modifier {
+	return func(_ resource.Managed, _ *v1beta1.SQLServerParameters, s *v1beta1.SQLServerStatus) {
+		s.AtProvider.ReplicationRole = role
+	}
+}
+
+func server(e *engine, m ...modifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	p, s, _ := e.server(mg)
+
+	for _, mod := range m {
+		mod(mg, p, s)
+	}
+	return mg
+}
+
+const (
+	inProgressResponse = `{"status": "InProgress"}`
+)
+
+const passwordSalt = "coolsalt"
+
+var passwordSecretRef = xpv1.SecretKeySelector{
+	SecretReference: xpv1.SecretReference{Namespace: "coolns", Name: "coolsecret"},
+	Key:             "password",
+}
+
+func passwordSecret(pw string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{passwordSecretRef.Key: []byte(pw)}
+		return nil
+	}
+}
+
+func noopSender() autorest.Sender {
+	return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	name := "coolserver"
+	endpoint := "coolazure.example.prg"
+	admin := "cooladmin"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eo  managed.ExternalObservation
+		err error
+	}
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			e    managed.ExternalClient
+			args args
+			want want
+		}{
+			"ErrNotAServer": {
+				e: &external{engine: e},
+				args: args{
+					ctx: context.Background(),
+				},
+				want: want{
+					err: errors.Errorf(errNotServer, e.gvk.Kind),
+				},
+			},
+			"ErrGetServer": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{}, errBoom
+						},
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errGetServer, e.gvk.Kind),
+				},
+			},
+			"ServerCreating": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+						},
+						MockGetRESTClient: func() autorest.Sender {
+							return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+								return &http.Response{
+									Request:       req,
+									StatusCode:    http.StatusAccepted,
+									Body:          ioutil.NopCloser(strings.NewReader(inProgressResponse)),
+									ContentLength: int64(len([]byte(inProgressResponse))),
+								}, nil
+							})
+						},
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io"})),
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists: true,
+					},
+				},
+			},
+			"ServerNotFound": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+						},
+						MockGetRESTClient: func() autorest.Sender {
+							return nil
+						},
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists: false,
+					},
+				},
+			},
+			"ServerAvailable": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+					},
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{
+								SKU:                      &database.ServerSKU{},
+								UserVisibleState:         v1beta1.StateReady,
+								FullyQualifiedDomainName: endpoint,
+								StorageProfile:           &database.ServerStorageProfile{},
+							}, nil
+						},
+						MockGetRESTClient: func() autorest.Sender {
+							return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+								return nil, nil
+							})
+						},
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg: server(e,
+						withExternalName(name),
+						withAdminName(admin),
+					),
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+						ConnectionDetails: managed.ConnectionDetails{
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						},
+					},
+				},
+			},
+			"PasswordChanged": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockGet:    passwordSecret("newpassword"),
+					},
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{
+								SKU:                      &database.ServerSKU{},
+								UserVisibleState:         v1beta1.StateReady,
+								FullyQualifiedDomainName: endpoint,
+								StorageProfile:           &database.ServerStorageProfile{},
+							}, nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg: server(e,
+						withExternalName(name),
+						withAdminName(admin),
+						withPasswordSecretRef(passwordSecretRef),
+						withPassword("oldpassword"),
+					),
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+						ConnectionDetails: managed.ConnectionDetails{
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						},
+					},
+				},
+			},
+			"ReplicaNeedsPromotion": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+					},
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{
+								SKU:                      &database.ServerSKU{},
+								UserVisibleState:         v1beta1.StateReady,
+								FullyQualifiedDomainName: endpoint,
+								StorageProfile:           &database.ServerStorageProfile{},
+								ReplicationRole:          v1beta1.ReplicationRoleReplica,
+							}, nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg: server(e,
+						withExternalName(name),
+						withAdminName(admin),
+						withReplicationRole(v1beta1.ReplicationRoleNone),
+					),
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: false,
+						ConnectionDetails: managed.ConnectionDetails{
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						},
+					},
+				},
+			},
+			"ReplicaPasswordNotChanged": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockGet:    passwordSecret("newpassword"),
+					},
+					client: &MockSQLServerAPI{
+						MockGetServer: func(_ context.Context, _, _ string) (database.Server, error) {
+							return database.Server{
+								SKU:                      &database.ServerSKU{},
+								UserVisibleState:         v1beta1.StateReady,
+								FullyQualifiedDomainName: endpoint,
+								StorageProfile:           &database.ServerStorageProfile{},
+								ReplicationRole:          v1beta1.ReplicationRoleReplica,
+							}, nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg: server(e,
+						withExternalName(name),
+						withAdminName(admin),
+						withPasswordSecretRef(passwordSecretRef),
+					),
+				},
+				want: want{
+					eo: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+						ConnectionDetails: managed.ConnectionDetails{
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						},
+					},
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				eo, err := tc.e.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		ec  managed.ExternalCreation
+		err error
+	}
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			e    managed.ExternalClient
+			args args
+			want want
+		}{
+			"ErrNotAServer": {
+				e: &external{engine: e},
+				args: args{
+					ctx: context.Background(),
+				},
+				want: want{
+					err: errors.Errorf(errNotServer, e.gvk.Kind),
+				},
+			},
+			"ErrGeneratePassword": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					newPasswordFn:    func() (string, error) { return "", errBoom },
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: want{
+					err: errors.Wrap(errBoom, errGenPassword),
+				},
+			},
+			"ErrCreateServer": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockCreateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, _ string) (string, error) {
+							return "", errBoom
+						},
+					},
+					newPasswordFn: func() (string, error) { return password, nil },
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: want{
+					err: errors.Wrapf(errBoom, errCreateServer, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockCreateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, _ string) (string, error) {
+							return "", nil
+						},
+						MockGetRESTClient: func() autorest.Sender {
+							return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+								return nil, nil
+							})
+						},
+					},
+					newPasswordFn: func() (string, error) { return password, nil },
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: want{
+					ec: managed.ExternalCreation{
+						ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+					},
+				},
+			},
+			"ErrGetPasswordSecret": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube:             &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withPasswordSecretRef(passwordSecretRef)),
+				},
+				want: want{
+					err: errors.Wrap(errBoom, "cannot get administrator login password secret"),
+				},
+			},
+			"SuccessfulWithPasswordSecret": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube:             &test.MockClient{MockGet: passwordSecret(password)},
+					client: &MockSQLServerAPI{
+						MockCreateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, pw string) (string, error) {
+							if pw != password {
+								return "", errBoom
+							}
+							return "", nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withPasswordSecretRef(passwordSecretRef)),
+				},
+				want: want{
+					ec: managed.ExternalCreation{
+						ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+					},
+				},
+			},
+			"SuccessfulGeoRestore": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockCreateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, pw string) (string, error) {
+							if pw != "" {
+								return "", errBoom
+							}
+							return "", nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+					newPasswordFn: func() (string, error) { return password, nil },
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withCreateMode(v1beta1.CreateModeGeoRestore, "coolsource")),
+				},
+				want: want{
+					ec: managed.ExternalCreation{},
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				ec, err := tc.e.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
+					t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu  managed.ExternalUpdate
+		mg  resource.Managed
+		err error
+	}
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			e    managed.ExternalClient
+			args args
+			want want
+		}{
+			"ErrNotAServer": {
+				e: &external{engine: e},
+				args: args{
+					ctx: context.Background(),
+				},
+				want: want{
+					err: errors.Errorf(errNotServer, e.gvk.Kind),
+				},
+			},
+			"ErrUpdateServer": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockUpdateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, _, _ string) (string, error) {
+							return "", errBoom
+						},
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: want{
+					mg:  server(e),
+					err: errors.Wrapf(errBoom, errUpdateServer, e.gvk.Kind),
+				},
+			},
+			"PasswordUnchanged": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube:             &test.MockClient{MockGet: passwordSecret(password)},
+					client: &MockSQLServerAPI{
+						MockUpdateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, _, pw string) (string, error) {
+							if pw != "" {
+								return "", errBoom
+							}
+							return "", nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withPasswordSecretRef(passwordSecretRef), withPassword(password)),
+				},
+				want: want{
+					mg: server(e, withPasswordSecretRef(passwordSecretRef), withPassword(password), withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch})),
+				},
+			},
+			"PasswordRotated": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					kube:             &test.MockClient{MockGet: passwordSecret(password)},
+					client: &MockSQLServerAPI{
+						MockUpdateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, _, pw string) (string, error) {
+							if pw != password {
+								return "", errBoom
+							}
+							return "", nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withPasswordSecretRef(passwordSecretRef), withPassword("oldpassword")),
+				},
+				want: want{
+					eu: managed.ExternalUpdate{
+						ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+					},
+					mg: server(e, withPasswordSecretRef(passwordSecretRef), withPassword(password), withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch})),
+				},
+			},
+			"PasswordRotatedErrFetchLastOperation": {
+				e: &external{
+					fetchOperationFn: func(_ context.Context, _ autorest.Sender, _ *azurev1alpha3.AsyncOperation) error { return errBoom },
+					engine:           e,
+					kube:             &test.MockClient{MockGet: passwordSecret(password)},
+					client: &MockSQLServerAPI{
+						MockUpdateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, _, pw string) (string, error) {
+							if pw != password {
+								return "", errBoom
+							}
+							return "", nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withPasswordSecretRef(passwordSecretRef), withPassword("oldpassword")),
+				},
+				want: want{
+					mg:  server(e, withPasswordSecretRef(passwordSecretRef), withPassword("oldpassword"), withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch})),
+					err: errors.Wrap(errBoom, errFetchLastOperation),
+				},
+			},
+			"PromoteReplica": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockUpdateServer: func(_ context.Context, _ string, _ v1beta1.SQLServerParameters, role, _ string) (string, error) {
+							if role != v1beta1.ReplicationRoleReplica {
+								return "", errBoom
+							}
+							return "", nil
+						},
+						MockGetRESTClient: noopSender,
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e, withReplicationRole(v1beta1.ReplicationRoleNone), withObservedReplicationRole(v1beta1.ReplicationRoleReplica)),
+				},
+				want: want{
+					mg: server(e, withReplicationRole(v1beta1.ReplicationRoleNone), withObservedReplicationRole(v1beta1.ReplicationRoleReplica), withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch})),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+					t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+					t.Errorf("tc.e.Update(...): -want managed resource, +got managed resource:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			e    managed.ExternalClient
+			args args
+			want error
+		}{
+			"ErrNotAServer": {
+				e: &external{engine: e},
+				args: args{
+					ctx: context.Background(),
+				},
+				want: errors.Errorf(errNotServer, e.gvk.Kind),
+			},
+			"ErrDeleteServer": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockDeleteServer: func(_ context.Context, _, _ string) (string, error) { return "", errBoom },
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: errors.Wrapf(errBoom, errDeleteServer, e.gvk.Kind),
+			},
+			"Successful": {
+				e: &external{
+					fetchOperationFn: azure.FetchAsyncOperation,
+					engine:           e,
+					client: &MockSQLServerAPI{
+						MockDeleteServer: func(_ context.Context, _, _ string) (string, error) { return "", nil },
+						MockGetRESTClient: func() autorest.Sender {
+							return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+								return nil, nil
+							})
+						},
+					},
+				},
+				args: args{
+					ctx: context.Background(),
+					mg:  server(e),
+				},
+				want: nil,
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				got := tc.e.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserveractivedirectoryadministrator
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotAdministrator    = "managed resource is not a %s"
+	errCreateAdministrator = "cannot create %s"
+	errUpdateAdministrator = "cannot update %s"
+	errGetAdministrator    = "cannot get %s"
+	errDeleteAdministrator = "cannot delete %s"
+)
+
+// An engine adapts the engine agnostic Active Directory administrator
+// controller to the managed resource kind and the Azure API of a single
+// database engine.
+type engine struct {
+	gvk           schema.GroupVersionKind
+	object        resource.Managed
+	newClient     func(subscriptionID string, auth autorest.Authorizer) database.ServerAdministratorAPI
+	administrator func(mg resource.Managed) (*v1alpha3.ActiveDirectoryAdministratorParameters, *v1alpha3.ActiveDirectoryAdministratorStatus, bool)
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1alpha3.MySQLServerActiveDirectoryAdministratorGroupVersionKind,
+	object: &v1alpha3.MySQLServerActiveDirectoryAdministrator{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.ServerAdministratorAPI {
+		cl := mysql.NewServerAdministratorsClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLServerAdministratorsClient(cl)
+	},
+	administrator: func(mg resource.Managed) (*v1alpha3.ActiveDirectoryAdministratorParameters, *v1alpha3.ActiveDirectoryAdministratorStatus, bool) {
+		a, ok := mg.(*v1alpha3.MySQLServerActiveDirectoryAdministrator)
+		if !ok {
+			return nil, nil, false
+		}
+		return &a.Spec.ForProvider, &a.Status, true
+	},
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1alpha3.PostgreSQLServerActiveDirectoryAdministratorGroupVersionKind,
+	object: &v1alpha3.PostgreSQLServerActiveDirectoryAdministrator{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.ServerAdministratorAPI {
+		cl := postgresql.NewServerAdministratorsClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLServerAdministratorsClient(cl)
+	},
+	administrator: func(mg resource.Managed) (*v1alpha3.ActiveDirectoryAdministratorParameters, *v1alpha3.ActiveDirectoryAdministratorStatus, bool) {
+		a, ok := mg.(*v1alpha3.PostgreSQLServerActiveDirectoryAdministrator)
+		if !ok {
+			return nil, nil, false
+		}
+		return &a.Spec.ForProvider, &a.Status, true
+	},
+}
+
+// SetupMySQLServerActiveDirectoryAdministrator adds a controller that
+// reconciles MySQLServerActiveDirectoryAdministrators.
+func SetupMySQLServerActiveDirectoryAdministrator(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServerActiveDirectoryAdministrator adds a controller that
+// reconciles PostgreSQLServerActiveDirectoryAdministrators.
+func SetupPostgreSQLServerActiveDirectoryAdministrator(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{engine: c.engine, client: c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)}, nil
+}
+
+// A server has at most one Azure AD administrator, so the administrator is
+// identified by its server rather than by its external name.
+type external struct {
+	engine *engine
+	client database.ServerAdministratorAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, s, ok := e.engine.administrator(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotAdministrator, e.engine.gvk.Kind)
+	}
+
+	az, err := e.client.GetServerAdministrator(ctx, p.ResourceGroupName, p.ServerName)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetAdministrator, e.engine.gvk.Kind)
+	}
+
+	s.AtProvider.ID = az.ID
+	s.AtProvider.Type = az.Type
+	mg.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.ServerAdministratorIsUpToDate(*p, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, _, ok := e.engine.administrator(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotAdministrator, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+	a, err := database.NewServerAdministrator(*p)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrapf(err, errCreateAdministrator, e.engine.gvk.Kind)
+	}
+	err = e.client.CreateOrUpdateServerAdministrator(ctx, p.ResourceGroupName, p.ServerName, a)
+	return managed.ExternalCreation{}, errors.Wrapf(err, errCreateAdministrator, e.engine.gvk.Kind)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	p, _, ok := e.engine.administrator(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotAdministrator, e.engine.gvk.Kind)
+	}
+
+	a, err := database.NewServerAdministrator(*p)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateAdministrator, e.engine.gvk.Kind)
+	}
+	err = e.client.CreateOrUpdateServerAdministrator(ctx, p.ResourceGroupName, p.ServerName, a)
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateAdministrator, e.engine.gvk.Kind)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, _, ok := e.engine.administrator(mg)
+	if !ok {
+		return errors.Errorf(errNotAdministrator, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Deleting())
+	err := e.client.DeleteServerAdministrator(ctx, p.ResourceGroupName, p.ServerName)
+	return errors.Wrapf(resource.Ignore(azure.IsNotFound, err), errDeleteAdministrator, e.engine.gvk.Kind)
+}
@@ -0,0 +1,502 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserveractivedirectoryadministrator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+const (
+	name              = "coolAdmin"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	login             = "cool-admins"
+	objectID          = "7e3d6c1a-4f4b-4c3e-9d8e-2f1a0b9c8d7e"
+	tenantID          = "0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+)
+
+var (
+	_ managed.ExternalClient          = &external{}
+	_ managed.ExternalConnecter       = &connecter{}
+	_ database.ServerAdministratorAPI = &MockServerAdministratorAPI{}
+)
+
+type MockServerAdministratorAPI struct {
+	MockGetServerAdministrator            func(ctx context.Context, resourceGroupName, serverName string) (database.ServerAdministrator, error)
+	MockCreateOrUpdateServerAdministrator func(ctx context.Context, resourceGroupName, serverName string, a database.ServerAdministrator) error
+	MockDeleteServerAdministrator         func(ctx context.Context, resourceGroupName, serverName string) error
+}
+
+func (m *MockServerAdministratorAPI) GetServerAdministrator(ctx context.Context, resourceGroupName, serverName string) (database.ServerAdministrator, error) {
+	return m.MockGetServerAdministrator(ctx, resourceGroupName, serverName)
+}
+
+func (m *MockServerAdministratorAPI) CreateOrUpdateServerAdministrator(ctx context.Context, resourceGroupName, serverName string, a database.ServerAdministrator) error {
+	return m.MockCreateOrUpdateServerAdministrator(ctx, resourceGroupName, serverName, a)
+}
+
+func (m *MockServerAdministratorAPI) DeleteServerAdministrator(ctx context.Context, resourceGroupName, serverName string) error {
+	return m.MockDeleteServerAdministrator(ctx, resourceGroupName, serverName)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine}
+
+type administratorModifier func(*v1alpha3.ActiveDirectoryAdministratorParameters, *v1alpha3.ActiveDirectoryAdministratorStatus)
+
+func withConditions(c ...xpv1.Condition) administratorModifier {
+	return func(_ *v1alpha3.ActiveDirectoryAdministratorParameters, s *v1alpha3.ActiveDirectoryAdministratorStatus) {
+		s.ConditionedStatus.Conditions = c
+	}
+}
+
+func withType(t string) administratorModifier {
+	return func(_ *v1alpha3.ActiveDirectoryAdministratorParameters, s *v1alpha3.ActiveDirectoryAdministratorStatus) {
+		s.AtProvider.Type = t
+	}
+}
+
+func withID(id string) administratorModifier {
+	return func(_ *v1alpha3.ActiveDirectoryAdministratorParameters, s *v1alpha3.ActiveDirectoryAdministratorStatus) {
+		s.AtProvider.ID = id
+	}
+}
+
+func withObjectID(id string) administratorModifier {
+	return func(p *v1alpha3.ActiveDirectoryAdministratorParameters, _ *v1alpha3.ActiveDirectoryAdministratorStatus) {
+		p.ObjectID = id
+	}
+}
+
+func administrator(e *engine, am ...administratorModifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	mg.SetName(name)
+	mg.SetUID(uid)
+	mg.SetFinalizers([]string{})
+
+	p, s, _ := e.administrator(mg)
+	*p = v1alpha3.ActiveDirectoryAdministratorParameters{
+		ServerName:        serverName,
+		ResourceGroupName: resourceGroupName,
+		Login:             login,
+		ObjectID:          objectID,
+		TenantID:          tenantID,
+	}
+
+	for _, m := range am {
+		m(p, s)
+	}
+
+	return mg
+}
+
+func azureAdministrator(sid string) database.ServerAdministrator {
+	s := uuid.FromStringOrNil(sid)
+	t := uuid.FromStringOrNil(tenantID)
+	return database.ServerAdministrator{
+		ID:                resourceID,
+		Type:              resourceType,
+		AdministratorType: azure.ToStringPtr(v1alpha3.AdministratorTypeActiveDirectory),
+		Login:             azure.ToStringPtr(login),
+		Sid:               &s,
+		TenantID:          &t,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotActiveDirectoryAdministrator": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{}},
+				want: want{
+					err: errors.Errorf(errNotAdministrator, e.gvk.Kind),
+				},
+			},
+			"SuccessfulObserveNotExist": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockGetServerAdministrator: func(_ context.Context, _, _ string) (database.ServerAdministrator, error) {
+						return database.ServerAdministrator{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e),
+					o:  managed.ExternalObservation{ResourceExists: false},
+				},
+			},
+			"SuccessfulObserveUpToDate": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockGetServerAdministrator: func(_ context.Context, _, _ string) (database.ServerAdministrator, error) {
+						return azureAdministrator(objectID), nil
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				},
+			},
+			"SuccessfulObserveDrifted": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockGetServerAdministrator: func(_ context.Context, _, _ string) (database.ServerAdministrator, error) {
+						return azureAdministrator(tenantID), nil
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				},
+			},
+			"FailedObserve": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockGetServerAdministrator: func(_ context.Context, _, _ string) (database.ServerAdministrator, error) {
+						return database.ServerAdministrator{}, errBoom
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg:  administrator(e),
+					err: errors.Wrapf(errBoom, errGetAdministrator, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.o, o); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	_, errInvalid := database.NewServerAdministrator(v1alpha3.ActiveDirectoryAdministratorParameters{ObjectID: "not-a-uuid"})
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotActiveDirectoryAdministrator": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{}},
+				want: want{
+					err: errors.Errorf(errNotAdministrator, e.gvk.Kind),
+				},
+			},
+			"InvalidObjectID": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{}},
+				args: args{
+					mg: administrator(e, withObjectID("not-a-uuid")),
+				},
+				want: want{
+					mg: administrator(e,
+						withObjectID("not-a-uuid"),
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errInvalid, errCreateAdministrator, e.gvk.Kind),
+				},
+			},
+			"ErrorCreate": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockCreateOrUpdateServerAdministrator: func(_ context.Context, _, _ string, _ database.ServerAdministrator) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errBoom, errCreateAdministrator, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockCreateOrUpdateServerAdministrator: func(_ context.Context, _, _ string, a database.ServerAdministrator) error {
+						want := azureAdministrator(objectID)
+						want.ID, want.Type = "", ""
+						if diff := cmp.Diff(want, a); diff != "" {
+							return errors.New(diff)
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Creating()),
+					),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotActiveDirectoryAdministrator": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{}},
+				want: want{
+					err: errors.Errorf(errNotAdministrator, e.gvk.Kind),
+				},
+			},
+			"UpdateError": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockCreateOrUpdateServerAdministrator: func(_ context.Context, _, _ string, _ database.ServerAdministrator) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg:  administrator(e),
+					err: errors.Wrapf(errBoom, errUpdateAdministrator, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockCreateOrUpdateServerAdministrator: func(_ context.Context, _, _ string, _ database.ServerAdministrator) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotActiveDirectoryAdministrator": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{}},
+				want: want{
+					err: errors.Errorf(errNotAdministrator, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockDeleteServerAdministrator: func(_ context.Context, _, _ string) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"SuccessfulNotFound": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockDeleteServerAdministrator: func(_ context.Context, _, _ string) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"Failed": {
+				ec: &external{engine: e, client: &MockServerAdministratorAPI{
+					MockDeleteServerAdministrator: func(_ context.Context, _, _ string) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: administrator(e),
+				},
+				want: want{
+					mg: administrator(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errDeleteAdministrator, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverconfiguration
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotConfiguration    = "managed resource is not a %s"
+	errCreateConfiguration = "cannot create %s"
+	errUpdateConfiguration = "cannot update %s"
+	errGetConfiguration    = "cannot get %s"
+	errDeleteConfiguration = "cannot reset %s to its default value"
+)
+
+// An engine adapts the engine agnostic configuration controller to the
+// managed resource kind and the Azure API of a single database engine.
+type engine struct {
+	gvk           schema.GroupVersionKind
+	object        resource.Managed
+	newClient     func(subscriptionID string, auth autorest.Authorizer) database.ConfigurationAPI
+	configuration func(mg resource.Managed) (*v1alpha3.ConfigurationParameters, *v1alpha3.ConfigurationStatus, bool)
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1alpha3.MySQLServerConfigurationGroupVersionKind,
+	object: &v1alpha3.MySQLServerConfiguration{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.ConfigurationAPI {
+		cl := mysql.NewConfigurationsClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLConfigurationsClient(cl)
+	},
+	configuration: func(mg resource.Managed) (*v1alpha3.ConfigurationParameters, *v1alpha3.ConfigurationStatus, bool) {
+		r, ok := mg.(*v1alpha3.MySQLServerConfiguration)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1alpha3.PostgreSQLServerConfigurationGroupVersionKind,
+	object: &v1alpha3.PostgreSQLServerConfiguration{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.ConfigurationAPI {
+		cl := postgresql.NewConfigurationsClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLConfigurationsClient(cl)
+	},
+	configuration: func(mg resource.Managed) (*v1alpha3.ConfigurationParameters, *v1alpha3.ConfigurationStatus, bool) {
+		r, ok := mg.(*v1alpha3.PostgreSQLServerConfiguration)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+}
+
+// SetupMySQLServerConfiguration adds a controller that reconciles
+// MySQLServerConfigurations.
+func SetupMySQLServerConfiguration(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServerConfiguration adds a controller that reconciles
+// PostgreSQLServerConfigurations.
+func SetupPostgreSQLServerConfiguration(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{engine: c.engine, client: c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)}, nil
+}
+
+type external struct {
+	engine *engine
+	client database.ConfigurationAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, s, ok := e.engine.configuration(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotConfiguration, e.engine.gvk.Kind)
+	}
+
+	az, err := e.client.GetConfiguration(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetConfiguration, e.engine.gvk.Kind)
+	}
+
+	// Configurations exist for as long as their server does. We consider a
+	// configuration that is being deleted gone once it is reset to its
+	// default value.
+	if meta.WasDeleted(mg) && database.ConfigurationIsDefault(az) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	database.UpdateConfigurationObservation(&s.AtProvider, az)
+	mg.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.ConfigurationIsUpToDate(*p, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, _, ok := e.engine.configuration(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotConfiguration, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+	err := e.client.CreateOrUpdateConfiguration(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg), database.NewConfiguration(*p))
+	return managed.ExternalCreation{}, errors.Wrapf(err, errCreateConfiguration, e.engine.gvk.Kind)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	p, _, ok := e.engine.configuration(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotConfiguration, e.engine.gvk.Kind)
+	}
+
+	err := e.client.CreateOrUpdateConfiguration(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg), database.NewConfiguration(*p))
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateConfiguration, e.engine.gvk.Kind)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, _, ok := e.engine.configuration(mg)
+	if !ok {
+		return errors.Errorf(errNotConfiguration, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Deleting())
+	az, err := e.client.GetConfiguration(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg))
+	if err != nil {
+		return errors.Wrapf(resource.Ignore(azure.IsNotFound, err), errGetConfiguration, e.engine.gvk.Kind)
+	}
+	err = e.client.CreateOrUpdateConfiguration(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg), database.NewDefaultConfiguration(az))
+	return errors.Wrapf(err, errDeleteConfiguration, e.engine.gvk.Kind)
+}
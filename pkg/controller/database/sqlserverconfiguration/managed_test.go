@@ -0,0 +1,543 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverconfiguration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+const (
+	name              = "coolconfiguration"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	value             = "500"
+	defaultValue      = "100"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.ConfigurationAPI = &MockConfigurationAPI{}
+)
+
+type MockConfigurationAPI struct {
+	MockGetConfiguration            func(ctx context.Context, resourceGroupName, serverName, configurationName string) (database.Configuration, error)
+	MockCreateOrUpdateConfiguration func(ctx context.Context, resourceGroupName, serverName, configurationName string, c database.Configuration) error
+}
+
+func (m *MockConfigurationAPI) GetConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string) (database.Configuration, error) {
+	return m.MockGetConfiguration(ctx, resourceGroupName, serverName, configurationName)
+}
+
+func (m *MockConfigurationAPI) CreateOrUpdateConfiguration(ctx context.Context, resourceGroupName, serverName, configurationName string, c database.Configuration) error {
+	return m.MockCreateOrUpdateConfiguration(ctx, resourceGroupName, serverName, configurationName, c)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine}
+
+type configurationModifier func(resource.Managed, *v1alpha3.ConfigurationStatus)
+
+func withConditions(c ...xpv1.Condition) configurationModifier {
+	return func(_ resource.Managed, s *v1alpha3.ConfigurationStatus) { s.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha3.ConfigurationObservation) configurationModifier {
+	return func(_ resource.Managed, s *v1alpha3.ConfigurationStatus) { s.AtProvider = o }
+}
+
+var deletionTimestamp = metav1.Now()
+
+func withDeletionTimestamp() configurationModifier {
+	return func(mg resource.Managed, _ *v1alpha3.ConfigurationStatus) {
+		mg.SetDeletionTimestamp(&deletionTimestamp)
+	}
+}
+
+func configuration(e *engine, sm ...configurationModifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	mg.SetName(name)
+	mg.SetUID(uid)
+	mg.SetFinalizers([]string{})
+	meta.SetExternalName(mg, name)
+
+	p, s, _ := e.configuration(mg)
+	*p = v1alpha3.ConfigurationParameters{
+		ServerName:        serverName,
+		ResourceGroupName: resourceGroupName,
+		Value:             value,
+	}
+
+	for _, m := range sm {
+		m(mg, s)
+	}
+
+	return mg
+}
+
+func azConfiguration(v, source string) database.Configuration {
+	return database.Configuration{
+		ID:           resourceID,
+		Type:         resourceType,
+		Value:        azure.ToStringPtr(v),
+		DefaultValue: azure.ToStringPtr(defaultValue),
+		Source:       azure.ToStringPtr(source),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotConfiguration": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{}},
+				want: want{
+					err: errors.Errorf(errNotConfiguration, e.gvk.Kind),
+				},
+			},
+			"SuccessfulObserveNotExist": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return database.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e),
+				},
+			},
+			"SuccessfulObserveUpToDate": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return azConfiguration(value, v1alpha3.ConfigurationSourceUserOverride), nil
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+					mg: configuration(e,
+						withConditions(xpv1.Available()),
+						withObservation(v1alpha3.ConfigurationObservation{
+							ID:           resourceID,
+							Type:         resourceType,
+							DefaultValue: defaultValue,
+							Source:       v1alpha3.ConfigurationSourceUserOverride,
+						}),
+					),
+				},
+			},
+			"SuccessfulObserveNeedsUpdate": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return azConfiguration(defaultValue, v1alpha3.ConfigurationSourceSystemDefault), nil
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+					mg: configuration(e,
+						withConditions(xpv1.Available()),
+						withObservation(v1alpha3.ConfigurationObservation{
+							ID:           resourceID,
+							Type:         resourceType,
+							DefaultValue: defaultValue,
+							Source:       v1alpha3.ConfigurationSourceSystemDefault,
+						}),
+					),
+				},
+			},
+			"SuccessfulObserveRestartRequired": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						c := azConfiguration(value, v1alpha3.ConfigurationSourceUserOverride)
+						c.RestartRequired = true
+						return c, nil
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+					mg: configuration(e,
+						withConditions(xpv1.Available()),
+						withObservation(v1alpha3.ConfigurationObservation{
+							ID:              resourceID,
+							Type:            resourceType,
+							DefaultValue:    defaultValue,
+							Source:          v1alpha3.ConfigurationSourceUserOverride,
+							RestartRequired: true,
+						}),
+					),
+				},
+			},
+			"SuccessfulObserveResetAfterDeletion": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return azConfiguration(defaultValue, v1alpha3.ConfigurationSourceSystemDefault), nil
+					},
+				}},
+				args: args{
+					mg: configuration(e, withDeletionTimestamp()),
+				},
+				want: want{
+					o:  managed.ExternalObservation{ResourceExists: false},
+					mg: configuration(e, withDeletionTimestamp()),
+				},
+			},
+			"FailedObserve": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return database.Configuration{}, errBoom
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg:  configuration(e),
+					err: errors.Wrapf(errBoom, errGetConfiguration, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.o, o); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotConfiguration": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{}},
+				want: want{
+					err: errors.Errorf(errNotConfiguration, e.gvk.Kind),
+				},
+			},
+			"ErrorCreate": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockCreateOrUpdateConfiguration: func(_ context.Context, _, _, _ string, _ database.Configuration) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e,
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errBoom, errCreateConfiguration, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockCreateOrUpdateConfiguration: func(_ context.Context, _, _, _ string, c database.Configuration) error {
+						if azure.ToString(c.Value) != value || azure.ToString(c.Source) != v1alpha3.ConfigurationSourceUserOverride {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e,
+						withConditions(xpv1.Creating()),
+					),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotConfiguration": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{}},
+				want: want{
+					err: errors.Errorf(errNotConfiguration, e.gvk.Kind),
+				},
+			},
+			"UpdateError": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockCreateOrUpdateConfiguration: func(_ context.Context, _, _, _ string, _ database.Configuration) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg:  configuration(e),
+					err: errors.Wrapf(errBoom, errUpdateConfiguration, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockCreateOrUpdateConfiguration: func(_ context.Context, _, _, _ string, c database.Configuration) error {
+						if azure.ToString(c.Value) != value {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotConfiguration": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{}},
+				want: want{
+					err: errors.Errorf(errNotConfiguration, e.gvk.Kind),
+				},
+			},
+			"SuccessfulReset": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return azConfiguration(value, v1alpha3.ConfigurationSourceUserOverride), nil
+					},
+					MockCreateOrUpdateConfiguration: func(_ context.Context, _, _, _ string, c database.Configuration) error {
+						if azure.ToString(c.Value) != defaultValue || azure.ToString(c.Source) != v1alpha3.ConfigurationSourceSystemDefault {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"SuccessfulNotFound": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return database.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"FailedGet": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return database.Configuration{}, errBoom
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errGetConfiguration, e.gvk.Kind),
+				},
+			},
+			"FailedReset": {
+				ec: &external{engine: e, client: &MockConfigurationAPI{
+					MockGetConfiguration: func(_ context.Context, _, _, _ string) (database.Configuration, error) {
+						return azConfiguration(value, v1alpha3.ConfigurationSourceUserOverride), nil
+					},
+					MockCreateOrUpdateConfiguration: func(_ context.Context, _, _, _ string, _ database.Configuration) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: configuration(e),
+				},
+				want: want{
+					mg: configuration(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errDeleteConfiguration, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverdatabase
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	"github.com/crossplane/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotDatabase    = "managed resource is not a %s"
+	errUpdateCR       = "cannot update %s custom resource"
+	errCreateDatabase = "cannot create %s"
+	errGetDatabase    = "cannot get %s"
+	errDeleteDatabase = "cannot delete %s"
+	errGetServer      = "cannot get referenced %s"
+	errListServers    = "cannot list %ss"
+)
+
+// An engine adapts the engine agnostic database controller to the managed
+// resource kind and the Azure API of a single database engine.
+type engine struct {
+	gvk       schema.GroupVersionKind
+	object    resource.Managed
+	newClient func(subscriptionID string, auth autorest.Authorizer) database.DatabaseAPI
+	database  func(mg resource.Managed) (*v1alpha3.DatabaseParameters, *v1alpha3.DatabaseStatus, bool)
+
+	// The servers the databases of the engine belong to. Their connection
+	// details are published along with the name of the database.
+	serverGVK     schema.GroupVersionKind
+	newServer     func() resource.Managed
+	newServerList func() client.ObjectList
+	server        func(mg resource.Managed) (*v1beta1.SQLServerParameters, bool)
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1alpha3.MySQLServerDatabaseGroupVersionKind,
+	object: &v1alpha3.MySQLServerDatabase{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.DatabaseAPI {
+		cl := mysql.NewDatabasesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLDatabasesClient(cl)
+	},
+	database: func(mg resource.Managed) (*v1alpha3.DatabaseParameters, *v1alpha3.DatabaseStatus, bool) {
+		r, ok := mg.(*v1alpha3.MySQLServerDatabase)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+	serverGVK:     v1beta1.MySQLServerGroupVersionKind,
+	newServer:     func() resource.Managed { return &v1beta1.MySQLServer{} },
+	newServerList: func() client.ObjectList { return &v1beta1.MySQLServerList{} },
+	server: func(mg resource.Managed) (*v1beta1.SQLServerParameters, bool) {
+		s, ok := mg.(*v1beta1.MySQLServer)
+		if !ok {
+			return nil, false
+		}
+		return &s.Spec.ForProvider, true
+	},
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1alpha3.PostgreSQLServerDatabaseGroupVersionKind,
+	object: &v1alpha3.PostgreSQLServerDatabase{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.DatabaseAPI {
+		cl := postgresql.NewDatabasesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLDatabasesClient(cl)
+	},
+	database: func(mg resource.Managed) (*v1alpha3.DatabaseParameters, *v1alpha3.DatabaseStatus, bool) {
+		r, ok := mg.(*v1alpha3.PostgreSQLServerDatabase)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+	serverGVK:     v1beta1.PostgreSQLServerGroupVersionKind,
+	newServer:     func() resource.Managed { return &v1beta1.PostgreSQLServer{} },
+	newServerList: func() client.ObjectList { return &v1beta1.PostgreSQLServerList{} },
+	server: func(mg resource.Managed) (*v1beta1.SQLServerParameters, bool) {
+		s, ok := mg.(*v1beta1.PostgreSQLServer)
+		if !ok {
+			return nil, false
+		}
+		return &s.Spec.ForProvider, true
+	},
+}
+
+// SetupMySQLServerDatabase adds a controller that reconciles
+// MySQLServerDatabases.
+func SetupMySQLServerDatabase(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServerDatabase adds a controller that reconciles
+// PostgreSQLServerDatabases.
+func SetupPostgreSQLServerDatabase(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.client, engine: c.engine, client: c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	engine *engine
+	client database.DatabaseAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, s, ok := e.engine.database(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotDatabase, e.engine.gvk.Kind)
+	}
+
+	az, err := e.client.GetDatabase(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetDatabase, e.engine.gvk.Kind)
+	}
+
+	database.LateInitializeDatabase(p, az)
+	if err := e.kube.Update(ctx, mg); err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errUpdateCR, e.engine.gvk.Kind)
+	}
+	s.AtProvider.ID = az.ID
+	s.AtProvider.Type = az.Type
+	mg.SetConditions(xpv1.Available())
+
+	cd, err := e.connectionDetails(ctx, mg, *p)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// All parameters of a database are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, _, ok := e.engine.database(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotDatabase, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+	err := e.client.CreateOrUpdateDatabase(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg), database.NewDatabase(*p))
+	return managed.ExternalCreation{}, errors.Wrapf(err, errCreateDatabase, e.engine.gvk.Kind)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, _, ok := e.engine.database(mg)
+	if !ok {
+		return errors.Errorf(errNotDatabase, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Deleting())
+	err := e.client.DeleteDatabase(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg))
+	return errors.Wrapf(resource.Ignore(azure.IsNotFound, err), errDeleteDatabase, e.engine.gvk.Kind)
+}
+
+// connectionDetails returns the name of the supplied database along with the
+// endpoint, user and password of its server when the server is managed by
+// Crossplane.
+func (e *external) connectionDetails(ctx context.Context, mg resource.Managed, p v1alpha3.DatabaseParameters) (managed.ConnectionDetails, error) {
+	cd := managed.ConnectionDetails{
+		database.ConnectionSecretDatabaseKey: []byte(meta.GetExternalName(mg)),
+	}
+	s, err := e.server(ctx, p)
+	if err != nil || s == nil {
+		return cd, err
+	}
+	sd, err := database.GetServerConnectionDetails(ctx, e.kube, s)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range sd {
+		cd[k] = v
+	}
+	return cd, nil
+}
+
+// server returns the server the supplied database belongs to. Servers that
+// are not referenced are looked up by their external name and resource group.
+// It returns nil if the server is not managed by Crossplane.
+func (e *external) server(ctx context.Context, p v1alpha3.DatabaseParameters) (resource.Managed, error) {
+	if ref := p.ServerNameRef; ref != nil {
+		s := e.engine.newServer()
+		return s, errors.Wrapf(e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, s), errGetServer, e.engine.serverGVK.Kind)
+	}
+	l := e.engine.newServerList()
+	if err := e.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrapf(err, errListServers, e.engine.serverGVK.Kind)
+	}
+	items, err := apimeta.ExtractList(l)
+	if err != nil {
+		return nil, errors.Wrapf(err, errListServers, e.engine.serverGVK.Kind)
+	}
+	for _, o := range items {
+		s, ok := o.(resource.Managed)
+		if !ok {
+			continue
+		}
+		sp, ok := e.engine.server(s)
+		if ok && meta.GetExternalName(s) == p.ServerName && sp.ResourceGroupName == p.ResourceGroupName {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
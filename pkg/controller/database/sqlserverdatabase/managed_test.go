@@ -0,0 +1,593 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverdatabase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+const (
+	name              = "cooldb"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	charset           = "utf8"
+	collation         = "utf8_general_ci"
+	endpoint          = "coolsrv.example.org"
+	user              = "cooladmin@coolSrv"
+	password          = "verysecure"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.DatabaseAPI      = &MockDatabaseAPI{}
+)
+
+type MockDatabaseAPI struct {
+	MockGetDatabase            func(ctx context.Context, resourceGroupName, serverName, databaseName string) (database.Database, error)
+	MockCreateOrUpdateDatabase func(ctx context.Context, resourceGroupName, serverName, databaseName string, d database.Database) error
+	MockDeleteDatabase         func(ctx context.Context, resourceGroupName, serverName, databaseName string) error
+}
+
+func (m *MockDatabaseAPI) GetDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (database.Database, error) {
+	return m.MockGetDatabase(ctx, resourceGroupName, serverName, databaseName)
+}
+
+func (m *MockDatabaseAPI) CreateOrUpdateDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string, d database.Database) error {
+	return m.MockCreateOrUpdateDatabase(ctx, resourceGroupName, serverName, databaseName, d)
+}
+
+func (m *MockDatabaseAPI) DeleteDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error {
+	return m.MockDeleteDatabase(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine}
+
+type databaseModifier func(*v1alpha3.DatabaseParameters, *v1alpha3.DatabaseStatus)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(_ *v1alpha3.DatabaseParameters, s *v1alpha3.DatabaseStatus) { s.ConditionedStatus.Conditions = c }
+}
+
+func withType(t string) databaseModifier {
+	return func(_ *v1alpha3.DatabaseParameters, s *v1alpha3.DatabaseStatus) { s.AtProvider.Type = t }
+}
+
+func withID(id string) databaseModifier {
+	return func(_ *v1alpha3.DatabaseParameters, s *v1alpha3.DatabaseStatus) { s.AtProvider.ID = id }
+}
+
+func withCharsetAndCollation(cs, co string) databaseModifier {
+	return func(p *v1alpha3.DatabaseParameters, _ *v1alpha3.DatabaseStatus) {
+		p.Charset = &cs
+		p.Collation = &co
+	}
+}
+
+func withServerNameRef(n string) databaseModifier {
+	return func(p *v1alpha3.DatabaseParameters, _ *v1alpha3.DatabaseStatus) {
+		p.ServerNameRef = &xpv1.Reference{Name: n}
+	}
+}
+
+func sqlDatabase(e *engine, sm ...databaseModifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	mg.SetName(name)
+	mg.SetUID(uid)
+	mg.SetFinalizers([]string{})
+	meta.SetExternalName(mg, name)
+
+	p, s, _ := e.database(mg)
+	*p = v1alpha3.DatabaseParameters{
+		ServerName:        serverName,
+		ResourceGroupName: resourceGroupName,
+	}
+
+	for _, m := range sm {
+		m(p, s)
+	}
+
+	return mg
+}
+
+// sqlServer returns a server of the supplied engine with the supplied
+// external name and resource group that publishes its connection details.
+func sqlServer(e *engine, externalName, rg string) resource.Managed {
+	s := e.newServer()
+	meta.SetExternalName(s, externalName)
+	p, _ := e.server(s)
+	p.ResourceGroupName = rg
+	s.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "coolns", Name: "coolsecret"})
+	return s
+}
+
+func withServerSecret(obj client.Object) {
+	if sec, ok := obj.(*corev1.Secret); ok {
+		sec.Data = map[string][]byte{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	azDatabase := func(_ context.Context, _, _, _ string) (database.Database, error) {
+		return database.Database{
+			ID:        resourceID,
+			Type:      resourceType,
+			Charset:   azure.ToStringPtr(charset),
+			Collation: azure.ToStringPtr(collation),
+		}, nil
+	}
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotDatabase": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{}},
+				want: want{
+					err: errors.Errorf(errNotDatabase, e.gvk.Kind),
+				},
+			},
+			"SuccessfulObserveNotExist": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockGetDatabase: func(_ context.Context, _, _, _ string) (database.Database, error) {
+						return database.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg: sqlDatabase(e),
+				},
+			},
+			"SuccessfulObserveExists": {
+				ec: &external{
+					kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockList: test.NewMockListFn(nil)},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					o: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+						ConnectionDetails: managed.ConnectionDetails{
+							database.ConnectionSecretDatabaseKey: []byte(name),
+						},
+					},
+					mg: sqlDatabase(e,
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+				},
+			},
+			"SuccessfulObserveWithServerSecret": {
+				ec: &external{
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+							if s, ok := obj.(resource.Managed); ok {
+								s.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "coolns", Name: "coolsecret"})
+							}
+							withServerSecret(obj)
+							return nil
+						},
+					},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e, withServerNameRef(serverName)),
+				},
+				want: want{
+					o: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+						ConnectionDetails: managed.ConnectionDetails{
+							database.ConnectionSecretDatabaseKey:      []byte(name),
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+							xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+						},
+					},
+					mg: sqlDatabase(e,
+						withServerNameRef(serverName),
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+				},
+			},
+			"SuccessfulObserveWithUnreferencedServer": {
+				ec: &external{
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+							return apimeta.SetList(obj, []runtime.Object{
+								sqlServer(e, serverName, "otherRG"),
+								sqlServer(e, serverName, resourceGroupName),
+							})
+						},
+						MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+							withServerSecret(obj)
+							return nil
+						},
+					},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					o: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+						ConnectionDetails: managed.ConnectionDetails{
+							database.ConnectionSecretDatabaseKey:      []byte(name),
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+							xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+						},
+					},
+					mg: sqlDatabase(e,
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+				},
+			},
+			"SuccessfulObserveWithServerInOtherResourceGroup": {
+				ec: &external{
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+							return apimeta.SetList(obj, []runtime.Object{sqlServer(e, serverName, "otherRG")})
+						},
+					},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					o: managed.ExternalObservation{
+						ResourceExists:   true,
+						ResourceUpToDate: true,
+						ConnectionDetails: managed.ConnectionDetails{
+							database.ConnectionSecretDatabaseKey: []byte(name),
+						},
+					},
+					mg: sqlDatabase(e,
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+				},
+			},
+			"FailedListServers": {
+				ec: &external{
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockList:   test.NewMockListFn(errBoom),
+					},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					err: errors.Wrapf(errBoom, errListServers, e.serverGVK.Kind),
+				},
+			},
+			"FailedGetServer": {
+				ec: &external{
+					kube: &test.MockClient{
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockGet:    test.NewMockGetFn(errBoom),
+					},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e, withServerNameRef(serverName)),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withServerNameRef(serverName),
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					err: errors.Wrapf(errBoom, errGetServer, e.serverGVK.Kind),
+				},
+			},
+			"FailedUpdateCR": {
+				ec: &external{
+					kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+					engine: e,
+					client: &MockDatabaseAPI{MockGetDatabase: azDatabase},
+				},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg:  sqlDatabase(e, withCharsetAndCollation(charset, collation)),
+					err: errors.Wrapf(errBoom, errUpdateCR, e.gvk.Kind),
+				},
+			},
+			"FailedObserve": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockGetDatabase: func(_ context.Context, _, _, _ string) (database.Database, error) {
+						return database.Database{}, errBoom
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg:  sqlDatabase(e),
+					err: errors.Wrapf(errBoom, errGetDatabase, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.o, o); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotDatabase": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{}},
+				want: want{
+					err: errors.Errorf(errNotDatabase, e.gvk.Kind),
+				},
+			},
+			"ErrorCreate": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockCreateOrUpdateDatabase: func(_ context.Context, _, _, _ string, _ database.Database) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errBoom, errCreateDatabase, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockCreateOrUpdateDatabase: func(_ context.Context, _, _, _ string, d database.Database) error {
+						if azure.ToString(d.Charset) != charset || azure.ToString(d.Collation) != collation {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e, withCharsetAndCollation(charset, collation)),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withCharsetAndCollation(charset, collation),
+						withConditions(xpv1.Creating()),
+					),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotDatabase": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{}},
+				want: want{
+					err: errors.Errorf(errNotDatabase, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockDeleteDatabase: func(_ context.Context, _, _, _ string) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"SuccessfulNotFound": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockDeleteDatabase: func(_ context.Context, _, _, _ string) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"Failed": {
+				ec: &external{engine: e, client: &MockDatabaseAPI{
+					MockDeleteDatabase: func(_ context.Context, _, _, _ string) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: sqlDatabase(e),
+				},
+				want: want{
+					mg: sqlDatabase(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errDeleteDatabase, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
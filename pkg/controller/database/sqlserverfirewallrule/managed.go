@@ -0,0 +1,243 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverfirewallrule
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotFirewallRule    = "managed resource is not a %s"
+	errCreateFirewallRule = "cannot create %s"
+	errUpdateFirewallRule = "cannot update %s"
+	errGetFirewallRule    = "cannot get %s"
+	errDeleteFirewallRule = "cannot delete %s"
+)
+
+// An engine adapts the engine agnostic firewall rule controller to the
+// managed resource kind and the Azure API of a single database engine.
+type engine struct {
+	gvk       schema.GroupVersionKind
+	object    resource.Managed
+	newClient func(subscriptionID string, auth autorest.Authorizer) database.FirewallRuleAPI
+	rule      func(mg resource.Managed) (*v1alpha3.FirewallRuleParameters, *v1alpha3.FirewallRuleStatus, bool)
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1alpha3.MySQLServerFirewallRuleGroupVersionKind,
+	object: &v1alpha3.MySQLServerFirewallRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.FirewallRuleAPI {
+		cl := mysql.NewFirewallRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLFirewallRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (*v1alpha3.FirewallRuleParameters, *v1alpha3.FirewallRuleStatus, bool) {
+		r, ok := mg.(*v1alpha3.MySQLServerFirewallRule)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1alpha3.PostgreSQLServerFirewallRuleGroupVersionKind,
+	object: &v1alpha3.PostgreSQLServerFirewallRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.FirewallRuleAPI {
+		cl := postgresql.NewFirewallRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLFirewallRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (*v1alpha3.FirewallRuleParameters, *v1alpha3.FirewallRuleStatus, bool) {
+		r, ok := mg.(*v1alpha3.PostgreSQLServerFirewallRule)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+}
+
+var mariadbEngine = &engine{
+	gvk:    v1alpha3.MariaDBServerFirewallRuleGroupVersionKind,
+	object: &v1alpha3.MariaDBServerFirewallRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.FirewallRuleAPI {
+		cl := mariadb.NewFirewallRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMariaDBFirewallRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (*v1alpha3.FirewallRuleParameters, *v1alpha3.FirewallRuleStatus, bool) {
+		r, ok := mg.(*v1alpha3.MariaDBServerFirewallRule)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+}
+
+var mssqlEngine = &engine{
+	gvk:    v1alpha3.MSSQLFirewallRuleGroupVersionKind,
+	object: &v1alpha3.MSSQLFirewallRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.FirewallRuleAPI {
+		cl := sql.NewFirewallRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMSSQLFirewallRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (*v1alpha3.FirewallRuleParameters, *v1alpha3.FirewallRuleStatus, bool) {
+		r, ok := mg.(*v1alpha3.MSSQLFirewallRule)
+		if !ok {
+			return nil, nil, false
+		}
+		return &r.Spec.ForProvider, &r.Status, true
+	},
+}
+
+// SetupMySQLServerFirewallRule adds a controller that reconciles
+// MySQLServerFirewallRules.
+func SetupMySQLServerFirewallRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServerFirewallRule adds a controller that reconciles
+// PostgreSQLServerFirewallRules.
+func SetupPostgreSQLServerFirewallRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+// SetupMariaDBServerFirewallRule adds a controller that reconciles
+// MariaDBServerFirewallRules.
+func SetupMariaDBServerFirewallRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mariadbEngine)
+}
+
+// SetupMSSQLFirewallRule adds a controller that reconciles
+// MSSQLFirewallRules.
+func SetupMSSQLFirewallRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mssqlEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{engine: c.engine, client: c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)}, nil
+}
+
+type external struct {
+	engine *engine
+	client database.FirewallRuleAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, s, ok := e.engine.rule(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotFirewallRule, e.engine.gvk.Kind)
+	}
+
+	az, err := e.client.GetFirewallRule(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetFirewallRule, e.engine.gvk.Kind)
+	}
+
+	s.AtProvider.ID = az.ID
+	s.AtProvider.Type = az.Type
+	mg.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.FirewallRuleIsUpToDate(*p, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, _, ok := e.engine.rule(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotFirewallRule, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+	err := e.client.CreateOrUpdateFirewallRule(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg), database.NewFirewallRule(*p))
+	return managed.ExternalCreation{}, errors.Wrapf(err, errCreateFirewallRule, e.engine.gvk.Kind)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	p, _, ok := e.engine.rule(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotFirewallRule, e.engine.gvk.Kind)
+	}
+
+	err := e.client.CreateOrUpdateFirewallRule(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg), database.NewFirewallRule(*p))
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateFirewallRule, e.engine.gvk.Kind)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, _, ok := e.engine.rule(mg)
+	if !ok {
+		return errors.Errorf(errNotFirewallRule, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Deleting())
+	err := e.client.DeleteFirewallRule(ctx, p.ResourceGroupName, p.ServerName, meta.GetExternalName(mg))
+	return errors.Wrapf(resource.Ignore(azure.IsNotFound, err), errDeleteFirewallRule, e.engine.gvk.Kind)
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverfirewallrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+const (
+	name              = "coolSubnet"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	ipAddress         = "127.0.0.1"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.FirewallRuleAPI  = &MockFirewallRuleAPI{}
+)
+
+type MockFirewallRuleAPI struct {
+	MockGetFirewallRule            func(ctx context.Context, resourceGroupName, serverName, ruleName string) (database.FirewallRule, error)
+	MockCreateOrUpdateFirewallRule func(ctx context.Context, resourceGroupName, serverName, ruleName string, r database.FirewallRule) error
+	MockDeleteFirewallRule         func(ctx context.Context, resourceGroupName, serverName, ruleName string) error
+}
+
+func (m *MockFirewallRuleAPI) GetFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (database.FirewallRule, error) {
+	return m.MockGetFirewallRule(ctx, resourceGroupName, serverName, ruleName)
+}
+
+func (m *MockFirewallRuleAPI) CreateOrUpdateFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r database.FirewallRule) error {
+	return m.MockCreateOrUpdateFirewallRule(ctx, resourceGroupName, serverName, ruleName, r)
+}
+
+func (m *MockFirewallRuleAPI) DeleteFirewallRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	return m.MockDeleteFirewallRule(ctx, resourceGroupName, serverName, ruleName)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine, mariadbEngine, mssqlEngine}
+
+type firewallRuleModifier func(*v1alpha3.FirewallRuleParameters, *v1alpha3.FirewallRuleStatus)
+
+func withConditions(c ...xpv1.Condition) firewallRuleModifier {
+	return func(_ *v1alpha3.FirewallRuleParameters, s *v1alpha3.FirewallRuleStatus) {
+		s.ConditionedStatus.Conditions = c
+	}
+}
+
+func withType(t string) firewallRuleModifier {
+	return func(_ *v1alpha3.FirewallRuleParameters, s *v1alpha3.FirewallRuleStatus) { s.AtProvider.Type = t }
+}
+
+func withID(id string) firewallRuleModifier {
+	return func(_ *v1alpha3.FirewallRuleParameters, s *v1alpha3.FirewallRuleStatus) { s.AtProvider.ID = id }
+}
+
+func firewallRule(e *engine, fm ...firewallRuleModifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	mg.SetName(name)
+	mg.SetUID(uid)
+	mg.SetFinalizers([]string{})
+	meta.SetExternalName(mg, name)
+
+	p, s, _ := e.rule(mg)
+	*p = v1alpha3.FirewallRuleParameters{
+		ServerName:        serverName,
+		ResourceGroupName: resourceGroupName,
+		FirewallRuleProperties: v1alpha3.FirewallRuleProperties{
+			StartIPAddress: ipAddress,
+			EndIPAddress:   ipAddress,
+		},
+	}
+
+	for _, m := range fm {
+		m(p, s)
+	}
+
+	return mg
+}
+
+func azureFirewallRule(endIPAddress string) database.FirewallRule {
+	return database.FirewallRule{
+		ID:             resourceID,
+		Type:           resourceType,
+		StartIPAddress: azure.ToStringPtr(ipAddress),
+		EndIPAddress:   azure.ToStringPtr(endIPAddress),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotFirewallRule": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotFirewallRule, e.gvk.Kind),
+				},
+			},
+			"SuccessfulObserveNotExist": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockGetFirewallRule: func(_ context.Context, _, _, _ string) (database.FirewallRule, error) {
+						return database.FirewallRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e),
+					o:  managed.ExternalObservation{ResourceExists: false},
+				},
+			},
+			"SuccessfulObserveExists": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockGetFirewallRule: func(_ context.Context, rg, s, n string) (database.FirewallRule, error) {
+						if rg != resourceGroupName || s != serverName || n != name {
+							return database.FirewallRule{}, errBoom
+						}
+						return azureFirewallRule(ipAddress), nil
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				},
+			},
+			"SuccessfulObserveNeedsUpdate": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockGetFirewallRule: func(_ context.Context, _, _, _ string) (database.FirewallRule, error) {
+						return azureFirewallRule("127.0.0.2"), nil
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Available()),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				},
+			},
+			"FailedObserve": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockGetFirewallRule: func(_ context.Context, _, _, _ string) (database.FirewallRule, error) {
+						return database.FirewallRule{}, errBoom
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg:  firewallRule(e),
+					err: errors.Wrapf(errBoom, errGetFirewallRule, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.o, o); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotFirewallRule": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotFirewallRule, e.gvk.Kind),
+				},
+			},
+			"ErrorCreate": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockCreateOrUpdateFirewallRule: func(_ context.Context, _, _, _ string, _ database.FirewallRule) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errBoom, errCreateFirewallRule, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockCreateOrUpdateFirewallRule: func(_ context.Context, rg, s, n string, r database.FirewallRule) error {
+						if rg != resourceGroupName || s != serverName || n != name || azure.ToString(r.StartIPAddress) != ipAddress {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Creating()),
+					),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotFirewallRule": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotFirewallRule, e.gvk.Kind),
+				},
+			},
+			"UpdateError": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockCreateOrUpdateFirewallRule: func(_ context.Context, _, _, _ string, _ database.FirewallRule) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg:  firewallRule(e),
+					err: errors.Wrapf(errBoom, errUpdateFirewallRule, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockCreateOrUpdateFirewallRule: func(_ context.Context, rg, s, n string, r database.FirewallRule) error {
+						if rg != resourceGroupName || s != serverName || n != name || azure.ToString(r.EndIPAddress) != ipAddress {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotFirewallRule": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotFirewallRule, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockDeleteFirewallRule: func(_ context.Context, _, _, _ string) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"SuccessfulNotFound": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockDeleteFirewallRule: func(_ context.Context, _, _, _ string) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"Failed": {
+				ec: &external{engine: e, client: &MockFirewallRuleAPI{
+					MockDeleteFirewallRule: func(_ context.Context, _, _, _ string) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: firewallRule(e),
+				},
+				want: want{
+					mg: firewallRule(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errDeleteFirewallRule, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverkey
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotServerKey    = "managed resource is not a %s"
+	errCreateServerKey = "cannot create %s"
+	errUpdateServerKey = "cannot update %s"
+	errGetServerKey    = "cannot get %s"
+	errDeleteServerKey = "cannot delete %s"
+)
+
+// An engine adapts the engine agnostic server key controller to the managed
+// resource kind and the Azure API of a single database engine.
+type engine struct {
+	gvk       schema.GroupVersionKind
+	object    resource.Managed
+	newClient func(subscriptionID string, auth autorest.Authorizer) database.ServerKeyAPI
+	key       func(mg resource.Managed) (*v1alpha3.ServerKeyParameters, *v1alpha3.ServerKeyStatus, bool)
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1alpha3.MySQLServerKeyGroupVersionKind,
+	object: &v1alpha3.MySQLServerKey{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.ServerKeyAPI {
+		cl := mysql.NewServerKeysClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLServerKeysClient(cl)
+	},
+	key: func(mg resource.Managed) (*v1alpha3.ServerKeyParameters, *v1alpha3.ServerKeyStatus, bool) {
+		k, ok := mg.(*v1alpha3.MySQLServerKey)
+		if !ok {
+			return nil, nil, false
+		}
+		return &k.Spec.ForProvider, &k.Status, true
+	},
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1alpha3.PostgreSQLServerKeyGroupVersionKind,
+	object: &v1alpha3.PostgreSQLServerKey{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.ServerKeyAPI {
+		cl := postgresql.NewServerKeysClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLServerKeysClient(cl)
+	},
+	key: func(mg resource.Managed) (*v1alpha3.ServerKeyParameters, *v1alpha3.ServerKeyStatus, bool) {
+		k, ok := mg.(*v1alpha3.PostgreSQLServerKey)
+		if !ok {
+			return nil, nil, false
+		}
+		return &k.Spec.ForProvider, &k.Status, true
+	},
+}
+
+// SetupMySQLServerKey adds a controller that reconciles MySQLServerKeys.
+func SetupMySQLServerKey(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServerKey adds a controller that reconciles
+// PostgreSQLServerKeys.
+func SetupPostgreSQLServerKey(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{engine: c.engine, client: c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)}, nil
+}
+
+// Azure requires the name of a server key to be derived from the URI of its
+// Key Vault key, so the key is identified by its URI rather than by its
+// external name.
+type external struct {
+	engine *engine
+	client database.ServerKeyAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	p, s, ok := e.engine.key(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotServerKey, e.engine.gvk.Kind)
+	}
+
+	name, err := database.ServerKeyName(p.KeyURI)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetServerKey, e.engine.gvk.Kind)
+	}
+	az, err := e.client.GetServerKey(ctx, p.ResourceGroupName, p.ServerName, name)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetServerKey, e.engine.gvk.Kind)
+	}
+
+	database.UpdateServerKeyObservation(&s.AtProvider, az)
+	mg.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: database.ServerKeyIsUpToDate(*p, az),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	p, _, ok := e.engine.key(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotServerKey, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+	name, err := database.ServerKeyName(p.KeyURI)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrapf(err, errCreateServerKey, e.engine.gvk.Kind)
+	}
+	err = e.client.CreateOrUpdateServerKey(ctx, p.ResourceGroupName, p.ServerName, name, database.NewServerKey(*p))
+	return managed.ExternalCreation{}, errors.Wrapf(err, errCreateServerKey, e.engine.gvk.Kind)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	p, _, ok := e.engine.key(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotServerKey, e.engine.gvk.Kind)
+	}
+
+	name, err := database.ServerKeyName(p.KeyURI)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateServerKey, e.engine.gvk.Kind)
+	}
+	err = e.client.CreateOrUpdateServerKey(ctx, p.ResourceGroupName, p.ServerName, name, database.NewServerKey(*p))
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateServerKey, e.engine.gvk.Kind)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	p, _, ok := e.engine.key(mg)
+	if !ok {
+		return errors.Errorf(errNotServerKey, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Deleting())
+	name, err := database.ServerKeyName(p.KeyURI)
+	if err != nil {
+		return errors.Wrapf(err, errDeleteServerKey, e.engine.gvk.Kind)
+	}
+	err = e.client.DeleteServerKey(ctx, p.ResourceGroupName, p.ServerName, name)
+	return errors.Wrapf(resource.Ignore(azure.IsNotFound, err), errDeleteServerKey, e.engine.gvk.Kind)
+}
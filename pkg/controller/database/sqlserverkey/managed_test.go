@@ -0,0 +1,480 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserverkey
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+const (
+	name              = "coolKey"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	keyURI            = "https://coolvault.vault.azure.net/keys/coolkey/0123456789abcdef"
+	keyName           = "coolvault_coolkey_0123456789abcdef"
+)
+
+var (
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
+	_ database.ServerKeyAPI     = &MockServerKeyAPI{}
+)
+
+type MockServerKeyAPI struct {
+	MockGetServerKey            func(ctx context.Context, resourceGroupName, serverName, keyName string) (database.ServerKey, error)
+	MockCreateOrUpdateServerKey func(ctx context.Context, resourceGroupName, serverName, keyName string, k database.ServerKey) error
+	MockDeleteServerKey         func(ctx context.Context, resourceGroupName, serverName, keyName string) error
+}
+
+func (m *MockServerKeyAPI) GetServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) (database.ServerKey, error) {
+	return m.MockGetServerKey(ctx, resourceGroupName, serverName, keyName)
+}
+
+func (m *MockServerKeyAPI) CreateOrUpdateServerKey(ctx context.Context, resourceGroupName, serverName, keyName string, k database.ServerKey) error {
+	return m.MockCreateOrUpdateServerKey(ctx, resourceGroupName, serverName, keyName, k)
+}
+
+func (m *MockServerKeyAPI) DeleteServerKey(ctx context.Context, resourceGroupName, serverName, keyName string) error {
+	return m.MockDeleteServerKey(ctx, resourceGroupName, serverName, keyName)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine}
+
+type serverKeyModifier func(*v1alpha3.ServerKeyParameters, *v1alpha3.ServerKeyStatus)
+
+func withConditions(c ...xpv1.Condition) serverKeyModifier {
+	return func(_ *v1alpha3.ServerKeyParameters, s *v1alpha3.ServerKeyStatus) { s.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha3.ServerKeyObservation) serverKeyModifier {
+	return func(_ *v1alpha3.ServerKeyParameters, s *v1alpha3.ServerKeyStatus) { s.AtProvider = o }
+}
+
+func withKeyURI(u string) serverKeyModifier {
+	return func(p *v1alpha3.ServerKeyParameters, _ *v1alpha3.ServerKeyStatus) { p.KeyURI = u }
+}
+
+func serverKey(e *engine, km ...serverKeyModifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	mg.SetName(name)
+	mg.SetUID(uid)
+	mg.SetFinalizers([]string{})
+
+	p, s, _ := e.key(mg)
+	*p = v1alpha3.ServerKeyParameters{
+		ServerName:        serverName,
+		ResourceGroupName: resourceGroupName,
+		KeyURI:            keyURI,
+	}
+
+	for _, m := range km {
+		m(p, s)
+	}
+
+	return mg
+}
+
+func azureServerKey(uri string) database.ServerKey {
+	return database.ServerKey{
+		ID:            resourceID,
+		Name:          keyName,
+		Type:          resourceType,
+		ServerKeyType: azure.ToStringPtr(v1alpha3.ServerKeyTypeAzureKeyVault),
+		URI:           azure.ToStringPtr(uri),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	_, errInvalid := database.ServerKeyName("nope")
+	observed := v1alpha3.ServerKeyObservation{ID: resourceID, Name: keyName, Type: resourceType}
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotServerKey": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{}},
+				want: want{
+					err: errors.Errorf(errNotServerKey, e.gvk.Kind),
+				},
+			},
+			"InvalidKeyURI": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{}},
+				args: args{
+					mg: serverKey(e, withKeyURI("nope")),
+				},
+				want: want{
+					mg:  serverKey(e, withKeyURI("nope")),
+					err: errors.Wrapf(errInvalid, errGetServerKey, e.gvk.Kind),
+				},
+			},
+			"SuccessfulObserveNotExist": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockGetServerKey: func(_ context.Context, _, _, _ string) (database.ServerKey, error) {
+						return database.ServerKey{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e),
+					o:  managed.ExternalObservation{ResourceExists: false},
+				},
+			},
+			"SuccessfulObserveExists": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockGetServerKey: func(_ context.Context, _, _, n string) (database.ServerKey, error) {
+						if n != keyName {
+							return database.ServerKey{}, errBoom
+						}
+						return azureServerKey(keyURI), nil
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Available()),
+						withObservation(observed),
+					),
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				},
+			},
+			"SuccessfulObserveNeedsUpdate": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockGetServerKey: func(_ context.Context, _, _, _ string) (database.ServerKey, error) {
+						return azureServerKey("https://coolvault.vault.azure.net/keys/coolkey/fedcba9876543210"), nil
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Available()),
+						withObservation(observed),
+					),
+					o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				},
+			},
+			"FailedObserve": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockGetServerKey: func(_ context.Context, _, _, _ string) (database.ServerKey, error) {
+						return database.ServerKey{}, errBoom
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg:  serverKey(e),
+					err: errors.Wrapf(errBoom, errGetServerKey, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.o, o); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotServerKey": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{}},
+				want: want{
+					err: errors.Errorf(errNotServerKey, e.gvk.Kind),
+				},
+			},
+			"ErrorCreate": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockCreateOrUpdateServerKey: func(_ context.Context, _, _, _ string, _ database.ServerKey) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errBoom, errCreateServerKey, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockCreateOrUpdateServerKey: func(_ context.Context, rg, s, n string, k database.ServerKey) error {
+						if rg != resourceGroupName || s != serverName || n != keyName || azure.ToString(k.URI) != keyURI {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Creating()),
+					),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotServerKey": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{}},
+				want: want{
+					err: errors.Errorf(errNotServerKey, e.gvk.Kind),
+				},
+			},
+			"UpdateError": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockCreateOrUpdateServerKey: func(_ context.Context, _, _, _ string, _ database.ServerKey) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg:  serverKey(e),
+					err: errors.Wrapf(errBoom, errUpdateServerKey, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockCreateOrUpdateServerKey: func(_ context.Context, _, _, _ string, _ database.ServerKey) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotServerKey": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{}},
+				want: want{
+					err: errors.Errorf(errNotServerKey, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockDeleteServerKey: func(_ context.Context, _, _, _ string) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"SuccessfulNotFound": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockDeleteServerKey: func(_ context.Context, _, _, _ string) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"Failed": {
+				ec: &external{engine: e, client: &MockServerKeyAPI{
+					MockDeleteServerKey: func(_ context.Context, _, _, _ string) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: serverKey(e),
+				},
+				want: want{
+					mg: serverKey(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errDeleteServerKey, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlservervirtualnetworkrule
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2020-01-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+// Error strings.
+const (
+	errNotVirtualNetworkRule    = "managed resource is not a %s"
+	errCreateVirtualNetworkRule = "cannot create %s"
+	errUpdateVirtualNetworkRule = "cannot update %s"
+	errGetVirtualNetworkRule    = "cannot get %s"
+	errDeleteVirtualNetworkRule = "cannot delete %s"
+)
+
+// A rule is the engine agnostic view of a virtual network rule managed
+// resource.
+type rule struct {
+	resourceGroupName string
+	serverName        string
+	properties        v1alpha3.VirtualNetworkRuleProperties
+	status            *v1alpha3.VirtualNetworkRuleStatus
+}
+
+// An engine adapts the engine agnostic virtual network rule controller to the
+// managed resource kind and the Azure API of a single database engine.
+type engine struct {
+	gvk       schema.GroupVersionKind
+	object    resource.Managed
+	newClient func(subscriptionID string, auth autorest.Authorizer) database.VirtualNetworkRuleAPI
+	rule      func(mg resource.Managed) (rule, bool)
+
+	// updateOnDemand engines never report their rules as up to date when
+	// observed. Instead each update gets the rule and only writes it if it
+	// needs to be updated.
+	updateOnDemand bool
+}
+
+var mysqlEngine = &engine{
+	gvk:    v1alpha3.MySQLServerVirtualNetworkRuleGroupVersionKind,
+	object: &v1alpha3.MySQLServerVirtualNetworkRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.VirtualNetworkRuleAPI {
+		cl := mysql.NewVirtualNetworkRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMySQLVirtualNetworkRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (rule, bool) {
+		v, ok := mg.(*v1alpha3.MySQLServerVirtualNetworkRule)
+		if !ok {
+			return rule{}, false
+		}
+		return rule{
+			resourceGroupName: v.Spec.ResourceGroupName,
+			serverName:        v.Spec.ServerName,
+			properties:        v.Spec.VirtualNetworkRuleProperties,
+			status:            &v.Status,
+		}, true
+	},
+	updateOnDemand: true,
+}
+
+var postgresqlEngine = &engine{
+	gvk:    v1alpha3.PostgreSQLServerVirtualNetworkRuleGroupVersionKind,
+	object: &v1alpha3.PostgreSQLServerVirtualNetworkRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.VirtualNetworkRuleAPI {
+		cl := postgresql.NewVirtualNetworkRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewPostgreSQLVirtualNetworkRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (rule, bool) {
+		v, ok := mg.(*v1alpha3.PostgreSQLServerVirtualNetworkRule)
+		if !ok {
+			return rule{}, false
+		}
+		return rule{
+			resourceGroupName: v.Spec.ResourceGroupName,
+			serverName:        v.Spec.ServerName,
+			properties:        v.Spec.VirtualNetworkRuleProperties,
+			status:            &v.Status,
+		}, true
+	},
+}
+
+var mariadbEngine = &engine{
+	gvk:    v1alpha3.MariaDBServerVirtualNetworkRuleGroupVersionKind,
+	object: &v1alpha3.MariaDBServerVirtualNetworkRule{},
+	newClient: func(subscriptionID string, auth autorest.Authorizer) database.VirtualNetworkRuleAPI {
+		cl := mariadb.NewVirtualNetworkRulesClient(subscriptionID)
+		cl.Authorizer = auth
+		return database.NewMariaDBVirtualNetworkRulesClient(cl)
+	},
+	rule: func(mg resource.Managed) (rule, bool) {
+		v, ok := mg.(*v1alpha3.MariaDBServerVirtualNetworkRule)
+		if !ok {
+			return rule{}, false
+		}
+		return rule{
+			resourceGroupName: v.Spec.ResourceGroupName,
+			serverName:        v.Spec.ServerName,
+			properties:        v.Spec.VirtualNetworkRuleProperties,
+			status:            &v.Status,
+		}, true
+	},
+}
+
+// SetupMySQLServerVirtualNetworkRule adds a controller that reconciles
+// MySQLServerVirtualNetworkRules.
+func SetupMySQLServerVirtualNetworkRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mysqlEngine)
+}
+
+// SetupPostgreSQLServerVirtualNetworkRule adds a controller that reconciles
+// PostgreSQLServerVirtualNetworkRules.
+func SetupPostgreSQLServerVirtualNetworkRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, postgresqlEngine)
+}
+
+// SetupMariaDBServerVirtualNetworkRule adds a controller that reconciles
+// MariaDBServerVirtualNetworkRules.
+func SetupMariaDBServerVirtualNetworkRule(mgr ctrl.Manager, l logging.Logger) error {
+	return setup(mgr, l, mariadbEngine)
+}
+
+func setup(mgr ctrl.Manager, l logging.Logger, e *engine) error {
+	name := managed.ControllerName(e.gvk.GroupKind().String())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(e.object).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(e.gvk),
+			managed.WithConnectionPublishers(),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), engine: e}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	engine *engine
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{engine: c.engine, client: c.engine.newClient(creds[azure.CredentialsKeySubscriptionID], auth)}, nil
+}
+
+type external struct {
+	engine *engine
+	client database.VirtualNetworkRuleAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	v, ok := e.engine.rule(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotVirtualNetworkRule, e.engine.gvk.Kind)
+	}
+
+	az, err := e.client.GetVirtualNetworkRule(ctx, v.resourceGroupName, v.serverName, meta.GetExternalName(mg))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetVirtualNetworkRule, e.engine.gvk.Kind)
+	}
+
+	database.UpdateVirtualNetworkRuleStatus(v.status, az)
+
+	mg.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}
+	if !e.engine.updateOnDemand {
+		o.ResourceUpToDate = !database.VirtualNetworkRuleNeedsUpdate(v.properties, az)
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	v, ok := e.engine.rule(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotVirtualNetworkRule, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Creating())
+
+	vnet := database.NewVirtualNetworkRule(v.properties)
+	err := e.client.CreateOrUpdateVirtualNetworkRule(ctx, v.resourceGroupName, v.serverName, meta.GetExternalName(mg), vnet)
+	return managed.ExternalCreation{}, errors.Wrapf(err, errCreateVirtualNetworkRule, e.engine.gvk.Kind)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	v, ok := e.engine.rule(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotVirtualNetworkRule, e.engine.gvk.Kind)
+	}
+
+	if e.engine.updateOnDemand {
+		az, err := e.client.GetVirtualNetworkRule(ctx, v.resourceGroupName, v.serverName, meta.GetExternalName(mg))
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errGetVirtualNetworkRule, e.engine.gvk.Kind)
+		}
+		if !database.VirtualNetworkRuleNeedsUpdate(v.properties, az) {
+			return managed.ExternalUpdate{}, nil
+		}
+	}
+
+	vnet := database.NewVirtualNetworkRule(v.properties)
+	err := e.client.CreateOrUpdateVirtualNetworkRule(ctx, v.resourceGroupName, v.serverName, meta.GetExternalName(mg), vnet)
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateVirtualNetworkRule, e.engine.gvk.Kind)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	v, ok := e.engine.rule(mg)
+	if !ok {
+		return errors.Errorf(errNotVirtualNetworkRule, e.engine.gvk.Kind)
+	}
+
+	mg.SetConditions(xpv1.Deleting())
+
+	err := e.client.DeleteVirtualNetworkRule(ctx, v.resourceGroupName, v.serverName, meta.GetExternalName(mg))
+	return errors.Wrapf(resource.Ignore(azure.IsNotFound, err), errDeleteVirtualNetworkRule, e.engine.gvk.Kind)
+}
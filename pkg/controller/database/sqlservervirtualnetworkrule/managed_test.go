@@ -0,0 +1,549 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlservervirtualnetworkrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane/provider-azure/pkg/clients"
+	"github.com/crossplane/provider-azure/pkg/clients/database"
+)
+
+const (
+	name              = "coolSubnet"
+	uid               = types.UID("definitely-a-uuid")
+	serverName        = "coolVnet"
+	resourceGroupName = "coolRG"
+	vnetSubnetID      = "/the/best/subnet/ever"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	stateReady        = "Ready"
+)
+
+var (
+	_ managed.ExternalClient         = &external{}
+	_ managed.ExternalConnecter      = &connecter{}
+	_ database.VirtualNetworkRuleAPI = &MockVirtualNetworkRuleAPI{}
+)
+
+type MockVirtualNetworkRuleAPI struct {
+	MockGetVirtualNetworkRule            func(ctx context.Context, resourceGroupName, serverName, ruleName string) (database.VirtualNetworkRule, error)
+	MockCreateOrUpdateVirtualNetworkRule func(ctx context.Context, resourceGroupName, serverName, ruleName string, r database.VirtualNetworkRule) error
+	MockDeleteVirtualNetworkRule         func(ctx context.Context, resourceGroupName, serverName, ruleName string) error
+}
+
+func (m *MockVirtualNetworkRuleAPI) GetVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) (database.VirtualNetworkRule, error) {
+	return m.MockGetVirtualNetworkRule(ctx, resourceGroupName, serverName, ruleName)
+}
+
+func (m *MockVirtualNetworkRuleAPI) CreateOrUpdateVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string, r database.VirtualNetworkRule) error {
+	return m.MockCreateOrUpdateVirtualNetworkRule(ctx, resourceGroupName, serverName, ruleName, r)
+}
+
+func (m *MockVirtualNetworkRuleAPI) DeleteVirtualNetworkRule(ctx context.Context, resourceGroupName, serverName, ruleName string) error {
+	return m.MockDeleteVirtualNetworkRule(ctx, resourceGroupName, serverName, ruleName)
+}
+
+// engines are the engines every test case is run against.
+var engines = []*engine{mysqlEngine, postgresqlEngine, mariadbEngine}
+
+type virtualNetworkRuleModifier func(*v1alpha3.VirtualNetworkRuleProperties, *v1alpha3.VirtualNetworkRuleStatus)
+
+func withConditions(c ...xpv1.Condition) virtualNetworkRuleModifier {
+	return func(_ *v1alpha3.VirtualNetworkRuleProperties, s *v1alpha3.VirtualNetworkRuleStatus) {
+		s.ConditionedStatus.Conditions = c
+	}
+}
+
+func withType(t string) virtualNetworkRuleModifier {
+	return func(_ *v1alpha3.VirtualNetworkRuleProperties, s *v1alpha3.VirtualNetworkRuleStatus) { s.Type = t }
+}
+
+func withID(id string) virtualNetworkRuleModifier {
+	return func(_ *v1alpha3.VirtualNetworkRuleProperties, s *v1alpha3.VirtualNetworkRuleStatus) { s.ID = id }
+}
+
+func withState(st string) virtualNetworkRuleModifier {
+	return func(_ *v1alpha3.VirtualNetworkRuleProperties, s *v1alpha3.VirtualNetworkRuleStatus) { s.State = st }
+}
+
+// spec returns the spec fields of the supplied virtual network rule. The rule
+// accessor of an engine returns a copy of them, so it cannot be used here.
+func spec(mg resource.Managed) (rg, srv *string, p *v1alpha3.VirtualNetworkRuleProperties) {
+	switch v := mg.(type) {
+	case *v1alpha3.MySQLServerVirtualNetworkRule:
+		return &v.Spec.ResourceGroupName, &v.Spec.ServerName, &v.Spec.VirtualNetworkRuleProperties
+	case *v1alpha3.PostgreSQLServerVirtualNetworkRule:
+		return &v.Spec.ResourceGroupName, &v.Spec.ServerName, &v.Spec.VirtualNetworkRuleProperties
+	case *v1alpha3.MariaDBServerVirtualNetworkRule:
+		return &v.Spec.ResourceGroupName, &v.Spec.ServerName, &v.Spec.VirtualNetworkRuleProperties
+	}
+	return nil, nil, nil
+}
+
+func virtualNetworkRule(e *engine, vm ...virtualNetworkRuleModifier) resource.Managed {
+	mg := e.object.DeepCopyObject().(resource.Managed)
+	mg.SetName(name)
+	mg.SetUID(uid)
+	mg.SetFinalizers([]string{})
+	meta.SetExternalName(mg, name)
+
+	rg, srv, p := spec(mg)
+	*rg = resourceGroupName
+	*srv = serverName
+	*p = v1alpha3.VirtualNetworkRuleProperties{
+		VirtualNetworkSubnetID:           vnetSubnetID,
+		IgnoreMissingVnetServiceEndpoint: true,
+	}
+
+	v, _ := e.rule(mg)
+	for _, m := range vm {
+		m(p, v.status)
+	}
+
+	return mg
+}
+
+func azureVirtualNetworkRule(subnetID string) database.VirtualNetworkRule {
+	return database.VirtualNetworkRule{
+		ID:                               resourceID,
+		Type:                             resourceType,
+		State:                            stateReady,
+		VirtualNetworkSubnetID:           azure.ToStringPtr(subnetID),
+		IgnoreMissingVnetServiceEndpoint: azure.ToBoolPtr(true),
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotVirtualNetworkRule": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+			"SuccessfulCreate": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockCreateOrUpdateVirtualNetworkRule: func(_ context.Context, rg, s, n string, r database.VirtualNetworkRule) error {
+						if rg != resourceGroupName || s != serverName || n != name || azure.ToString(r.VirtualNetworkSubnetID) != vnetSubnetID {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Creating()),
+					),
+				},
+			},
+			"FailedCreate": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockCreateOrUpdateVirtualNetworkRule: func(_ context.Context, _, _, _ string, _ database.VirtualNetworkRule) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Creating()),
+					),
+					err: errors.Wrapf(errBoom, errCreateVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Create(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotVirtualNetworkRule": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+			"SuccessfulObserveNotExist": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return database.VirtualNetworkRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e),
+					o:  managed.ExternalObservation{ResourceExists: false},
+				},
+			},
+			"SuccessfulObserveExists": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return azureVirtualNetworkRule(vnetSubnetID), nil
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Available()),
+						withState(stateReady),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					// Engines that update on demand never report their
+					// rules as up to date.
+					o: managed.ExternalObservation{
+						ResourceExists:    true,
+						ConnectionDetails: managed.ConnectionDetails{},
+						ResourceUpToDate:  !e.updateOnDemand,
+					},
+				},
+			},
+			"SuccessfulObserveNeedsUpdate": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return azureVirtualNetworkRule("/some/other/subnet"), nil
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Available()),
+						withState(stateReady),
+						withType(resourceType),
+						withID(resourceID),
+					),
+					o: managed.ExternalObservation{
+						ResourceExists:    true,
+						ConnectionDetails: managed.ConnectionDetails{},
+						ResourceUpToDate:  false,
+					},
+				},
+			},
+			"FailedObserve": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return database.VirtualNetworkRule{}, errBoom
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg:  virtualNetworkRule(e),
+					err: errors.Wrapf(errBoom, errGetVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				o, err := tc.ec.Observe(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.o, o); diff != "" {
+					t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	type testCase struct {
+		ec   managed.ExternalClient
+		args args
+		want want
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]testCase{
+			"NotVirtualNetworkRule": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+			"SuccessfulNeedsUpdate": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return azureVirtualNetworkRule("/some/other/subnet"), nil
+					},
+					MockCreateOrUpdateVirtualNetworkRule: func(_ context.Context, rg, s, n string, r database.VirtualNetworkRule) error {
+						if rg != resourceGroupName || s != serverName || n != name || azure.ToString(r.VirtualNetworkSubnetID) != vnetSubnetID {
+							return errBoom
+						}
+						return nil
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e),
+				},
+			},
+			"UnsuccessfulUpdate": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return azureVirtualNetworkRule("/some/other/subnet"), nil
+					},
+					MockCreateOrUpdateVirtualNetworkRule: func(_ context.Context, _, _, _ string, _ database.VirtualNetworkRule) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg:  virtualNetworkRule(e),
+					err: errors.Wrapf(errBoom, errUpdateVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+		}
+
+		// Engines that update on demand get the rule before updating it, and
+		// only write it if it needs to be updated.
+		if e.updateOnDemand {
+			cases["SuccessfulDoesNotNeedUpdate"] = testCase{
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return azureVirtualNetworkRule(vnetSubnetID), nil
+					},
+					MockCreateOrUpdateVirtualNetworkRule: func(_ context.Context, _, _, _ string, _ database.VirtualNetworkRule) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e),
+				},
+			}
+			cases["UnsuccessfulGet"] = testCase{
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockGetVirtualNetworkRule: func(_ context.Context, _, _, _ string) (database.VirtualNetworkRule, error) {
+						return database.VirtualNetworkRule{}, errBoom
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg:  virtualNetworkRule(e),
+					err: errors.Wrapf(errBoom, errGetVirtualNetworkRule, e.gvk.Kind),
+				},
+			}
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				_, err := tc.ec.Update(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	for _, e := range engines {
+		cases := map[string]struct {
+			ec   managed.ExternalClient
+			args args
+			want want
+		}{
+			"NotVirtualNetworkRule": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{}},
+				want: want{
+					err: errors.Errorf(errNotVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+			"Successful": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockDeleteVirtualNetworkRule: func(_ context.Context, _, _, _ string) error {
+						return nil
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"SuccessfulNotFound": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockDeleteVirtualNetworkRule: func(_ context.Context, _, _, _ string) error {
+						return autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Deleting()),
+					),
+				},
+			},
+			"Failed": {
+				ec: &external{engine: e, client: &MockVirtualNetworkRuleAPI{
+					MockDeleteVirtualNetworkRule: func(_ context.Context, _, _, _ string) error {
+						return errBoom
+					},
+				}},
+				args: args{
+					mg: virtualNetworkRule(e),
+				},
+				want: want{
+					mg: virtualNetworkRule(e,
+						withConditions(xpv1.Deleting()),
+					),
+					err: errors.Wrapf(errBoom, errDeleteVirtualNetworkRule, e.gvk.Kind),
+				},
+			},
+		}
+
+		for name, tc := range cases {
+			t.Run(e.gvk.Kind+"/"+name, func(t *testing.T) {
+				err := tc.ec.Delete(tc.args.ctx, tc.args.mg)
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			})
+		}
+	}
+}
@@ -19,19 +19,22 @@ package account
 import (
 	"context"
 	"reflect"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -49,6 +52,9 @@ const (
 	reconcileTimeout      = 2 * time.Minute
 	requeueAfterOnSuccess = 1 * time.Minute
 	requeueAfterOnWait    = 30 * time.Second
+
+	reasonDeprecatedSkuFields event.Reason = "DeprecatedSkuFields"
+	errFmtDeprecatedSkuFields              = "sku fields %s are deprecated and ignored"
 )
 
 var (
@@ -64,7 +70,8 @@ type Reconciler struct {
 	managed.ReferenceResolver
 	managed.Initializer
 
-	log logging.Logger
+	log    logging.Logger
+	record event.Recorder
 }
 
 // Setup adds a controller that reconciles Accounts.
@@ -76,6 +83,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		syncdeleterMaker: &accountSyncdeleterMaker{mgr.GetClient()},
 		Initializer:      managed.NewNameAsExternalName(mgr.GetClient()),
 		log:              l.WithValues("controller", name),
+		record:           event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -103,6 +111,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.Initialize(ctx, b); err != nil {
 		return reconcile.Result{}, err
 	}
+	if f := deprecatedSkuFields(b.Spec.StorageAccountSpec); len(f) > 0 {
+		r.record.Event(b, event.Warning(reasonDeprecatedSkuFields, errors.Errorf(errFmtDeprecatedSkuFields, strings.Join(f, ", "))))
+	}
 
 	bh, err := r.newSyncdeleter(ctx, b)
 	if err != nil {
@@ -118,6 +129,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return bh.sync(ctx)
 }
 
+// deprecatedSkuFields returns the names of the deprecated sku fields that are
+// set in the supplied spec. Azure no longer reports them, so they are ignored.
+func deprecatedSkuFields(s *v1alpha3.StorageAccountSpec) []string {
+	if s == nil || s.Sku == nil {
+		return nil
+	}
+	var f []string
+	if len(s.Sku.Capabilities) > 0 {
+		f = append(f, "capabilities")
+	}
+	if s.Sku.Kind != "" {
+		f = append(f, "kind")
+	}
+	if len(s.Sku.Locations) > 0 {
+		f = append(f, "locations")
+	}
+	if s.Sku.ResourceType != "" {
+		f = append(f, "resourceType")
+	}
+	return f
+}
+
 type syncdeleterMaker interface {
 	newSyncdeleter(context.Context, *v1alpha3.Account) (syncdeleter, error)
 }
@@ -266,18 +299,16 @@ func (acu *accountCreateUpdater) update(ctx context.Context, account *storage.Ac
 	if account.ProvisioningState == storage.Succeeded {
 		acu.acct.Status.SetConditions(xpv1.Available())
 
-		current := v1alpha3.NewStorageAccountSpec(account)
-		if reflect.DeepEqual(current, acu.acct.Spec.StorageAccountSpec) {
-			acu.acct.Status.SetConditions(xpv1.ReconcileSuccess())
-			return requeueOnSuccess, acu.kube.Status().Update(ctx, acu.acct)
-		}
-
-		a, err := acu.Update(ctx, v1alpha3.ToStorageAccountUpdate(acu.acct.Spec.StorageAccountSpec))
-		if err != nil {
-			acu.acct.Status.SetConditions(xpv1.ReconcileError(err))
-			return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
+		// Accounts that are up to date are still synced back, so that their
+		// status, connection secret, keys and static website stay current.
+		if !azurestorage.IsAccountUpToDate(acu.acct.Spec.StorageAccountSpec, *account) {
+			a, err := acu.Update(ctx, v1alpha3.ToStorageAccountUpdate(acu.acct.Spec.StorageAccountSpec))
+			if err != nil {
+				acu.acct.Status.SetConditions(xpv1.ReconcileError(err))
+				return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
+			}
+			account = a
 		}
-		account = a
 	}
 
 	return acu.syncback(ctx, account)
@@ -297,10 +328,15 @@ func newAccountSyncBacker(ao azurestorage.AccountOperations, kube client.Client,
 	}
 }
 
+// syncback late initializes any unset fields of the account's spec and
+// records the observed state of the storage account in its status.
 func (asb *accountSyncbacker) syncback(ctx context.Context, acct *storage.Account) (reconcile.Result, error) {
-	asb.acct.Spec.StorageAccountSpec = v1alpha3.NewStorageAccountSpec(acct)
-	if err := asb.kube.Update(ctx, asb.acct); err != nil {
-		return resultRequeue, err
+	spec := asb.acct.Spec.StorageAccountSpec.DeepCopy()
+	azurestorage.LateInitializeAccount(asb.acct.Spec.StorageAccountSpec, *acct)
+	if !reflect.DeepEqual(spec, asb.acct.Spec.StorageAccountSpec) {
+		if err := asb.kube.Update(ctx, asb.acct); err != nil {
+			return resultRequeue, err
+		}
 	}
 
 	asb.acct.Status.StorageAccountStatus = v1alpha3.NewStorageAccountStatus(acct)
@@ -321,8 +357,9 @@ func (asb *accountSyncbacker) syncback(ctx context.Context, acct *storage.Accoun
 
 type accountSecretUpdater struct {
 	azurestorage.AccountOperations
-	acct *v1alpha3.Account
-	kube client.Client
+	acct       *v1alpha3.Account
+	kube       client.Client
+	newService func(accountName, accountKey, blobEndpoint string) (azurestorage.ServiceOperations, error)
 }
 
 func newAccountSecretUpdater(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account) *accountSecretUpdater {
@@ -330,6 +367,7 @@ func newAccountSecretUpdater(ao azurestorage.AccountOperations, kube client.Clie
 		AccountOperations: ao,
 		acct:              acct,
 		kube:              kube,
+		newService:        azurestorage.NewServiceHandle,
 	}
 }
 
@@ -337,8 +375,13 @@ func (asu *accountSecretUpdater) updatesecret(ctx context.Context, acct *storage
 	secret := resource.ConnectionSecretFor(asu.acct, v1alpha3.AccountGroupVersionKind)
 	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
 
-	if acct.PrimaryEndpoints != nil {
-		secret.Data[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(to.String(acct.PrimaryEndpoints.Blob))
+	if ep := acct.PrimaryEndpoints; ep != nil {
+		secret.Data[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(to.String(ep.Blob))
+		secret.Data[azurestorage.ConnectionSecretBlobEndpointKey] = []byte(to.String(ep.Blob))
+		secret.Data[azurestorage.ConnectionSecretQueueEndpointKey] = []byte(to.String(ep.Queue))
+		secret.Data[azurestorage.ConnectionSecretTableEndpointKey] = []byte(to.String(ep.Table))
+		secret.Data[azurestorage.ConnectionSecretFileEndpointKey] = []byte(to.String(ep.File))
+		secret.Data[azurestorage.ConnectionSecretWebEndpointKey] = []byte(to.String(ep.Web))
 	}
 
 	keys, err := asu.ListKeys(ctx)
@@ -349,8 +392,32 @@ func (asu *accountSecretUpdater) updatesecret(ctx context.Context, acct *storage
 		return errors.New("account keys are empty")
 	}
 
-	secret.Data[xpv1.ResourceCredentialsSecretUserKey] = []byte(meta.GetExternalName(asu.acct))
-	secret.Data[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(to.String(keys[0].Value))
+	keys, err = asu.rotateKeys(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	activeKeyName := azurestorage.KeyName1
+	if r := asu.acct.Status.KeyRotation; r != nil && r.ActiveKeyName != "" {
+		activeKeyName = r.ActiveKeyName
+	}
+	active, other := azurestorage.ActiveKeys(keys, activeKeyName)
+
+	name := meta.GetExternalName(asu.acct)
+	secret.Data[xpv1.ResourceCredentialsSecretUserKey] = []byte(name)
+	secret.Data[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(active)
+	secret.Data[azurestorage.ConnectionSecretConnectionStringKey] = []byte(azurestorage.NewConnectionString(name, active, acct.PrimaryEndpoints))
+	if other != "" {
+		secret.Data[azurestorage.ConnectionSecretSecondaryKeyKey] = []byte(other)
+	}
+
+	if err := asu.updateSAS(ctx, secret, active, acct.PrimaryEndpoints); err != nil {
+		return err
+	}
+
+	if err := asu.updateStaticWebsite(ctx, active, acct.PrimaryEndpoints); err != nil {
+		return err
+	}
 
 	if err := asu.kube.Create(ctx, secret); err != nil {
 		if kerrors.IsAlreadyExists(err) {
@@ -361,3 +428,122 @@ func (asu *accountSecretUpdater) updatesecret(ctx context.Context, acct *storage
 
 	return nil
 }
+
+// updateSAS adds the account shared access signature to the supplied secret,
+// generating a new one if none has been published yet, the desired permissions
+// or signing key changed, or the published signature is about to expire.
+func (asu *accountSecretUpdater) updateSAS(ctx context.Context, secret *corev1.Secret, accountKey string, ep *storage.Endpoints) error {
+	cd := asu.acct.Spec.ConnectionDetails
+	if cd == nil || cd.SharedAccessSignature == nil {
+		asu.acct.Status.SharedAccessSignatureExpiry = nil
+		return nil
+	}
+	sas := *cd.SharedAccessSignature
+	if err := azurestorage.ValidateSAS(sas); err != nil {
+		return err
+	}
+
+	existing := &corev1.Secret{}
+	if err := asu.kube.Get(ctx, types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, existing); resource.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "failed to get secret: %s/%s", secret.Namespace, secret.Name)
+	}
+
+	token := string(existing.Data[azurestorage.ConnectionSecretSASTokenKey])
+	now := time.Now()
+	if token == "" || !azurestorage.IsAccountSASUpToDate(token, meta.GetExternalName(asu.acct), accountKey, sas) || azurestorage.SASNeedsRenewal(sas, asu.acct.Status.SharedAccessSignatureExpiry, now) {
+		expiry := metav1.NewTime(now.Add(sas.Expiry.Duration))
+		t, err := azurestorage.NewAccountSAS(meta.GetExternalName(asu.acct), accountKey, sas, expiry.Time)
+		if err != nil {
+			return errors.Wrap(err, "failed to generate shared access signature")
+		}
+		token = t
+		asu.acct.Status.SharedAccessSignatureExpiry = &expiry
+	}
+
+	secret.Data[azurestorage.ConnectionSecretSASTokenKey] = []byte(token)
+	secret.Data[azurestorage.ConnectionSecretSASConnectionStringKey] = []byte(azurestorage.NewSASConnectionString(token, ep))
+	secret.Data[azurestorage.ConnectionSecretSASExpiryKey] = []byte(asu.acct.Status.SharedAccessSignatureExpiry.UTC().Format(time.RFC3339))
+	return nil
+}
+
+// updateStaticWebsite enables, reconfigures, or disables static website
+// hosting through the blob service of the account. Static website hosting is
+// only disabled if it was previously enabled through this Account.
+func (asu *accountSecretUpdater) updateStaticWebsite(ctx context.Context, accountKey string, ep *storage.Endpoints) error {
+	w := asu.acct.Spec.StaticWebsite
+	if w == nil && asu.acct.Status.StaticWebsite == nil {
+		return nil
+	}
+
+	blob := ""
+	if ep != nil {
+		blob = to.String(ep.Blob)
+	}
+	svc, err := asu.newService(meta.GetExternalName(asu.acct), accountKey, blob)
+	if err != nil {
+		return errors.Wrap(err, "failed to create blob service client")
+	}
+
+	o, err := svc.GetStaticWebsite(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get static website configuration")
+	}
+	if azurestorage.IsStaticWebsiteUpToDate(w, o) {
+		asu.acct.Status.StaticWebsite = azurestorage.NewStaticWebsiteObservation(o)
+		return nil
+	}
+
+	if err := svc.SetStaticWebsite(ctx, azurestorage.NewStaticWebsite(w)); err != nil {
+		return errors.Wrap(err, "failed to set static website configuration")
+	}
+	asu.acct.Status.StaticWebsite = w.DeepCopy()
+	return nil
+}
+
+// rotateKeys rotates the account's access keys according to its rotation
+// policy and returns the resulting keys. A rotation regenerates the inactive
+// key and makes it the active key that is published to the connection
+// secret. The previously active key is regenerated once the grace period has
+// elapsed, giving consumers of the connection secret, including Containers,
+// time to pick up the new key.
+func (asu *accountSecretUpdater) rotateKeys(ctx context.Context, keys []storage.AccountKey) ([]storage.AccountKey, error) {
+	p := asu.acct.Spec.KeyRotationPolicy
+	if p == nil {
+		return keys, nil
+	}
+	if asu.acct.Status.KeyRotation == nil {
+		asu.acct.Status.KeyRotation = &v1alpha3.KeyRotationStatus{ActiveKeyName: azurestorage.KeyName1}
+	}
+	s := asu.acct.Status.KeyRotation
+	now := metav1.Now()
+
+	if s.PendingKeyName != "" {
+		if !azurestorage.GracePeriodElapsed(*p, *s, now.Time) {
+			return keys, nil
+		}
+		k, err := asu.RegenerateKey(ctx, s.PendingKeyName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to regenerate account key %s", s.PendingKeyName)
+		}
+		azurestorage.SetKeyRegenerationTime(s, s.PendingKeyName, now)
+		s.PendingKeyName = ""
+		return k, nil
+	}
+
+	trigger := asu.acct.GetAnnotations()[v1alpha3.AnnotationKeyRotateKeys]
+	if !azurestorage.KeyRotationDue(*p, *s, trigger, asu.acct.GetCreationTimestamp().Time, now.Time) {
+		return keys, nil
+	}
+
+	inactive := azurestorage.OtherKeyName(s.ActiveKeyName)
+	k, err := asu.RegenerateKey(ctx, inactive)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to regenerate account key %s", inactive)
+	}
+	azurestorage.SetKeyRegenerationTime(s, inactive, now)
+	s.PendingKeyName = s.ActiveKeyName
+	s.ActiveKeyName = inactive
+	s.LastRotationTime = &now
+	s.LastRotationTrigger = trigger
+	return k, nil
+}
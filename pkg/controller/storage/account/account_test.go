@@ -24,13 +24,16 @@ import (
 
 	"github.com/crossplane/provider-azure/apis"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -39,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -185,6 +189,15 @@ const (
 	testAccountName = "testAccount"
 )
 
+// recorder records the events it is asked to emit.
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
 func TestReconciler_Reconcile(t *testing.T) {
 	name := testAccountName
 	key := types.NamespacedName{Name: name}
@@ -198,9 +211,10 @@ func TestReconciler_Reconcile(t *testing.T) {
 		maker  syncdeleterMaker
 	}
 	type want struct {
-		res  reconcile.Result
-		err  error
-		acct *v1alpha3.Account
+		res    reconcile.Result
+		err    error
+		acct   *v1alpha3.Account
+		events []event.Event
 	}
 	tests := []struct {
 		name   string
@@ -255,15 +269,33 @@ func TestReconciler_Reconcile(t *testing.T) {
 			},
 			want: want{res: requeueOnSuccess},
 		},
+		{
+			name: "DeprecatedSkuFields",
+			fields: fields{
+				client: fake.NewClientBuilder().WithObjects(v1alpha3test.NewMockAccount(name).
+					WithSpecStorageAccountSpec(&v1alpha3.StorageAccountSpec{
+						Sku: &v1alpha3.Sku{Name: storage.StandardLRS, Locations: []string{"westus"}, ResourceType: "storageAccounts"},
+					}).Account).Build(),
+				maker: newMockAccountHandleMaker(newMockAccountSyncDeleter(), nil),
+			},
+			want: want{
+				res: requeueOnSuccess,
+				events: []event.Event{
+					event.Warning(reasonDeprecatedSkuFields, errors.Errorf(errFmtDeprecatedSkuFields, "locations, resourceType")),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{}
 			r := &Reconciler{
 				Client:           tt.fields.client,
 				syncdeleterMaker: tt.fields.maker,
 				Initializer:      managed.NewNameAsExternalName(tt.fields.client),
 				log:              logging.NewNopLogger(),
+				record:           rec,
 			}
 			got, err := r.Reconcile(context.Background(), req)
 			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
@@ -272,6 +304,9 @@ func TestReconciler_Reconcile(t *testing.T) {
 			if diff := cmp.Diff(tt.want.res, got); diff != "" {
 				t.Errorf("Reconciler.Reconcile(): -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tt.want.events, rec.events); diff != "" {
+				t.Errorf("Reconciler.Reconcile(): -want events, +got events:\n%s", diff)
+			}
 			if tt.want.acct != nil {
 				b := &v1alpha3.Account{}
 				if err := r.Get(ctx, key, b); err != nil {
@@ -667,24 +702,36 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
 			},
 			fields: fields{
+				sb: &MockAccountSyncbacker{
+					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
+						return requeueOnSuccess, nil
+					},
+				},
 				acct: v1alpha3test.NewMockAccount(name).
 					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
 					Account,
+				ao: &azurestoragefake.MockAccountOperations{
+					MockUpdate: func(ctx context.Context, update storage.AccountUpdateParameters) (attrs *storage.Account, e error) {
+						return nil, errBoom
+					},
+				},
 				kube: test.NewMockClient(),
 			},
 			want: want{
 				res: requeueOnSuccess,
 				acct: v1alpha3test.NewMockAccount(name).
 					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
-					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
+					WithStatusConditions(xpv1.Available()).
 					Account,
 			},
 		},
 		{
 			name: "UpdateFailed",
 			attrs: &storage.Account{
-				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
-				Location:          to.StringPtr("test-location"),
+				AccountProperties: &storage.AccountProperties{
+					ProvisioningState:      storage.Succeeded,
+					EnableHTTPSTrafficOnly: to.BoolPtr(true),
+				},
 			},
 			fields: fields{
 				acct: v1alpha3test.NewMockAccount(name).WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).Account,
@@ -708,8 +755,10 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 		{
 			name: "UpdateSuccess",
 			attrs: &storage.Account{
-				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
-				Location:          to.StringPtr("test-location"),
+				AccountProperties: &storage.AccountProperties{
+					ProvisioningState:      storage.Succeeded,
+					EnableHTTPSTrafficOnly: to.BoolPtr(true),
+				},
 			},
 			fields: fields{
 				sb: &MockAccountSyncbacker{
@@ -784,18 +833,20 @@ func Test_accountSyncBacker_syncback(t *testing.T) {
 			name: "UpdateDailed",
 			fields: fields{
 				secretupdater: &MockAccountSecretupdater{},
-				acct:          v1alpha3test.NewMockAccount(name).Account,
+				acct:          v1alpha3test.NewMockAccount(name).WithSpecStorageAccountSpec(newStorageAccountSpec()).Account,
 				kube: &test.MockClient{
 					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
 						return errBoom
 					},
 				},
 			},
-			acct: &storage.Account{},
+			acct: &storage.Account{Location: to.StringPtr("test-location")},
 			want: want{
-				err:  errBoom,
-				res:  resultRequeue,
-				acct: v1alpha3test.NewMockAccount(name).WithSpecStorageAccountSpec(newStorageAccountSpec()).Account,
+				err: errBoom,
+				res: resultRequeue,
+				acct: v1alpha3test.NewMockAccount(name).
+					WithSpecStorageAccountSpec(v1alpha3.NewStorageAccountSpec(&storage.Account{Location: to.StringPtr("test-location")})).
+					Account,
 			},
 		},
 		{
@@ -810,7 +861,9 @@ func Test_accountSyncBacker_syncback(t *testing.T) {
 			want: want{
 				res: requeueOnWait,
 				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStatusFromProperties(&storage.AccountProperties{ProvisioningState: storage.Creating}).
+					WithStorageAccountStatus(v1alpha3.NewStorageAccountStatus(&storage.Account{
+						AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Creating},
+					})).
 					WithStatusConditions(xpv1.ReconcileSuccess()).
 					Account,
 			},
@@ -830,7 +883,9 @@ func Test_accountSyncBacker_syncback(t *testing.T) {
 			want: want{
 				res: resultRequeue,
 				acct: v1alpha3test.NewMockAccount(name).
-					WithSpecStatusFromProperties(&storage.AccountProperties{ProvisioningState: storage.Succeeded}).
+					WithStorageAccountStatus(v1alpha3.NewStorageAccountStatus(&storage.Account{
+						AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+					})).
 					WithStatusConditions(xpv1.ReconcileError(errBoom)).Account,
 			},
 		},
@@ -1008,6 +1063,50 @@ func Test_accountSecretUpdater_updatesecret(t *testing.T) {
 			},
 			wantErr: errors.Wrapf(errors.New("test-create-secret-error"), "failed to create secret: %s/%s", ns, csName),
 		},
+		{
+			name: "CreateNewSecretWithSAS",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{
+							{KeyName: to.StringPtr("key1"), Value: to.StringPtr("dGVzdC1rZXkK")},
+							{KeyName: to.StringPtr("key2"), Value: to.StringPtr("dGVzdC1rZXkyCg==")},
+						}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						return kerrors.NewNotFound(schema.GroupResource{Group: azurev1alpha3.Group, Resource: "secret"}, name)
+					},
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+						s := obj.(*corev1.Secret)
+						for _, k := range []string{azurestorage.ConnectionSecretSecondaryKeyKey, azurestorage.ConnectionSecretConnectionStringKey, azurestorage.ConnectionSecretSASTokenKey} {
+							if len(s.Data[k]) == 0 {
+								return errors.Errorf("missing connection detail %s", k)
+							}
+						}
+						return nil
+					},
+				},
+				acct: func() *v1alpha3.Account {
+					a := v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account
+					a.Spec.ConnectionDetails = &v1alpha3.ConnectionDetails{
+						SharedAccessSignature: &v1alpha3.SharedAccessSignature{
+							Permissions: "rl",
+							Expiry:      metav1.Duration{Duration: time.Hour},
+						},
+					}
+					return a
+				}(),
+			},
+			acct: &storage.Account{
+				AccountProperties: &storage.AccountProperties{
+					PrimaryEndpoints: &storage.Endpoints{
+						Blob: to.StringPtr("test-blob-endpoint"),
+					},
+				},
+			},
+		},
 		{
 			name: "UpdateExistingSecret",
 			fields: fields{
@@ -1054,6 +1153,233 @@ func Test_accountSecretUpdater_updatesecret(t *testing.T) {
 			if diff := cmp.Diff(tt.wantErr, err, test.EquateErrors()); diff != "" {
 				t.Errorf("accountSyncBackSecretUpdater.syncback() -want error, +got error:\n%s", diff)
 			}
+			if tt.wantErr == nil && tt.fields.acct.Spec.ConnectionDetails != nil && tt.fields.acct.Status.SharedAccessSignatureExpiry == nil {
+				t.Errorf("accountSyncBackSecretUpdater.syncback() expected shared access signature expiry to be recorded")
+			}
+		})
+	}
+}
+
+func Test_accountSecretUpdater_rotateKeys(t *testing.T) {
+	ctx := context.TODO()
+	name := testAccountName
+	errBoom := errors.New("boom")
+	keys := []storage.AccountKey{
+		{KeyName: to.StringPtr(azurestorage.KeyName1), Value: to.StringPtr("key1-value")},
+		{KeyName: to.StringPtr(azurestorage.KeyName2), Value: to.StringPtr("key2-value")},
+	}
+	regenerated := []storage.AccountKey{
+		{KeyName: to.StringPtr(azurestorage.KeyName1), Value: to.StringPtr("key1-value")},
+		{KeyName: to.StringPtr(azurestorage.KeyName2), Value: to.StringPtr("new-key2-value")},
+	}
+	withRotation := func(p *v1alpha3.KeyRotationPolicy, s *v1alpha3.KeyRotationStatus, trigger string) *v1alpha3.Account {
+		a := v1alpha3test.NewMockAccount(name).Account
+		a.Spec.KeyRotationPolicy = p
+		a.Status.KeyRotation = s
+		if trigger != "" {
+			a.SetAnnotations(map[string]string{v1alpha3.AnnotationKeyRotateKeys: trigger})
+		}
+		return a
+	}
+	longAgo := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	justNow := metav1.Now()
+
+	type want struct {
+		keys       []storage.AccountKey
+		active     string
+		pending    string
+		regenerate string
+		err        error
+	}
+
+	tests := map[string]struct {
+		acct *v1alpha3.Account
+		want want
+	}{
+		"NoPolicy": {
+			acct: withRotation(nil, nil, "now"),
+			want: want{keys: keys},
+		},
+		"NotDue": {
+			acct: withRotation(&v1alpha3.KeyRotationPolicy{}, nil, ""),
+			want: want{keys: keys, active: azurestorage.KeyName1},
+		},
+		"AnnotationTriggersRotation": {
+			acct: withRotation(&v1alpha3.KeyRotationPolicy{}, nil, "now"),
+			want: want{keys: regenerated, active: azurestorage.KeyName2, pending: azurestorage.KeyName1, regenerate: azurestorage.KeyName2},
+		},
+		"IntervalTriggersRotation": {
+			acct: withRotation(
+				&v1alpha3.KeyRotationPolicy{Interval: &metav1.Duration{Duration: time.Hour}},
+				&v1alpha3.KeyRotationStatus{ActiveKeyName: azurestorage.KeyName1, LastRotationTime: &longAgo},
+				""),
+			want: want{keys: regenerated, active: azurestorage.KeyName2, pending: azurestorage.KeyName1, regenerate: azurestorage.KeyName2},
+		},
+		"WithinGracePeriod": {
+			acct: withRotation(
+				&v1alpha3.KeyRotationPolicy{},
+				&v1alpha3.KeyRotationStatus{ActiveKeyName: azurestorage.KeyName2, PendingKeyName: azurestorage.KeyName1, LastRotationTime: &justNow},
+				""),
+			want: want{keys: keys, active: azurestorage.KeyName2, pending: azurestorage.KeyName1},
+		},
+		"GracePeriodElapsed": {
+			acct: withRotation(
+				&v1alpha3.KeyRotationPolicy{},
+				&v1alpha3.KeyRotationStatus{ActiveKeyName: azurestorage.KeyName2, PendingKeyName: azurestorage.KeyName1, LastRotationTime: &longAgo},
+				""),
+			want: want{keys: regenerated, active: azurestorage.KeyName2, regenerate: azurestorage.KeyName1},
+		},
+		"RegenerateFailed": {
+			acct: withRotation(&v1alpha3.KeyRotationPolicy{}, nil, "now"),
+			want: want{
+				active: azurestorage.KeyName1,
+				err:    errors.Wrapf(errBoom, "failed to regenerate account key %s", azurestorage.KeyName2),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			regenerate := ""
+			asu := &accountSecretUpdater{
+				AccountOperations: &azurestoragefake.MockAccountOperations{
+					MockRegenerateKey: func(_ context.Context, keyName string) ([]storage.AccountKey, error) {
+						if tc.want.err != nil {
+							return nil, errBoom
+						}
+						regenerate = keyName
+						return regenerated, nil
+					},
+				},
+				acct: tc.acct,
+			}
+			got, err := asu.rotateKeys(ctx, keys)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("rotateKeys(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.keys, got); diff != "" {
+				t.Errorf("rotateKeys(...): -want keys, +got keys:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.regenerate, regenerate); diff != "" {
+				t.Errorf("rotateKeys(...): -want regenerated key, +got regenerated key:\n%s", diff)
+			}
+			s := tc.acct.Status.KeyRotation
+			if s == nil {
+				s = &v1alpha3.KeyRotationStatus{}
+			}
+			if diff := cmp.Diff(tc.want.active, s.ActiveKeyName); diff != "" {
+				t.Errorf("rotateKeys(...): -want active key, +got active key:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pending, s.PendingKeyName); diff != "" {
+				t.Errorf("rotateKeys(...): -want pending key, +got pending key:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_accountSecretUpdater_updateStaticWebsite(t *testing.T) {
+	ctx := context.TODO()
+	name := testAccountName
+	errBoom := errors.New("boom")
+	website := &v1alpha3.StaticWebsite{IndexDocument: "index.html", ErrorDocument404Path: "404.html"}
+	enabled := &azblob.StaticWebsite{Enabled: true, IndexDocument: to.StringPtr("index.html"), ErrorDocument404Path: to.StringPtr("404.html")}
+	withWebsite := func(spec, status *v1alpha3.StaticWebsite) *v1alpha3.Account {
+		a := v1alpha3test.NewMockAccount(name).Account
+		a.Spec.StaticWebsite = spec
+		a.Status.StaticWebsite = status
+		return a
+	}
+
+	type want struct {
+		err    error
+		set    *azblob.StaticWebsite
+		status *v1alpha3.StaticWebsite
+	}
+	cases := map[string]struct {
+		acct     *v1alpha3.Account
+		observed *azblob.StaticWebsite
+		getErr   error
+		setErr   error
+		want     want
+	}{
+		"NotManaged": {
+			acct: withWebsite(nil, nil),
+			want: want{},
+		},
+		"Enable": {
+			acct:     withWebsite(website, nil),
+			observed: &azblob.StaticWebsite{},
+			want: want{
+				set:    enabled,
+				status: website,
+			},
+		},
+		"UpToDate": {
+			acct:     withWebsite(website, nil),
+			observed: enabled,
+			want: want{
+				status: website,
+			},
+		},
+		"Reconfigure": {
+			acct:     withWebsite(&v1alpha3.StaticWebsite{IndexDocument: "default.html"}, website),
+			observed: enabled,
+			want: want{
+				set:    &azblob.StaticWebsite{Enabled: true, IndexDocument: to.StringPtr("default.html")},
+				status: &v1alpha3.StaticWebsite{IndexDocument: "default.html"},
+			},
+		},
+		"Disable": {
+			acct:     withWebsite(nil, website),
+			observed: enabled,
+			want: want{
+				set: &azblob.StaticWebsite{Enabled: false},
+			},
+		},
+		"GetFailed": {
+			acct:   withWebsite(website, nil),
+			getErr: errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, "failed to get static website configuration"),
+			},
+		},
+		"SetFailed": {
+			acct:     withWebsite(website, nil),
+			observed: &azblob.StaticWebsite{},
+			setErr:   errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, "failed to set static website configuration"),
+				set: enabled,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			var set *azblob.StaticWebsite
+			asu := &accountSecretUpdater{
+				acct: tc.acct,
+				newService: func(accountName, accountKey, blobEndpoint string) (azurestorage.ServiceOperations, error) {
+					return &azurestoragefake.MockServiceOperations{
+						MockGetStaticWebsite: func(ctx context.Context) (*azblob.StaticWebsite, error) {
+							return tc.observed, tc.getErr
+						},
+						MockSetStaticWebsite: func(ctx context.Context, w azblob.StaticWebsite) error {
+							set = &w
+							return tc.setErr
+						},
+					}, nil
+				},
+			}
+			err := asu.updateStaticWebsite(ctx, "key", nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("updateStaticWebsite(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, set); diff != "" {
+				t.Errorf("updateStaticWebsite(...): -want set, +got set:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, tc.acct.Status.StaticWebsite); diff != "" && tc.want.err == nil {
+				t.Errorf("updateStaticWebsite(...): -want status, +got status:\n%s", diff)
+			}
 		})
 	}
 }
@@ -18,14 +18,15 @@ package container
 
 import (
 	"context"
-	"reflect"
 	"time"
 
-	"github.com/Azure/azure-storage-blob-go/azblob"
+	storagemgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,7 +54,19 @@ const (
 
 // Error strings
 const (
-	errAcctSecretNil = "account does not have a connection secret"
+	errAcctSecretNil            = "account does not have a connection secret"
+	errGetContainerProperties   = "cannot get container properties"
+	errCreatePolicy             = "cannot create or update immutability policy"
+	errExtendPolicy             = "cannot extend locked immutability policy"
+	errLockPolicy               = "cannot lock immutability policy"
+	errDeletePolicy             = "cannot delete immutability policy"
+	errRemoveLockedPolicy       = "cannot remove a locked immutability policy"
+	errUnlockPolicy             = "cannot unlock a locked immutability policy"
+	errShortenLockedPolicy      = "cannot shorten the retention period of a locked immutability policy"
+	errSetLegalHold             = "cannot set legal hold tags"
+	errClearLegalHold           = "cannot clear legal hold tags"
+	errDeleteLockedContainer    = "cannot delete a container with a locked immutability policy"
+	errSASSharedKeyNotPermitted = "cannot sign a shared access signature: account does not permit shared key access"
 )
 
 var (
@@ -169,10 +182,33 @@ func (m *containerSyncdeleterMaker) newSyncdeleter(ctx context.Context, c *v1alp
 	accountPassword := string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey])
 	containerName := meta.GetExternalName(c)
 
-	ch, err := storage.NewContainerHandle(accountName, accountPassword, containerName)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create client handle: %s, storage account: %s", containerName, accountName)
+	// Azure AD authentication and the management API both require the
+	// credentials of the Account's ProviderConfig.
+	method := authenticationMethod(c)
+	var creds map[string]string
+	var auth autorest.Authorizer
+	if method == v1alpha3.AuthenticationMethodAzureAD || managesPolicies(c) {
+		var err error
+		if creds, auth, err = azure.GetAuthInfo(ctx, m.Client, acct); err != nil {
+			return nil, errors.Wrap(err, "failed to get management credentials")
+		}
+	}
+
+	var ch *storage.ContainerHandle
+	switch method {
+	case v1alpha3.AuthenticationMethodAzureAD:
+		tc, err := storage.NewTokenCredential(creds)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get Azure AD token for storage account: %s", accountName)
+		}
+		ch = storage.NewContainerHandleWithCredential(accountName, tc, containerName)
+	default:
+		var err error
+		if ch, err = storage.NewContainerHandle(accountName, accountPassword, containerName); err != nil {
+			return nil, errors.Wrapf(err, "failed to create client handle: %s, storage account: %s", containerName, accountName)
+		}
 	}
+	c.Status.AuthenticationMethod = method
 
 	// set owner reference on the container to storage account, thus
 	// if the account is delete - container is garbage collected as well
@@ -180,18 +216,60 @@ func (m *containerSyncdeleterMaker) newSyncdeleter(ctx context.Context, c *v1alp
 	or.BlockOwnerDeletion = to.BoolPtr(true)
 	meta.AddOwnerReference(c, or)
 
+	// Immutability policies and legal holds can only be managed through the
+	// management API, so we only build a client for it when needed.
+	var policies storage.ContainerPolicyOperations
+	var pu policyupdater
+	if managesPolicies(c) {
+		bc := storagemgmt.NewBlobContainersClient(creds[azure.CredentialsKeySubscriptionID])
+		bc.Authorizer = auth
+		policies = storage.NewContainerPolicyHandle(&bc, acct.Spec.ResourceGroupName, meta.GetExternalName(acct), containerName)
+		pu = &containerPolicyUpdater{ContainerPolicyOperations: policies, container: c}
+	}
+
 	return &containerSyncdeleter{
 		createupdater: &containerCreateUpdater{
+			policyupdater: pu,
+			secretupdater: &containerSecretUpdater{
+				kube:        m.Client,
+				container:   c,
+				accountName: accountName,
+				accountKey:  accountPassword,
+				url:         ch.ContainerURL.String(),
+			},
 			ContainerOperations: ch,
 			kube:                m.Client,
 			container:           c,
 		},
 		ContainerOperations: ch,
+		policies:            policies,
 		kube:                m.Client,
 		container:           c,
 	}, nil
 }
 
+// authenticationMethod returns the method the supplied container should use to
+// authenticate to the storage data plane. Unless a method is specified the
+// container uses shared key authentication until it observes that the account
+// does not permit it.
+func authenticationMethod(c *v1alpha3.Container) v1alpha3.AuthenticationMethod {
+	switch {
+	case c.Spec.AuthenticationMethod != "":
+		return c.Spec.AuthenticationMethod
+	case c.Status.AuthenticationMethod != "":
+		return c.Status.AuthenticationMethod
+	default:
+		return v1alpha3.AuthenticationMethodSharedKey
+	}
+}
+
+// managesPolicies returns true if the immutability policy or legal hold of
+// the supplied container is, or was, managed by Crossplane.
+func managesPolicies(c *v1alpha3.Container) bool {
+	return c.Spec.ImmutabilityPolicy != nil || len(c.Spec.LegalHoldTags) > 0 ||
+		c.Status.ImmutabilityPolicy != nil || len(c.Status.LegalHoldTags) > 0
+}
+
 type deleter interface {
 	delete(context.Context) (reconcile.Result, error)
 }
@@ -205,7 +283,15 @@ type creator interface {
 }
 
 type updater interface {
-	update(context.Context, *azblob.PublicAccessType, azblob.Metadata) (reconcile.Result, error)
+	update(context.Context, *v1alpha3.ContainerObservation) (reconcile.Result, error)
+}
+
+type secretupdater interface {
+	updatesecret(context.Context) error
+}
+
+type policyupdater interface {
+	updatepolicy(context.Context) error
 }
 
 type syncdeleter interface {
@@ -216,6 +302,7 @@ type syncdeleter interface {
 type containerSyncdeleter struct {
 	createupdater
 	storage.ContainerOperations
+	policies  storage.ContainerPolicyOperations
 	kube      client.Client
 	container *v1alpha3.Container
 }
@@ -223,7 +310,14 @@ type containerSyncdeleter struct {
 func (csd *containerSyncdeleter) delete(ctx context.Context) (reconcile.Result, error) {
 	csd.container.Status.SetConditions(xpv1.Deleting())
 	if csd.container.Spec.DeletionPolicy == xpv1.DeletionDelete {
+		// Azure refuses to delete a container with a locked immutability
+		// policy, so we keep our finalizer until the policy expires.
+		if err := csd.checkDeletable(ctx); err != nil {
+			csd.container.Status.SetConditions(xpv1.ReconcileError(err))
+			return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
+		}
 		if err := csd.Delete(ctx); err != nil && !azure.IsNotFound(err) {
+			csd.fallBackToAzureAD(err)
 			csd.container.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
 		}
@@ -236,18 +330,47 @@ func (csd *containerSyncdeleter) delete(ctx context.Context) (reconcile.Result,
 	return reconcile.Result{}, csd.kube.Update(ctx, csd.container)
 }
 
+// fallBackToAzureAD switches the container to Azure AD authentication on its
+// next reconcile if no authentication method was specified and the supplied
+// error indicates that the account does not permit shared key access.
+func (csd *containerSyncdeleter) fallBackToAzureAD(err error) {
+	if csd.container.Spec.AuthenticationMethod == "" && storage.IsKeyBasedAuthenticationNotPermitted(err) {
+		csd.container.Status.AuthenticationMethod = v1alpha3.AuthenticationMethodAzureAD
+	}
+}
+
+// checkDeletable returns an error if the container has a locked immutability
+// policy.
+func (csd *containerSyncdeleter) checkDeletable(ctx context.Context) error {
+	if csd.policies == nil {
+		return nil
+	}
+	props, err := csd.policies.GetProperties(ctx)
+	if err != nil {
+		if azure.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetContainerProperties)
+	}
+	if storage.IsImmutabilityPolicyLocked(storage.NewImmutabilityPolicyObservation(props.ImmutabilityPolicy)) {
+		return errors.New(errDeleteLockedContainer)
+	}
+	return nil
+}
+
 func (csd *containerSyncdeleter) sync(ctx context.Context) (reconcile.Result, error) {
-	access, meta, err := csd.Get(ctx)
+	o, err := csd.Get(ctx)
 	if err != nil && !storage.IsNotFoundError(err) {
+		csd.fallBackToAzureAD(err)
 		csd.container.Status.SetConditions(xpv1.ReconcileError(err))
 		return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
 	}
 
-	if access == nil {
+	if o == nil {
 		return csd.create(ctx)
 	}
 
-	return csd.update(ctx, access, meta)
+	return csd.update(ctx, o)
 }
 
 type createupdater interface {
@@ -257,6 +380,8 @@ type createupdater interface {
 
 // containerCreateUpdater implementation of createupdater interface
 type containerCreateUpdater struct {
+	policyupdater
+	secretupdater
 	storage.ContainerOperations
 	kube      client.Client
 	container *v1alpha3.Container
@@ -283,12 +408,27 @@ func (ccu *containerCreateUpdater) create(ctx context.Context) (reconcile.Result
 	return reconcile.Result{}, ccu.kube.Status().Update(ctx, ccu.container)
 }
 
-func (ccu *containerCreateUpdater) update(ctx context.Context, accessType *azblob.PublicAccessType, meta azblob.Metadata) (reconcile.Result, error) {
+func (ccu *containerCreateUpdater) update(ctx context.Context, o *v1alpha3.ContainerObservation) (reconcile.Result, error) {
 	container := ccu.container
 	spec := container.Spec
+	container.Status.AtProvider = *o
+
+	if !storage.IsContainerUpToDate(spec.ContainerParameters, *o) {
+		if err := ccu.Update(ctx, spec.PublicAccessType, spec.Metadata, *o); err != nil {
+			container.Status.SetConditions(xpv1.ReconcileError(err))
+			return resultRequeue, ccu.kube.Status().Update(ctx, container)
+		}
+	}
 
-	if !reflect.DeepEqual(*accessType, spec.PublicAccessType) || !reflect.DeepEqual(meta, spec.Metadata) {
-		if err := ccu.Update(ctx, spec.PublicAccessType, spec.Metadata); err != nil {
+	if ccu.policyupdater != nil {
+		if err := ccu.updatepolicy(ctx); err != nil {
+			container.Status.SetConditions(xpv1.ReconcileError(err))
+			return resultRequeue, ccu.kube.Status().Update(ctx, container)
+		}
+	}
+
+	if container.GetWriteConnectionSecretToReference() != nil {
+		if err := ccu.updatesecret(ctx); err != nil {
 			container.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, ccu.kube.Status().Update(ctx, container)
 		}
@@ -297,3 +437,175 @@ func (ccu *containerCreateUpdater) update(ctx context.Context, accessType *azblo
 	container.Status.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
 	return requeueOnSuccess, ccu.kube.Status().Update(ctx, ccu.container)
 }
+
+// containerPolicyUpdater manages the immutability policy and legal hold of a
+// container.
+type containerPolicyUpdater struct {
+	storage.ContainerPolicyOperations
+	container *v1alpha3.Container
+}
+
+func (cpu *containerPolicyUpdater) updatepolicy(ctx context.Context) error {
+	props, err := cpu.GetProperties(ctx)
+	if err != nil {
+		return errors.Wrap(err, errGetContainerProperties)
+	}
+
+	policyChanged, err := cpu.updateImmutabilityPolicy(ctx, props.ImmutabilityPolicy)
+	if err != nil {
+		return err
+	}
+	holdChanged, err := cpu.updateLegalHold(ctx, storage.NewLegalHoldTags(props.LegalHold))
+	if err != nil {
+		return err
+	}
+
+	if policyChanged || holdChanged {
+		if props, err = cpu.GetProperties(ctx); err != nil {
+			return errors.Wrap(err, errGetContainerProperties)
+		}
+	}
+
+	cpu.container.Status.ImmutabilityPolicy = storage.NewImmutabilityPolicyObservation(props.ImmutabilityPolicy)
+	cpu.container.Status.LegalHoldTags = storage.NewLegalHoldTags(props.LegalHold)
+	return nil
+}
+
+// updateImmutabilityPolicy brings the observed immutability policy in line
+// with the desired one, returning true if it was changed. A locked policy can
+// only be extended; it can not be shortened, unlocked, or removed.
+func (cpu *containerPolicyUpdater) updateImmutabilityPolicy(ctx context.Context, p *storagemgmt.ImmutabilityPolicyProperties) (bool, error) { // nolint:gocyclo
+	desired := cpu.container.Spec.ImmutabilityPolicy
+	observed := storage.NewImmutabilityPolicyObservation(p)
+	etag := ""
+	if p != nil {
+		etag = to.String(p.Etag)
+	}
+
+	if storage.IsImmutabilityPolicyLocked(observed) {
+		switch {
+		case desired == nil:
+			return false, errors.New(errRemoveLockedPolicy)
+		case !desired.Locked:
+			return false, errors.New(errUnlockPolicy)
+		case desired.ImmutabilityPeriodSinceCreationInDays < observed.ImmutabilityPeriodSinceCreationInDays:
+			return false, errors.New(errShortenLockedPolicy)
+		case desired.ImmutabilityPeriodSinceCreationInDays > observed.ImmutabilityPeriodSinceCreationInDays:
+			_, err := cpu.ExtendImmutabilityPolicy(ctx, *desired, etag)
+			return true, errors.Wrap(err, errExtendPolicy)
+		}
+		return false, nil
+	}
+
+	switch {
+	case desired == nil && observed == nil:
+		return false, nil
+	case desired == nil:
+		return true, errors.Wrap(cpu.DeleteImmutabilityPolicy(ctx, etag), errDeletePolicy)
+	}
+
+	changed := false
+	if !storage.IsImmutabilityPolicyUpToDate(*desired, observed) {
+		ip, err := cpu.CreateOrUpdateImmutabilityPolicy(ctx, *desired, etag)
+		if err != nil {
+			return false, errors.Wrap(err, errCreatePolicy)
+		}
+		etag = to.String(ip.Etag)
+		changed = true
+	}
+	if desired.Locked {
+		return true, errors.Wrap(cpu.LockImmutabilityPolicy(ctx, etag), errLockPolicy)
+	}
+	return changed, nil
+}
+
+// updateLegalHold adds missing and removes unwanted legal hold tags, returning
+// true if any were changed.
+func (cpu *containerPolicyUpdater) updateLegalHold(ctx context.Context, observed []string) (bool, error) {
+	add, remove := storage.LegalHoldTagsDiff(cpu.container.Spec.LegalHoldTags, observed)
+	if len(add) > 0 {
+		if err := cpu.SetLegalHold(ctx, add); err != nil {
+			return false, errors.Wrap(err, errSetLegalHold)
+		}
+	}
+	if len(remove) > 0 {
+		if err := cpu.ClearLegalHold(ctx, remove); err != nil {
+			return false, errors.Wrap(err, errClearLegalHold)
+		}
+	}
+	return len(add) > 0 || len(remove) > 0, nil
+}
+
+// containerSecretUpdater publishes the connection details of a container.
+type containerSecretUpdater struct {
+	kube        client.Client
+	container   *v1alpha3.Container
+	accountName string
+	accountKey  string
+	url         string
+}
+
+func (csu *containerSecretUpdater) updatesecret(ctx context.Context) error {
+	secret := resource.ConnectionSecretFor(csu.container, v1alpha3.ContainerGroupVersionKind)
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+
+	secret.Data[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(csu.url)
+	secret.Data[xpv1.ResourceCredentialsSecretUserKey] = []byte(csu.accountName)
+
+	if err := csu.updateSAS(ctx, secret); err != nil {
+		return err
+	}
+
+	if err := csu.kube.Create(ctx, secret); err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return errors.Wrapf(csu.kube.Update(ctx, secret), "failed to update secret: %s", key)
+		}
+		return errors.Wrapf(err, "failed to create secret: %s", key)
+	}
+	return nil
+}
+
+// updateSAS adds the container shared access signature to the supplied
+// secret, generating a new one if none has been published yet, the desired
+// permissions or signing key changed, or the published signature is about to
+// expire. The signing key changes when the Account's keys are rotated.
+func (csu *containerSecretUpdater) updateSAS(ctx context.Context, secret *corev1.Secret) error {
+	cd := csu.container.Spec.ConnectionDetails
+	if cd == nil || cd.SharedAccessSignature == nil {
+		csu.container.Status.SharedAccessSignatureExpiry = nil
+		return nil
+	}
+	sas := *cd.SharedAccessSignature
+	if err := storage.ValidateSAS(sas); err != nil {
+		return err
+	}
+
+	// Signatures are signed with the account key, which the Account will not
+	// accept if we automatically switched to Azure AD authentication.
+	if csu.container.Spec.AuthenticationMethod == "" && csu.container.Status.AuthenticationMethod == v1alpha3.AuthenticationMethodAzureAD {
+		csu.container.Status.SharedAccessSignatureExpiry = nil
+		return errors.New(errSASSharedKeyNotPermitted)
+	}
+
+	existing := &corev1.Secret{}
+	if err := csu.kube.Get(ctx, types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, existing); resource.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "failed to get secret: %s/%s", secret.Namespace, secret.Name)
+	}
+
+	token := string(existing.Data[storage.ConnectionSecretSASTokenKey])
+	now := time.Now()
+	if token == "" || !storage.IsContainerSASUpToDate(token, csu.accountName, csu.accountKey, meta.GetExternalName(csu.container), sas) || storage.SASNeedsRenewal(sas, csu.container.Status.SharedAccessSignatureExpiry, now) {
+		expiry := metav1.NewTime(now.Add(sas.Expiry.Duration))
+		t, err := storage.NewContainerSAS(csu.accountName, csu.accountKey, meta.GetExternalName(csu.container), sas, expiry.Time)
+		if err != nil {
+			return errors.Wrap(err, "failed to generate shared access signature")
+		}
+		token = t
+		csu.container.Status.SharedAccessSignatureExpiry = &expiry
+	}
+
+	secret.Data[storage.ConnectionSecretSASTokenKey] = []byte(token)
+	secret.Data[storage.ConnectionSecretSASURLKey] = []byte(csu.url + "?" + token)
+	secret.Data[storage.ConnectionSecretSASExpiryKey] = []byte(csu.container.Status.SharedAccessSignatureExpiry.UTC().Format(time.RFC3339))
+	return nil
+}
@@ -19,14 +19,17 @@ package container
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/crossplane/provider-azure/apis"
 
+	storagemgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
@@ -57,15 +60,15 @@ func init() {
 
 type mockCreateUpdater struct {
 	mockCreate func(context.Context) (reconcile.Result, error)
-	mockUpdate func(context.Context, *azblob.PublicAccessType, azblob.Metadata) (reconcile.Result, error)
+	mockUpdate func(context.Context, *v1alpha3.ContainerObservation) (reconcile.Result, error)
 }
 
 func (m *mockCreateUpdater) create(ctx context.Context) (reconcile.Result, error) {
 	return m.mockCreate(ctx)
 }
 
-func (m *mockCreateUpdater) update(ctx context.Context, pat *azblob.PublicAccessType, meta azblob.Metadata) (reconcile.Result, error) {
-	return m.mockUpdate(ctx, pat, meta)
+func (m *mockCreateUpdater) update(ctx context.Context, o *v1alpha3.ContainerObservation) (reconcile.Result, error) {
+	return m.mockUpdate(ctx, o)
 }
 
 func newMockCreateUpdater() *mockCreateUpdater {
@@ -73,7 +76,7 @@ func newMockCreateUpdater() *mockCreateUpdater {
 		mockCreate: func(context.Context) (result reconcile.Result, e error) {
 			return reconcile.Result{}, nil
 		},
-		mockUpdate: func(context.Context, *azblob.PublicAccessType, azblob.Metadata) (reconcile.Result, error) {
+		mockUpdate: func(context.Context, *v1alpha3.ContainerObservation) (reconcile.Result, error) {
 			return reconcile.Result{}, nil
 		},
 	}
@@ -135,6 +138,25 @@ func newStorageNotFoundError() error {
 	return azblob.NewResponseError(nil, &http.Response{StatusCode: http.StatusNotFound}, "")
 }
 
+func newKeyBasedAuthenticationNotPermittedError() error {
+	return azblob.NewResponseError(nil, &http.Response{
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{"X-Ms-Error-Code": []string{"KeyBasedAuthenticationNotPermitted"}},
+		Request:    httptest.NewRequest(http.MethodGet, "https://testAccount.blob.core.windows.net/testContainer", nil),
+	}, "")
+}
+
+func newImmutabilityPolicyProperties(days int32, state storagemgmt.ImmutabilityPolicyState) *storagemgmt.ImmutabilityPolicyProperties {
+	return &storagemgmt.ImmutabilityPolicyProperties{
+		Etag: to.StringPtr("etag"),
+		ImmutabilityPolicyProperty: &storagemgmt.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: to.Int32Ptr(days),
+			AllowProtectedAppendWrites:            to.BoolPtr(false),
+			State:                                 state,
+		},
+	}
+}
+
 const (
 	testNamespace     = "default"
 	testContainerName = "testContainer"
@@ -483,6 +505,7 @@ func Test_containerSyncdeleter_delete(t *testing.T) {
 	type fields struct {
 		createupdater       createupdater
 		ContainerOperations storage.ContainerOperations
+		policies            storage.ContainerPolicyOperations
 		kube                client.Client
 		container           *v1alpha3.Container
 	}
@@ -565,12 +588,62 @@ func Test_containerSyncdeleter_delete(t *testing.T) {
 					Container,
 			},
 		},
+		{
+			name: "LockedImmutabilityPolicy",
+			fields: fields{
+				kube: test.NewMockClient(),
+				policies: &azurestoragefake.MockContainerPolicyOperations{
+					MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+						return &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(30, storagemgmt.Locked)}, nil
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizer(finalizer).Container,
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: resultRequeue,
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizer(finalizer).
+					WithStatusConditions(xpv1.Deleting(), xpv1.ReconcileError(errors.New(errDeleteLockedContainer))).
+					Container,
+			},
+		},
+		{
+			name: "UnlockedImmutabilityPolicy",
+			fields: fields{
+				kube: test.NewMockClient(),
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockDelete: func(ctx context.Context) error { return nil },
+				},
+				policies: &azurestoragefake.MockContainerPolicyOperations{
+					MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+						return &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(30, storagemgmt.Unlocked)}, nil
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizer(finalizer).Container,
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: reconcile.Result{},
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizers([]string{}).
+					WithStatusConditions(xpv1.Deleting()).
+					Container,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			csd := &containerSyncdeleter{
 				createupdater:       tt.fields.createupdater,
 				ContainerOperations: tt.fields.ContainerOperations,
+				policies:            tt.fields.policies,
 				kube:                tt.fields.kube,
 				container:           tt.fields.container,
 			}
@@ -591,6 +664,7 @@ func Test_containerSyncdeleter_delete(t *testing.T) {
 func Test_containerSyncdeleter_sync(t *testing.T) {
 	ctx := context.TODO()
 	errBoom := errors.New("boom")
+	errKeyBased := newKeyBasedAuthenticationNotPermittedError()
 
 	type fields struct {
 		createupdater       createupdater
@@ -618,8 +692,8 @@ func Test_containerSyncdeleter_sync(t *testing.T) {
 			fields: fields{
 				createupdater: newMockCreateUpdater(),
 				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return nil, nil, newStorageNotFoundError()
+					MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+						return nil, newStorageNotFoundError()
 					},
 				},
 			},
@@ -631,8 +705,8 @@ func Test_containerSyncdeleter_sync(t *testing.T) {
 			fields: fields{
 				createupdater: newMockCreateUpdater(),
 				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return nil, nil, errBoom
+					MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+						return nil, errBoom
 					},
 				},
 				container: v1alpha3test.NewMockContainer(testContainerName).Container,
@@ -646,13 +720,57 @@ func Test_containerSyncdeleter_sync(t *testing.T) {
 					Container,
 			},
 		},
+		{
+			name: "GetErrorKeyBasedAuthenticationNotPermitted",
+			fields: fields{
+				createupdater: newMockCreateUpdater(),
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+						return nil, errKeyBased
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).Container,
+				kube:      test.NewMockClient(),
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: resultRequeue,
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithStatusAuthenticationMethod(v1alpha3.AuthenticationMethodAzureAD).
+					WithStatusConditions(xpv1.ReconcileError(errKeyBased)).
+					Container,
+			},
+		},
+		{
+			name: "GetErrorKeyBasedAuthenticationNotPermittedSharedKeyRequired",
+			fields: fields{
+				createupdater: newMockCreateUpdater(),
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+						return nil, errKeyBased
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecAuthenticationMethod(v1alpha3.AuthenticationMethodSharedKey).
+					Container,
+				kube: test.NewMockClient(),
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: resultRequeue,
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecAuthenticationMethod(v1alpha3.AuthenticationMethodSharedKey).
+					WithStatusConditions(xpv1.ReconcileError(errKeyBased)).
+					Container,
+			},
+		},
 		{
 			name: "Create",
 			fields: fields{
 				createupdater: newMockCreateUpdater(),
 				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return nil, nil, nil
+					MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+						return nil, nil
 					},
 				},
 				container: v1alpha3test.NewMockContainer(testContainerName).Container,
@@ -669,8 +787,8 @@ func Test_containerSyncdeleter_sync(t *testing.T) {
 			fields: fields{
 				createupdater: newMockCreateUpdater(),
 				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
-						return azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer), nil, nil
+					MockGet: func(ctx context.Context) (*v1alpha3.ContainerObservation, error) {
+						return &v1alpha3.ContainerObservation{PublicAccessType: azblob.PublicAccessContainer}, nil
 					},
 				},
 				container: v1alpha3test.NewMockContainer(testContainerName).Container,
@@ -809,6 +927,11 @@ func Test_containerCreateUpdater_create(t *testing.T) {
 func Test_containerCreateUpdater_update(t *testing.T) {
 	ctx := context.TODO()
 	errBoom := errors.New("boom")
+	observed := &v1alpha3.ContainerObservation{
+		PublicAccessType: azblob.PublicAccessContainer,
+		Metadata:         azblob.Metadata{"foo": "bar"},
+		ETag:             "etag",
+	}
 
 	type fields struct {
 		ContainerOperations storage.ContainerOperations
@@ -816,9 +939,8 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 		container           *v1alpha3.Container
 	}
 	type args struct {
-		ctx        context.Context
-		accessType *azblob.PublicAccessType
-		meta       azblob.Metadata
+		ctx context.Context
+		o   *v1alpha3.ContainerObservation
 	}
 	type want struct {
 		res  reconcile.Result
@@ -835,17 +957,21 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 			name: "NoChange",
 			fields: fields{
 				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).Container,
+					WithSpecPAC(azblob.PublicAccessContainer).
+					WithSpecMetadata(map[string]string{"Foo": "bar"}).
+					Container,
 				kube: test.NewMockClient(),
 			},
 			args: args{
-				ctx:        ctx,
-				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
+				ctx: ctx,
+				o:   observed,
 			},
 			want: want{
 				res: requeueOnSuccess,
 				cont: v1alpha3test.NewMockContainer(testContainerName).
 					WithSpecPAC(azblob.PublicAccessContainer).
+					WithSpecMetadata(map[string]string{"Foo": "bar"}).
+					WithStatusAtProvider(*observed).
 					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
 					Container,
 			},
@@ -858,23 +984,21 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 					WithStatusConditions().
 					Container,
 				ContainerOperations: &azurestoragefake.MockContainerOperations{
-					MockUpdate: func(ctx context.Context, publicAccessType azblob.PublicAccessType, meta azblob.Metadata) error {
+					MockUpdate: func(ctx context.Context, publicAccessType azblob.PublicAccessType, meta azblob.Metadata, o v1alpha3.ContainerObservation) error {
 						return errBoom
 					},
 				},
 				kube: test.NewMockClient(),
 			},
 			args: args{
-				ctx:        ctx,
-				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
-				meta: azblob.Metadata{
-					"foo": "bar",
-				},
+				ctx: ctx,
+				o:   observed,
 			},
 			want: want{
 				res: resultRequeue,
 				cont: v1alpha3test.NewMockContainer(testContainerName).
 					WithSpecPAC(azblob.PublicAccessContainer).
+					WithStatusAtProvider(*observed).
 					WithStatusConditions(xpv1.ReconcileError(errBoom)).
 					Container,
 			},
@@ -883,22 +1007,29 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 			name: "ContainerUpdateSuccessful",
 			fields: fields{
 				container: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
+					WithSpecPAC(azblob.PublicAccessBlob).
+					WithSpecMetadata(map[string]string{"foo": "bar"}).
 					Container,
-				ContainerOperations: azurestoragefake.NewMockContainerOperations(),
-				kube:                test.NewMockClient(),
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockUpdate: func(ctx context.Context, publicAccessType azblob.PublicAccessType, meta azblob.Metadata, o v1alpha3.ContainerObservation) error {
+						if publicAccessType != azblob.PublicAccessBlob || o.ETag != "etag" {
+							return errBoom
+						}
+						return nil
+					},
+				},
+				kube: test.NewMockClient(),
 			},
 			args: args{
-				ctx:        ctx,
-				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
-				meta: azblob.Metadata{
-					"foo": "bar",
-				},
+				ctx: ctx,
+				o:   observed,
 			},
 			want: want{
 				res: requeueOnSuccess,
 				cont: v1alpha3test.NewMockContainer(testContainerName).
-					WithSpecPAC(azblob.PublicAccessContainer).
+					WithSpecPAC(azblob.PublicAccessBlob).
+					WithSpecMetadata(map[string]string{"foo": "bar"}).
+					WithStatusAtProvider(*observed).
 					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
 					Container,
 			},
@@ -911,7 +1042,7 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 				kube:                tt.fields.kube,
 				container:           tt.fields.container,
 			}
-			got, err := ccu.update(tt.args.ctx, tt.args.accessType, tt.args.meta)
+			got, err := ccu.update(tt.args.ctx, tt.args.o)
 			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("containerCreateUpdater.update(): -want error, +got error:\n%s", diff)
 			}
@@ -924,3 +1055,330 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 		})
 	}
 }
+
+func Test_containerPolicyUpdater_updatepolicy(t *testing.T) {
+	ctx := context.TODO()
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		policies  storage.ContainerPolicyOperations
+		container *v1alpha3.Container
+	}
+	type want struct {
+		err  error
+		cont *v1alpha3.Container
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "GetPropertiesFailed",
+			fields: fields{
+				policies: &azurestoragefake.MockContainerPolicyOperations{
+					MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+						return nil, errBoom
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).Container,
+			},
+			want: want{
+				err:  errors.Wrap(errBoom, errGetContainerProperties),
+				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
+			},
+		},
+		{
+			name: "CreatePolicyAndLegalHold",
+			fields: fields{
+				policies: func() storage.ContainerPolicyOperations {
+					props := &storagemgmt.ContainerProperties{}
+					return &azurestoragefake.MockContainerPolicyOperations{
+						MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+							return props, nil
+						},
+						MockCreateOrUpdateImmutabilityPolicy: func(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storagemgmt.ImmutabilityPolicy, error) {
+							props = &storagemgmt.ContainerProperties{
+								ImmutabilityPolicy: newImmutabilityPolicyProperties(p.ImmutabilityPeriodSinceCreationInDays, storagemgmt.Unlocked),
+								LegalHold:          props.LegalHold,
+							}
+							return &storagemgmt.ImmutabilityPolicy{Etag: to.StringPtr("etag")}, nil
+						},
+						MockSetLegalHold: func(ctx context.Context, tags []string) error {
+							lh := make([]storagemgmt.TagProperty, len(tags))
+							for i := range tags {
+								lh[i] = storagemgmt.TagProperty{Tag: to.StringPtr(tags[i])}
+							}
+							props = &storagemgmt.ContainerProperties{
+								ImmutabilityPolicy: props.ImmutabilityPolicy,
+								LegalHold:          &storagemgmt.LegalHoldProperties{Tags: &lh},
+							}
+							return nil
+						},
+					}
+				}(),
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 7}).
+					WithSpecLegalHoldTags([]string{"audit"}).
+					Container,
+			},
+			want: want{
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 7}).
+					WithSpecLegalHoldTags([]string{"audit"}).
+					WithStatusImmutabilityPolicy(&v1alpha3.ImmutabilityPolicyObservation{
+						State:                                 string(storagemgmt.Unlocked),
+						ImmutabilityPeriodSinceCreationInDays: 7,
+					}).
+					WithStatusLegalHoldTags([]string{"audit"}).
+					Container,
+			},
+		},
+		{
+			name: "DeleteUnlockedPolicy",
+			fields: fields{
+				policies: func() storage.ContainerPolicyOperations {
+					props := &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(7, storagemgmt.Unlocked)}
+					return &azurestoragefake.MockContainerPolicyOperations{
+						MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+							return props, nil
+						},
+						MockDeleteImmutabilityPolicy: func(ctx context.Context, etag string) error {
+							if etag != "etag" {
+								return errBoom
+							}
+							props = &storagemgmt.ContainerProperties{}
+							return nil
+						},
+					}
+				}(),
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithStatusImmutabilityPolicy(&v1alpha3.ImmutabilityPolicyObservation{
+						State:                                 string(storagemgmt.Unlocked),
+						ImmutabilityPeriodSinceCreationInDays: 7,
+					}).
+					Container,
+			},
+			want: want{
+				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
+			},
+		},
+		{
+			name: "LockPolicy",
+			fields: fields{
+				policies: func() storage.ContainerPolicyOperations {
+					props := &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(7, storagemgmt.Unlocked)}
+					return &azurestoragefake.MockContainerPolicyOperations{
+						MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+							return props, nil
+						},
+						MockLockImmutabilityPolicy: func(ctx context.Context, etag string) error {
+							props = &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(7, storagemgmt.Locked)}
+							return nil
+						},
+					}
+				}(),
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 7, Locked: true}).
+					Container,
+			},
+			want: want{
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 7, Locked: true}).
+					WithStatusImmutabilityPolicy(&v1alpha3.ImmutabilityPolicyObservation{
+						State:                                 string(storagemgmt.Locked),
+						ImmutabilityPeriodSinceCreationInDays: 7,
+					}).
+					Container,
+			},
+		},
+		{
+			name: "ExtendLockedPolicy",
+			fields: fields{
+				policies: func() storage.ContainerPolicyOperations {
+					props := &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(7, storagemgmt.Locked)}
+					return &azurestoragefake.MockContainerPolicyOperations{
+						MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+							return props, nil
+						},
+						MockExtendImmutabilityPolicy: func(ctx context.Context, p v1alpha3.ImmutabilityPolicy, etag string) (*storagemgmt.ImmutabilityPolicy, error) {
+							props = &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(p.ImmutabilityPeriodSinceCreationInDays, storagemgmt.Locked)}
+							return &storagemgmt.ImmutabilityPolicy{}, nil
+						},
+					}
+				}(),
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 14, Locked: true}).
+					Container,
+			},
+			want: want{
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 14, Locked: true}).
+					WithStatusImmutabilityPolicy(&v1alpha3.ImmutabilityPolicyObservation{
+						State:                                 string(storagemgmt.Locked),
+						ImmutabilityPeriodSinceCreationInDays: 14,
+					}).
+					Container,
+			},
+		},
+		{
+			name: "ShortenLockedPolicy",
+			fields: fields{
+				policies: &azurestoragefake.MockContainerPolicyOperations{
+					MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+						return &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(14, storagemgmt.Locked)}, nil
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 7, Locked: true}).
+					Container,
+			},
+			want: want{
+				err: errors.New(errShortenLockedPolicy),
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithSpecImmutabilityPolicy(&v1alpha3.ImmutabilityPolicy{ImmutabilityPeriodSinceCreationInDays: 7, Locked: true}).
+					Container,
+			},
+		},
+		{
+			name: "RemoveLockedPolicy",
+			fields: fields{
+				policies: &azurestoragefake.MockContainerPolicyOperations{
+					MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+						return &storagemgmt.ContainerProperties{ImmutabilityPolicy: newImmutabilityPolicyProperties(14, storagemgmt.Locked)}, nil
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).Container,
+			},
+			want: want{
+				err:  errors.New(errRemoveLockedPolicy),
+				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
+			},
+		},
+		{
+			name: "ClearLegalHoldFailed",
+			fields: fields{
+				policies: &azurestoragefake.MockContainerPolicyOperations{
+					MockGetProperties: func(ctx context.Context) (*storagemgmt.ContainerProperties, error) {
+						return &storagemgmt.ContainerProperties{
+							LegalHold: &storagemgmt.LegalHoldProperties{Tags: &[]storagemgmt.TagProperty{{Tag: to.StringPtr("audit")}}},
+						}, nil
+					},
+					MockClearLegalHold: func(ctx context.Context, tags []string) error {
+						return errBoom
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithStatusLegalHoldTags([]string{"audit"}).
+					Container,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errClearLegalHold),
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithStatusLegalHoldTags([]string{"audit"}).
+					Container,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpu := &containerPolicyUpdater{
+				ContainerPolicyOperations: tt.fields.policies,
+				container:                 tt.fields.container,
+			}
+			err := cpu.updatepolicy(ctx)
+			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("containerPolicyUpdater.updatepolicy(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want.cont, tt.fields.container); diff != "" {
+				t.Errorf("containerPolicyUpdater.updatepolicy() container: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_authenticationMethod(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha3.Container
+		want v1alpha3.AuthenticationMethod
+	}{
+		"Default": {
+			c:    v1alpha3test.NewMockContainer(testContainerName).Container,
+			want: v1alpha3.AuthenticationMethodSharedKey,
+		},
+		"Observed": {
+			c: v1alpha3test.NewMockContainer(testContainerName).
+				WithStatusAuthenticationMethod(v1alpha3.AuthenticationMethodAzureAD).
+				Container,
+			want: v1alpha3.AuthenticationMethodAzureAD,
+		},
+		"Specified": {
+			c: v1alpha3test.NewMockContainer(testContainerName).
+				WithSpecAuthenticationMethod(v1alpha3.AuthenticationMethodSharedKey).
+				WithStatusAuthenticationMethod(v1alpha3.AuthenticationMethodAzureAD).
+				Container,
+			want: v1alpha3.AuthenticationMethodSharedKey,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, authenticationMethod(tc.c)); diff != "" {
+				t.Errorf("authenticationMethod(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_containerSecretUpdater_updateSAS(t *testing.T) {
+	sas := &v1alpha3.ConnectionDetails{SharedAccessSignature: &v1alpha3.SharedAccessSignature{
+		Permissions: "rl",
+		Expiry:      metav1.Duration{Duration: time.Hour},
+	}}
+	withSAS := func(c *v1alpha3.Container) *v1alpha3.Container {
+		c.Spec.ConnectionDetails = sas
+		return c
+	}
+
+	cases := map[string]struct {
+		c    *v1alpha3.Container
+		want error
+	}{
+		"NoSharedAccessSignature": {
+			c: v1alpha3test.NewMockContainer(testContainerName).
+				WithStatusAuthenticationMethod(v1alpha3.AuthenticationMethodAzureAD).
+				Container,
+		},
+		"SharedKeyNotPermitted": {
+			c: withSAS(v1alpha3test.NewMockContainer(testContainerName).
+				WithStatusAuthenticationMethod(v1alpha3.AuthenticationMethodAzureAD).
+				Container),
+			want: errors.New(errSASSharedKeyNotPermitted),
+		},
+		"InvalidSharedAccessSignature": {
+			c: func() *v1alpha3.Container {
+				c := v1alpha3test.NewMockContainer(testContainerName).Container
+				c.Spec.ConnectionDetails = &v1alpha3.ConnectionDetails{SharedAccessSignature: &v1alpha3.SharedAccessSignature{
+					Permissions: "rl",
+					Expiry:      metav1.Duration{Duration: time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 2 * time.Hour},
+				}}
+				return c
+			}(),
+			want: storage.ValidateSAS(v1alpha3.SharedAccessSignature{
+				Expiry:      metav1.Duration{Duration: time.Hour},
+				RenewBefore: &metav1.Duration{Duration: 2 * time.Hour},
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			csu := &containerSecretUpdater{kube: test.NewMockClient(), container: tc.c}
+			err := csu.updateSAS(context.Background(), &v1.Secret{Data: map[string][]byte{}})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("updateSAS(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}